@@ -5,9 +5,15 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"io"
 	"testing"
+	"time"
+
+	"github.com/godror/godror"
 
 	"oracle-etl/pkg/buffer"
+	"oracle-etl/pkg/jsonl"
+	"oracle-etl/pkg/rowset"
 )
 
 // sampleRow는 벤치마크용 샘플 row 데이터입니다
@@ -231,3 +237,107 @@ func BenchmarkCompressionRatio(b *testing.B) {
 		})
 	}
 }
+
+// driverRowColumns는 드라이버 값 시뮬레이션용 컬럼 스키마입니다 (sampleRow와 같은 구성)
+var driverRowColumns = []rowset.Column{
+	{Name: "MANDT", Kind: rowset.KindString},
+	{Name: "VBELN", Kind: rowset.KindString},
+	{Name: "POSNR", Kind: rowset.KindNumber},
+	{Name: "MATNR", Kind: rowset.KindString},
+	{Name: "ARKTX", Kind: rowset.KindString},
+	{Name: "NETWR", Kind: rowset.KindNumber},
+	{Name: "WAERK", Kind: rowset.KindString},
+	{Name: "FKIMG", Kind: rowset.KindNumber},
+	{Name: "VRKME", Kind: rowset.KindString},
+	{Name: "ERDAT", Kind: rowset.KindTime},
+	{Name: "ERZET", Kind: rowset.KindString},
+	{Name: "ERNAM", Kind: rowset.KindString},
+	{Name: "AEDAT", Kind: rowset.KindTime},
+	{Name: "AESSION", Kind: rowset.KindString},
+	{Name: "KWMENG", Kind: rowset.KindNumber},
+	{Name: "KZWI1", Kind: rowset.KindNumber},
+	{Name: "KZWI2", Kind: rowset.KindNumber},
+	{Name: "MWSBP", Kind: rowset.KindNumber},
+	{Name: "NETPR", Kind: rowset.KindNumber},
+	{Name: "PRSDT", Kind: rowset.KindTime},
+}
+
+// driverRow는 rows.Scan이 *interface{}에 채우는 godror 값을 흉내낸 row입니다
+// (NUMBER는 godror.Number, DATE는 time.Time, VARCHAR2는 string)
+var driverRow = []interface{}{
+	"800",
+	"0090000001",
+	godror.Number("10"),
+	"MAT-1234567890",
+	"Sample Material Description Text",
+	godror.Number("1234.56"),
+	"USD",
+	godror.Number("100"),
+	"EA",
+	time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	"10:30:45",
+	"USER001",
+	time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+	"SESSION123",
+	godror.Number("100"),
+	godror.Number("50"),
+	godror.Number("25"),
+	godror.Number("12.5"),
+	godror.Number("12.3456"),
+	time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+}
+
+// BenchmarkRowDecode는 드라이버 값을 row로 변환하여 JSONL로 인코딩하는 비용을 비교합니다
+//   - map: row마다 map[string]interface{}를 만들고 encoding/json으로 인코딩 (이전 방식)
+//   - typed: 청크 슬랩의 typed row에 값을 저장하고 미리 인코딩한 키로 직접 인코딩
+func BenchmarkRowDecode(b *testing.B) {
+	const chunkSize = 10000
+	names := make([]string, len(driverRowColumns))
+	for i, col := range driverRowColumns {
+		names[i] = col.Name
+	}
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		encoder := jsonl.NewEncoder(io.Discard)
+		for i := 0; i < b.N; i++ {
+			rows := make([]map[string]interface{}, 0, chunkSize)
+			for r := 0; r < chunkSize; r++ {
+				row := make(map[string]interface{})
+				for c, name := range names {
+					v := driverRow[c]
+					if t, ok := v.(time.Time); ok {
+						v = t.Format(time.RFC3339)
+					}
+					row[name] = v
+				}
+				rows = append(rows, row)
+			}
+			for _, row := range rows {
+				_ = encoder.Encode(row)
+			}
+		}
+		b.ReportMetric(float64(chunkSize)*float64(b.N)/b.Elapsed().Seconds(), "rows/sec")
+	})
+
+	b.Run("typed", func(b *testing.B) {
+		b.ReportAllocs()
+		schema := rowset.NewSchema(driverRowColumns)
+		encoder := jsonl.NewEncoder(io.Discard)
+		for i := 0; i < b.N; i++ {
+			rows := make([]rowset.Row, 0, chunkSize)
+			buf := rowset.NewBuffer(schema, chunkSize)
+			for r := 0; r < chunkSize; r++ {
+				row := buf.Next()
+				for c, col := range driverRowColumns {
+					_ = row.Values[c].Set(col.Kind, driverRow[c])
+				}
+				rows = append(rows, row)
+			}
+			for _, row := range rows {
+				_ = encoder.EncodeRow(row)
+			}
+		}
+		b.ReportMetric(float64(chunkSize)*float64(b.N)/b.Elapsed().Seconds(), "rows/sec")
+	})
+}
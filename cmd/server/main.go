@@ -3,26 +3,41 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
 	"time"
+	_ "time/tzdata" // 최소 컨테이너 이미지에서도 Asia/Seoul 시간대 사용
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog"
 
+	"oracle-etl/internal/adapter/gcs"
 	"oracle-etl/internal/adapter/handler"
+	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/adapter/sse"
 	"oracle-etl/internal/config"
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/governor"
+	"oracle-etl/internal/maintenance"
 	"oracle-etl/internal/middleware"
+	"oracle-etl/internal/repository"
+	"oracle-etl/internal/repository/bolt"
 	"oracle-etl/internal/repository/memory"
+	"oracle-etl/internal/resilience"
+	"oracle-etl/internal/telemetry"
 	"oracle-etl/internal/usecase"
+	"oracle-etl/pkg/buffer"
 )
 
 const (
 	// defaultConfigPath는 기본 설정 파일 경로입니다
 	defaultConfigPath = "config.yaml"
+
+	// demoOwner는 데모 모드의 기본 스키마 소유자입니다 (Mock 저장소의 예시 테이블 소유자)
+	demoOwner = "SAPSR3"
 )
 
 func main() {
@@ -69,19 +84,134 @@ func main() {
 	go broadcaster.Run(broadcasterCtx)
 	logger.Info().Msg("SSE Broadcaster 시작됨")
 
-	// Repository 초기화 (In-Memory)
-	transportRepo := memory.NewTransportRepository()
-	jobRepo := memory.NewJobRepository()
+	// Prometheus 메트릭 (비활성화 시 nil)
+	appMetrics := newMetrics(cfg, broadcaster)
+
+	// OpenTelemetry 분산 추적 (비활성화 시 traceparent 전파만 수행)
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), cfg.Tracing, cfg.App.Name, cfg.App.Version)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("분산 추적 초기화 실패")
+	}
+	defer func() {
+		// 종료 전에 남은 span을 내보냄
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error().Err(err).Msg("분산 추적 종료 실패")
+		}
+	}()
+	if cfg.Tracing.Enabled {
+		logger.Info().
+			Str("exporter", cfg.Tracing.Exporter).
+			Float64("sample_ratio", cfg.Tracing.SampleRatio).
+			Msg("분산 추적 활성화됨")
+	}
+
+	// Repository 초기화 (storage.driver에 따라 In-Memory 또는 bolt 파일)
+	transportRepo, jobRepo, watermarkRepo, closeStorage, err := newRepositories(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("저장소 초기화 실패")
+	}
+	defer closeStorage()
+	logger.Info().Str("driver", cfg.Storage.Driver).Msg("저장소 초기화됨")
 
 	// Service 초기화
 	transportSvc := usecase.NewTransportService(transportRepo)
+	transportSvc.SetScheduleTimezone(cfg.Scheduler.Timezone)
+	maintenanceCfg, err := newMaintenance(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("유지보수 시간대 설정 실패")
+	}
+	transportSvc.SetMaintenance(maintenanceCfg)
 	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
+	watermarkSvc := usecase.NewWatermarkService(watermarkRepo)
+
+	// 이전 프로세스에서 실행 중이던 Job 정리 (영구 저장소 사용 시)
+	if recovered, err := jobSvc.RecoverInterrupted(context.Background()); err != nil {
+		logger.Error().Err(err).Msg("중단된 Job 복구 실패")
+	} else if recovered > 0 {
+		logger.Warn().Int("jobs", recovered).Msg("서버 재시작으로 중단된 Job을 실패 처리했습니다")
+	}
+
+	// GCS 클라이언트 초기화 (설정이 없으면 row 수만 집계)
+	var gcsClient gcs.Client
+	if cfg.HasGCSConfig() {
+		gcsClient, err = newGCSClient(context.Background(), cfg)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("GCS 클라이언트 생성 실패")
+		}
+		defer gcsClient.Close()
+		logger.Info().Str("bucket", cfg.GCS.BucketName).Msg("GCS 클라이언트 생성됨")
+	}
+
+	// Oracle 저장소 및 Job 실행기 초기화 (Oracle 설정이 없으면 데모 모드에서만 Mock 저장소 사용)
+	var oracleRepo oracle.Repository
+	var runner *usecase.JobRunner
+	switch {
+	case cfg.HasOracleConfig():
+		oraclePool, err := newOraclePool(cfg)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Oracle 커넥션 풀 생성 실패")
+		}
+		defer oraclePool.Close()
+		oracleRepo = oraclePool
+		if appMetrics != nil {
+			appMetrics.RegisterDBStats("oracle", oraclePool.Stats)
+		}
+
+		if gcsClient == nil {
+			logger.Warn().Msg("GCS 설정이 없어 추출 데이터가 업로드되지 않습니다")
+		}
+
+		// Transport 생성 시 추출 원본 SQL과 컬럼 필터를 Oracle 스키마로 검증
+		transportSvc.SetSchemaValidator(oraclePool, cfg.Oracle.DefaultOwner)
+
+		// 운영 DB 보호를 위한 추출 부하 조절 (비활성화 시 nil)
+		loadGovernor := newLoadGovernor(cfg, logger, oraclePool)
+		if loadGovernor != nil {
+			governorCtx, governorCancel := context.WithCancel(context.Background())
+			defer governorCancel()
+			go loadGovernor.Run(governorCtx)
+			logger.Info().
+				Int("max_cursors", cfg.ETL.LoadGovernor.MaxCursors).
+				Float64("rows_per_second", cfg.ETL.LoadGovernor.RowsPerSecond).
+				Msg("추출 부하 조절 활성화됨")
+		}
+
+		runner = newJobRunner(cfg, logger, oraclePool, gcsClient, transportSvc, jobSvc, watermarkSvc, broadcaster, appMetrics, loadGovernor)
+		runner.SetMaintenance(maintenanceCfg)
+		logger.Info().Str("tns_name", cfg.Oracle.TNSName).Msg("Oracle 커넥션 풀 생성됨")
+
+		// 재시작 전에 유지보수 시간대를 기다리던 Job을 다시 예약
+		if resumed, err := runner.ResumeDeferred(context.Background()); err != nil {
+			logger.Error().Err(err).Msg("대기 중이던 Job 재예약 실패")
+		} else if resumed > 0 {
+			logger.Info().Int("jobs", resumed).Msg("유지보수 시간대를 기다리던 Job을 다시 예약했습니다")
+		}
+	case cfg.IsDemoMode():
+		oracleRepo = oracle.NewMockRepository()
+		logger.Warn().Msg("데모 모드: Mock 저장소로 테이블 조회 API를 제공하며 Transport 실행은 비활성화됩니다")
+	default:
+		logger.Warn().Msg("Oracle 설정이 없어 Transport 실행이 비활성화됩니다")
+	}
+
+	// 스케줄러 시작 (Job 실행기가 있는 경우)
+	if runner != nil && cfg.Scheduler.Enabled {
+		scheduler, err := newScheduler(cfg, logger, transportSvc, runner)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("스케줄러 생성 실패")
+		}
+
+		schedulerCtx, schedulerCancel := context.WithCancel(context.Background())
+		defer schedulerCancel()
+		go scheduler.Run(schedulerCtx)
+	}
 
 	// Fiber 앱 초기화
 	app := setupFiber(cfg, logger)
 
 	// 라우트 설정
-	setupRoutes(app, cfg, transportSvc, jobSvc, broadcaster)
+	setupRoutes(app, cfg, oracleRepo, gcsClient, transportSvc, jobSvc, watermarkSvc, runner, broadcaster, appMetrics)
 
 	// 서버 시작 (goroutine)
 	go func() {
@@ -96,7 +226,227 @@ func main() {
 		Msg("서버 시작됨")
 
 	// Graceful Shutdown 대기
-	waitForShutdown(app, logger, broadcasterCancel)
+	waitForShutdown(app, logger, broadcasterCancel, runner)
+}
+
+// newRepositories는 설정된 저장소 종류에 맞는 Repository들을 생성합니다
+// 반환된 close 함수는 서버 종료 시 호출해야 합니다
+func newRepositories(cfg *config.Config) (repository.TransportRepository, repository.JobRepository, repository.WatermarkRepository, func(), error) {
+	if cfg.Storage.Driver != "bolt" {
+		return memory.NewTransportRepository(), memory.NewJobRepository(), memory.NewWatermarkRepository(), func() {}, nil
+	}
+
+	db, err := bolt.Open(cfg.Storage.Path)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	closeDB := func() { _ = db.Close() }
+	return bolt.NewTransportRepository(db), bolt.NewJobRepository(db), bolt.NewWatermarkRepository(db), closeDB, nil
+}
+
+// newOraclePool은 설정으로부터 Oracle 커넥션 풀을 생성합니다
+func newOraclePool(cfg *config.Config) (*oracle.Pool, error) {
+	poolCfg := oracle.DefaultPoolConfig()
+	poolCfg.WalletPath = cfg.Oracle.WalletPath
+	poolCfg.TNSName = cfg.Oracle.TNSName
+	poolCfg.Username = cfg.Oracle.Username
+	poolCfg.Password = cfg.Oracle.Password
+	poolCfg.PoolMinConns = cfg.Oracle.PoolMin
+	poolCfg.PoolMaxConns = cfg.Oracle.PoolMax
+	poolCfg.FetchArraySize = cfg.Oracle.FetchArraySize
+	poolCfg.PrefetchCount = cfg.Oracle.PrefetchCount
+	poolCfg.DefaultOwner = cfg.Oracle.DefaultOwner
+
+	return oracle.NewPool(poolCfg)
+}
+
+// newGCSClient는 설정으로부터 GCS 클라이언트를 생성합니다
+func newGCSClient(ctx context.Context, cfg *config.Config) (gcs.Client, error) {
+	return gcs.NewClient(ctx, gcs.GCSConfig{
+		ProjectID:       cfg.GCS.ProjectID,
+		BucketName:      cfg.GCS.BucketName,
+		CredentialsFile: cfg.GCS.CredentialsFile,
+		ChunkSize:       cfg.GCS.ChunkSize,
+		Timeout:         cfg.GetGCSTimeout(),
+	})
+}
+
+// newMetrics는 ETL 메트릭을 생성하고 SSE 클라이언트 수를 등록합니다 (metrics.enabled가 false면 nil)
+func newMetrics(cfg *config.Config, broadcaster *sse.Broadcaster) *telemetry.Metrics {
+	if !cfg.Metrics.Enabled {
+		return nil
+	}
+	m := telemetry.NewMetrics()
+	m.RegisterSSEClients(broadcaster.ClientCount)
+	return m
+}
+
+// newJobRunner는 Oracle 저장소로 추출하여 GCS로 업로드하는 JobRunner를 생성합니다
+// gcsClient가 nil이면 업로드 없이 row 수만 집계하고, appMetrics가 nil이면 메트릭을 기록하지 않으며,
+// loadGovernor가 nil이면 추출 부하를 조절하지 않습니다
+func newJobRunner(cfg *config.Config, logger zerolog.Logger, oracleRepo oracle.Repository, gcsClient gcs.Client, transportSvc *usecase.TransportService, jobSvc *usecase.JobService, watermarkSvc *usecase.WatermarkService, broadcaster *sse.Broadcaster, appMetrics *telemetry.Metrics, loadGovernor *governor.Governor) *usecase.JobRunner {
+	bufferConfig := buffer.DefaultConfig()
+	if cfg.ETL.ChunkSize > 0 {
+		bufferConfig = bufferConfig.WithChunkSize(cfg.ETL.ChunkSize)
+	}
+	if cfg.ETL.ChunkMaxBytes > 0 {
+		bufferConfig = bufferConfig.WithChunkMaxBytes(cfg.ETL.ChunkMaxBytes)
+	}
+	if cfg.ETL.LOBMaxInline > 0 {
+		bufferConfig = bufferConfig.WithLOBMaxInlineBytes(cfg.ETL.LOBMaxInline)
+	}
+	if cfg.Oracle.FetchArraySize > 0 {
+		bufferConfig = bufferConfig.WithFetchArraySize(cfg.Oracle.FetchArraySize)
+	}
+
+	executor := usecase.NewParallelExecutor(oracleRepo, gcsClient, broadcaster, cfg.ETL.ParallelTables)
+	executor.SetResilience(newResilienceConfig(cfg))
+	executor.SetGovernor(loadGovernor)
+	if appMetrics != nil {
+		executor.SetMetrics(appMetrics)
+		appMetrics.RegisterWorkerQueue(executor.QueueStats)
+		for name, cb := range executor.CircuitBreakers() {
+			appMetrics.RegisterCircuitBreaker(name, cb)
+		}
+		if loadGovernor != nil {
+			appMetrics.RegisterLoadGovernor(loadGovernor)
+		}
+	}
+
+	return usecase.NewJobRunner(transportSvc, jobSvc, watermarkSvc, executor, broadcaster, usecase.JobRunnerConfig{
+		Owner:        cfg.Oracle.DefaultOwner,
+		Concurrency:  cfg.ETL.ParallelTables,
+		BufferConfig: &bufferConfig,
+		Split: domain.SplitOptions{
+			MinRows:      cfg.ETL.Split.MinRows,
+			RowsPerRange: cfg.ETL.Split.RowsPerRange,
+			MaxRanges:    cfg.ETL.Split.MaxRanges,
+		},
+		Logger:  logger,
+		Metrics: appMetrics,
+	})
+}
+
+// newResilienceConfig는 테이블 재시도와 Oracle/GCS Circuit Breaker 설정을 만듭니다
+// Circuit Breaker는 일시적 장애(resilience.IsTransient)만 실패로 집계합니다
+func newResilienceConfig(cfg *config.Config) usecase.ResilienceConfig {
+	attempts := cfg.ETL.RetryAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	rc := usecase.ResilienceConfig{
+		Retry: resilience.RetryConfig{
+			MaxRetries:   attempts,
+			InitialDelay: cfg.GetRetryBackoff(),
+			MaxDelay:     cfg.GetRetryMaxBackoff(),
+			Multiplier:   2.0,
+			Jitter:       cfg.ETL.RetryJitter,
+		},
+	}
+	if cfg.ETL.CircuitBreaker.Enabled {
+		breakerConfig := resilience.CircuitConfig{
+			FailureThreshold: cfg.ETL.CircuitBreaker.FailureThreshold,
+			SuccessThreshold: cfg.ETL.CircuitBreaker.SuccessThreshold,
+			Timeout:          cfg.GetCircuitOpenTimeout(),
+			IsFailure:        resilience.IsTransient,
+		}
+		rc.OracleBreaker = resilience.NewCircuitBreaker(breakerConfig)
+		rc.GCSBreaker = resilience.NewCircuitBreaker(breakerConfig)
+	}
+	return rc
+}
+
+// newLoadGovernor는 모든 Job의 Oracle 추출 부하를 조절하는 Governor를 생성합니다 (etl.load_governor.enabled가 false면 nil)
+// 부하 지표 기준이 설정된 경우에만 V$SYSMETRIC을 주기적으로 조회합니다
+func newLoadGovernor(cfg *config.Config, logger zerolog.Logger, oracleRepo oracle.Repository) *governor.Governor {
+	lg := cfg.ETL.LoadGovernor
+	if !lg.Enabled {
+		return nil
+	}
+
+	gc := governor.Config{
+		MaxCursors:     lg.MaxCursors,
+		RowsPerSecond:  lg.RowsPerSecond,
+		HostCPU:        governor.Thresholds{Slow: lg.HostCPUSlow, Pause: lg.HostCPUPause},
+		ActiveSessions: governor.Thresholds{Slow: lg.ActiveSessionsSlow, Pause: lg.ActiveSessionsPause},
+		SlowFactor:     lg.SlowFactor,
+		SampleInterval: cfg.GetLoadGovernorSampleInterval(),
+		Logger:         logger.With().Str("component", "load_governor").Logger(),
+	}
+	if lg.HasLoadThresholds() {
+		gc.Sampler = oracleRepo.GetLoadMetrics
+	}
+	return governor.New(gc)
+}
+
+// newMaintenance는 설정의 유지보수 시간대를 파싱합니다
+// 시간대를 지정하지 않은 정의는 scheduler.timezone 기준으로 해석합니다
+func newMaintenance(cfg *config.Config) (usecase.MaintenanceConfig, error) {
+	mc := usecase.MaintenanceConfig{
+		OutsideWindow: domain.OutsideWindowAction(cfg.Maintenance.OutsideWindow),
+		OnClose:       domain.WindowCloseAction(cfg.Maintenance.OnClose),
+	}
+	if len(cfg.Maintenance.Windows) == 0 {
+		return mc, nil
+	}
+
+	windows := make([]maintenance.Window, 0, len(cfg.Maintenance.Windows))
+	for _, wc := range cfg.Maintenance.Windows {
+		tz := wc.Timezone
+		if tz == "" {
+			tz = cfg.Scheduler.Timezone
+		}
+		if tz == "" {
+			tz = maintenance.DefaultTimezone
+		}
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return mc, fmt.Errorf("유지보수 시간대 '%s'의 시간대 로드 실패: %w", wc.Name, err)
+		}
+
+		w := maintenance.Window{
+			Name:     wc.Name,
+			Type:     maintenance.Type(wc.Type),
+			Cron:     wc.Cron,
+			Timezone: tz,
+			Global:   wc.Global,
+		}
+		if wc.Duration != "" {
+			if w.Duration, err = time.ParseDuration(wc.Duration); err != nil {
+				return mc, fmt.Errorf("유지보수 시간대 '%s'의 duration 파싱 실패: %w", wc.Name, err)
+			}
+		}
+		for i, rc := range wc.Ranges {
+			start, err := maintenance.ParseTime(rc.Start, loc, false)
+			if err != nil {
+				return mc, fmt.Errorf("유지보수 시간대 '%s'의 ranges[%d].start 파싱 실패: %w", wc.Name, i, err)
+			}
+			end, err := maintenance.ParseTime(rc.End, loc, true)
+			if err != nil {
+				return mc, fmt.Errorf("유지보수 시간대 '%s'의 ranges[%d].end 파싱 실패: %w", wc.Name, i, err)
+			}
+			w.Ranges = append(w.Ranges, maintenance.Range{Start: start, End: end})
+		}
+		windows = append(windows, w)
+	}
+
+	calendar, err := maintenance.New(windows)
+	if err != nil {
+		return mc, err
+	}
+	mc.Calendar = calendar
+	return mc, nil
+}
+
+// newScheduler는 Transport cron 스케줄러를 생성합니다
+func newScheduler(cfg *config.Config, logger zerolog.Logger, transportSvc *usecase.TransportService, runner *usecase.JobRunner) (*usecase.Scheduler, error) {
+	return usecase.NewScheduler(transportSvc, runner, usecase.SchedulerConfig{
+		Timezone:      cfg.Scheduler.Timezone,
+		OverlapPolicy: usecase.OverlapPolicy(cfg.Scheduler.OverlapPolicy),
+		TickInterval:  cfg.GetSchedulerTickInterval(),
+		MisfireGrace:  cfg.GetSchedulerMisfireGrace(),
+		Logger:        logger.With().Str("component", "scheduler").Logger(),
+	})
 }
 
 // setupFiber는 Fiber 앱을 설정합니다
@@ -121,44 +471,106 @@ func setupFiber(cfg *config.Config, logger zerolog.Logger) *fiber.App {
 		},
 	})
 
-	// 미들웨어 적용
+	// 미들웨어 적용 (CORS preflight는 인증 전에 응답, 요청 제한은 인증 실패 요청에도 적용)
 	app.Use(middleware.NewRecoveryMiddleware(logger))
+	app.Use(middleware.NewTracingMiddleware())
 	app.Use(middleware.NewLoggingMiddleware(logger))
+	app.Use(middleware.NewCORSMiddleware(&cfg.CORS))
+	app.Use(middleware.NewRateLimitMiddleware(&cfg.RateLimit))
+	app.Use(middleware.NewAuthMiddleware(&cfg.Auth))
 
 	return app
 }
 
 // setupRoutes는 API 라우트를 설정합니다
-func setupRoutes(app *fiber.App, cfg *config.Config, transportSvc *usecase.TransportService, jobSvc *usecase.JobService, broadcaster *sse.Broadcaster) {
+// oracleRepo가 nil이면 테이블/Oracle 상태 API는 503(ORACLE_NOT_CONFIGURED)을 반환하고,
+// appMetrics가 nil이면 메트릭 엔드포인트를 등록하지 않습니다
+func setupRoutes(app *fiber.App, cfg *config.Config, oracleRepo oracle.Repository, gcsClient gcs.Client, transportSvc *usecase.TransportService, jobSvc *usecase.JobService, watermarkSvc *usecase.WatermarkService, runner *usecase.JobRunner, broadcaster *sse.Broadcaster, appMetrics *telemetry.Metrics) {
 	// Handlers 초기화
-	healthHandler := handler.NewHealthHandler(cfg.App.Version)
-	transportHandler := handler.NewTransportHandler(transportSvc, jobSvc)
-	jobHandler := handler.NewJobHandler(jobSvc)
+	healthHandler := newHealthHandler(cfg, oracleRepo, gcsClient, runner)
+	tableHandler := handler.NewTableHandler(oracleRepo, tableOwner(cfg))
+	oracleHandler := handler.NewOracleHandler(oracleRepo)
+	transportHandler := handler.NewTransportHandler(transportSvc, jobSvc, watermarkSvc, runner)
+	jobHandler := handler.NewJobHandler(jobSvc, runner)
 	statusHandler := handler.NewStatusHandler(broadcaster)
 
+	// 역할별 권한 (viewer: 조회/미리보기, operator: 실행/취소, admin: Transport 생성/수정/삭제/롤백)
+	viewer := middleware.NewRoleMiddleware(&cfg.Auth, middleware.RoleViewer)
+	operator := middleware.NewRoleMiddleware(&cfg.Auth, middleware.RoleOperator)
+	admin := middleware.NewRoleMiddleware(&cfg.Auth, middleware.RoleAdmin)
+
+	// Prometheus 메트릭 (인증 활성화 시 viewer 이상)
+	if appMetrics != nil {
+		app.Get(cfg.Metrics.Path, viewer, handler.NewMetricsHandler(appMetrics.Registry()).Get)
+	}
+
 	// API 그룹
 	api := app.Group("/api")
 
-	// Health
+	// Health (liveness / readiness, 인증 제외)
 	api.Get("/health", healthHandler.Check)
+	api.Get("/health/ready", healthHandler.Ready)
+
+	// Oracle 상태 및 테이블 조회
+	api.Get("/oracle/status", viewer, oracleHandler.GetStatus)
+	api.Get("/tables", viewer, tableHandler.GetTables)
+	api.Get("/tables/:name/sample", viewer, tableHandler.GetSampleData)
+	api.Get("/tables/:name/columns", viewer, tableHandler.GetTableColumns)
 
 	// Transport CRUD
-	api.Post("/transports", transportHandler.Create)
-	api.Get("/transports", transportHandler.List)
-	api.Get("/transports/:id", transportHandler.GetByID)
-	api.Delete("/transports/:id", transportHandler.Delete)
-	api.Post("/transports/:id/execute", transportHandler.Execute)
+	api.Post("/transports", admin, transportHandler.Create)
+	api.Get("/transports", viewer, transportHandler.List)
+	api.Get("/transports/:id", viewer, transportHandler.GetByID)
+	api.Put("/transports/:id", admin, transportHandler.Update)
+	api.Patch("/transports/:id", admin, transportHandler.Patch)
+	api.Delete("/transports/:id", admin, transportHandler.Delete)
+	api.Post("/transports/:id/execute", operator, transportHandler.Execute)
+	api.Get("/transports/:id/watermarks", viewer, transportHandler.ListWatermarks)
+	api.Get("/transports/:id/revisions", viewer, transportHandler.ListRevisions)
+	api.Get("/transports/:id/revisions/:revision", viewer, transportHandler.GetRevision)
+	api.Get("/transports/:id/revisions/:revision/diff", viewer, transportHandler.DiffRevisions)
+	api.Post("/transports/:id/revisions/:revision/rollback", admin, transportHandler.Rollback)
 
 	// Transport 실시간 상태 (SSE)
-	api.Get("/transports/:id/status", statusHandler.GetStatus)
+	api.Get("/transports/:id/status", viewer, statusHandler.GetStatus)
 
 	// Job 조회
-	api.Get("/jobs", jobHandler.List)
-	api.Get("/jobs/:id", jobHandler.GetByID)
+	api.Get("/jobs", viewer, jobHandler.List)
+	api.Get("/jobs/:id", viewer, jobHandler.GetByID)
+	api.Post("/jobs/:id/cancel", operator, jobHandler.Cancel)
+	api.Post("/jobs/:id/retry", operator, jobHandler.Retry)
+}
+
+// newHealthHandler는 Oracle/GCS 연결 검사를 readiness에, 실행기의 Circuit Breaker 상태를 응답에 등록한 HealthHandler를 생성합니다
+func newHealthHandler(cfg *config.Config, oracleRepo oracle.Repository, gcsClient gcs.Client, runner *usecase.JobRunner) *handler.HealthHandler {
+	healthHandler := handler.NewHealthHandler(cfg.App.Version)
+	healthHandler.SetDemo(cfg.IsDemoMode())
+	if oracleRepo != nil {
+		healthHandler.AddCheck("oracle", oracleRepo.Ping)
+	}
+	if gcsClient != nil {
+		healthHandler.AddCheck("gcs", gcsClient.Ping)
+	}
+	if runner != nil {
+		for name, cb := range runner.CircuitBreakers() {
+			healthHandler.AddCircuitBreaker(name, cb)
+		}
+	}
+	return healthHandler
+}
+
+// tableOwner는 테이블 조회 API의 기본 스키마 소유자를 반환합니다
+// 데모 모드에서 default_owner가 없으면 Mock 저장소의 예시 스키마를 사용합니다
+func tableOwner(cfg *config.Config) string {
+	if cfg.Oracle.DefaultOwner == "" && cfg.IsDemoMode() {
+		return demoOwner
+	}
+	return cfg.Oracle.DefaultOwner
 }
 
 // waitForShutdown은 종료 시그널을 대기하고 graceful shutdown을 수행합니다
-func waitForShutdown(app *fiber.App, logger zerolog.Logger, broadcasterCancel context.CancelFunc) {
+// 실행 중인 Job은 반환 전에 중단하고 종료 상태 기록까지 대기하므로, 이후 실행되는 저장소/Oracle/GCS 정리(defer)와 겹치지 않습니다
+func waitForShutdown(app *fiber.App, logger zerolog.Logger, broadcasterCancel context.CancelFunc, runner *usecase.JobRunner) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
@@ -177,5 +589,16 @@ func waitForShutdown(app *fiber.App, logger zerolog.Logger, broadcasterCancel co
 		logger.Error().Err(err).Msg("서버 종료 중 오류 발생")
 	}
 
+	// 실행 중인 Job 중단 및 종료 대기
+	if runner != nil {
+		jobCtx, jobCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer jobCancel()
+		if err := runner.Shutdown(jobCtx); err != nil {
+			logger.Error().Err(err).Msg("실행 중인 Job 종료 대기 시간 초과")
+		} else {
+			logger.Info().Msg("실행 중인 Job 종료됨")
+		}
+	}
+
 	logger.Info().Msg("서버 종료 완료")
 }
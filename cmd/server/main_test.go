@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
@@ -14,9 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"oracle-etl/internal/adapter/handler"
+	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/adapter/sse"
 	"oracle-etl/internal/config"
 	"oracle-etl/internal/domain"
+	"oracle-etl/internal/governor"
 	"oracle-etl/internal/repository/memory"
 	"oracle-etl/internal/usecase"
 )
@@ -24,6 +28,12 @@ import (
 // createTestApp은 테스트용 앱을 생성합니다
 func createTestApp(t *testing.T) (*fiber.App, *config.Config, *sse.Broadcaster, context.CancelFunc) {
 	t.Helper()
+	return createTestAppWithRepo(t, oracle.NewMockRepository())
+}
+
+// createTestAppWithRepo는 지정한 Oracle 저장소로 테스트용 앱을 생성합니다 (nil이면 Oracle 미설정)
+func createTestAppWithRepo(t *testing.T, oracleRepo oracle.Repository) (*fiber.App, *config.Config, *sse.Broadcaster, context.CancelFunc) {
+	t.Helper()
 
 	logger := zerolog.Nop()
 	cfg := &config.Config{
@@ -43,6 +53,7 @@ func createTestApp(t *testing.T) (*fiber.App, *config.Config, *sse.Broadcaster,
 	jobRepo := memory.NewJobRepository()
 	transportSvc := usecase.NewTransportService(transportRepo)
 	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
+	watermarkSvc := usecase.NewWatermarkService(memory.NewWatermarkRepository())
 
 	// SSE Broadcaster 생성 및 시작
 	broadcaster := sse.NewBroadcaster()
@@ -50,8 +61,13 @@ func createTestApp(t *testing.T) (*fiber.App, *config.Config, *sse.Broadcaster,
 	t.Cleanup(cancel)
 	go broadcaster.Run(ctx)
 
+	var runner *usecase.JobRunner
+	if oracleRepo != nil {
+		runner = newJobRunner(cfg, logger, oracleRepo, nil, transportSvc, jobSvc, watermarkSvc, broadcaster, nil, nil)
+	}
+
 	app := setupFiber(cfg, logger)
-	setupRoutes(app, cfg, transportSvc, jobSvc, broadcaster)
+	setupRoutes(app, cfg, oracleRepo, nil, transportSvc, jobSvc, watermarkSvc, runner, broadcaster, nil)
 
 	return app, cfg, broadcaster, cancel
 }
@@ -103,6 +119,329 @@ func TestSetupRoutes_HealthEndpoint(t *testing.T) {
 	assert.Equal(t, "1.0.0", result["version"])
 }
 
+// TestSetupRoutes_ReadinessEndpoint는 readiness가 Oracle 연결 상태를 반영하는지 테스트합니다
+func TestSetupRoutes_ReadinessEndpoint(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	app, _, _, _ := createTestAppWithRepo(t, mockRepo)
+
+	req := httptest.NewRequest("GET", "/api/health/ready", nil)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var result handler.HealthResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, "ok", result.Status)
+	assert.Equal(t, "ok", result.Checks["oracle"].Status)
+	assert.NotContains(t, result.Checks, "gcs")
+	assert.True(t, mockRepo.PingCalled)
+
+	// Oracle 연결 실패 시 readiness는 503, liveness는 200 유지
+	mockRepo.ShouldError = true
+	mockRepo.ErrorMessage = "ORA-12541: TNS:no listener"
+	resp, err = app.Test(httptest.NewRequest("GET", "/api/health/ready", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, "unavailable", result.Status)
+	assert.Equal(t, "ORA-12541: TNS:no listener", result.Checks["oracle"].Error)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/api/health", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+// TestSetupRoutes_TableEndpoints는 테이블 조회와 Oracle 상태 라우트를 테스트합니다
+func TestSetupRoutes_TableEndpoints(t *testing.T) {
+	app, _, _, _ := createTestApp(t)
+
+	for _, path := range []string{
+		"/api/oracle/status",
+		"/api/tables?owner=SAPSR3",
+		"/api/tables/VBRP/sample?owner=SAPSR3",
+		"/api/tables/VBRP/columns?owner=SAPSR3",
+	} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil), -1)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, path)
+	}
+}
+
+// TestSetupRoutes_OracleNotConfigured는 Oracle 저장소가 없으면 테이블 조회 API가 503을 반환하는지 테스트합니다
+func TestSetupRoutes_OracleNotConfigured(t *testing.T) {
+	app, _, _, _ := createTestAppWithRepo(t, nil)
+
+	for _, path := range []string{"/api/oracle/status", "/api/tables?owner=SAPSR3", "/api/tables/VBRP/columns?owner=SAPSR3"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil), -1)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode, path)
+
+		var result map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "ORACLE_NOT_CONFIGURED", result["code"])
+	}
+
+	// 검사할 의존성이 없으면 readiness는 ok
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/health/ready", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+// TestSetupRoutes_RolePermissions는 인증 활성화 시 역할별 라우트 권한을 테스트합니다
+func TestSetupRoutes_RolePermissions(t *testing.T) {
+	logger := zerolog.Nop()
+	cfg := &config.Config{
+		Server: config.ServerConfig{ReadTimeout: "10s", WriteTimeout: "60s"},
+		App:    config.AppConfig{Name: "test-app", Version: "1.0.0"},
+		Auth: config.AuthConfig{
+			Enabled: true,
+			Keys: []config.APIKeyConfig{
+				{Name: "dashboard", Key: "viewer-key", Role: "viewer"},
+				{Name: "scheduler", Key: "operator-key", Role: "operator"},
+				{Name: "platform", Key: "admin-key", Role: "admin"},
+			},
+		},
+		CORS: config.CORSConfig{
+			Enabled:      true,
+			AllowOrigins: []string{"https://etl.example.com"},
+			AllowMethods: []string{"GET", "POST", "DELETE"},
+			AllowHeaders: []string{"Content-Type", "X-API-Key"},
+		},
+	}
+
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	jobSvc := usecase.NewJobService(memory.NewJobRepository(), transportRepo)
+	watermarkSvc := usecase.NewWatermarkService(memory.NewWatermarkRepository())
+	broadcaster := sse.NewBroadcaster()
+	mockRepo := oracle.NewMockRepository()
+	runner := newJobRunner(cfg, logger, mockRepo, nil, transportSvc, jobSvc, watermarkSvc, broadcaster, nil, nil)
+
+	app := setupFiber(cfg, logger)
+	setupRoutes(app, cfg, mockRepo, nil, transportSvc, jobSvc, watermarkSvc, runner, broadcaster, nil)
+
+	request := func(method, path, key string, body []byte) *http.Response {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+
+	body, _ := json.Marshal(domain.CreateTransportRequest{Name: "Test Transport", Tables: []string{"VBRP"}})
+
+	// 인증 정보 없음은 401, health는 인증 제외
+	assert.Equal(t, fiber.StatusUnauthorized, request("GET", "/api/transports", "", nil).StatusCode)
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/health", "", nil).StatusCode)
+
+	// viewer는 조회만 가능
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/tables?owner=SAPSR3", "viewer-key", nil).StatusCode)
+	resp := request("POST", "/api/transports", "viewer-key", body)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	var errResp map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, "FORBIDDEN", errResp["code"])
+
+	// admin은 생성 가능, operator는 생성 불가
+	assert.Equal(t, fiber.StatusForbidden, request("POST", "/api/transports", "operator-key", body).StatusCode)
+	resp = request("POST", "/api/transports", "admin-key", body)
+	require.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var transport domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&transport))
+
+	// operator는 실행 가능, viewer는 실행 불가
+	assert.Equal(t, fiber.StatusForbidden, request("POST", "/api/transports/"+transport.ID+"/execute", "viewer-key", nil).StatusCode)
+	assert.Equal(t, fiber.StatusAccepted, request("POST", "/api/transports/"+transport.ID+"/execute", "operator-key", nil).StatusCode)
+
+	// operator는 삭제 불가
+	assert.Equal(t, fiber.StatusForbidden, request("DELETE", "/api/transports/"+transport.ID, "operator-key", nil).StatusCode)
+
+	// 정의 수정과 롤백은 admin만, 리비전 조회는 viewer도 가능
+	patch := []byte(`{"description":"수정"}`)
+	assert.Equal(t, fiber.StatusForbidden, request("PATCH", "/api/transports/"+transport.ID, "operator-key", patch).StatusCode)
+	assert.Equal(t, fiber.StatusOK, request("PATCH", "/api/transports/"+transport.ID, "admin-key", patch).StatusCode)
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/transports/"+transport.ID+"/revisions", "viewer-key", nil).StatusCode)
+	assert.Equal(t, fiber.StatusForbidden, request("POST", "/api/transports/"+transport.ID+"/revisions/1/rollback", "operator-key", nil).StatusCode)
+
+	// CORS preflight는 인증 없이 응답
+	req := httptest.NewRequest("OPTIONS", "/api/transports", nil)
+	req.Header.Set("Origin", "https://etl.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "https://etl.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+}
+
+// TestSetupRoutes_Metrics는 메트릭 엔드포인트 등록과 권한을 테스트합니다
+func TestSetupRoutes_Metrics(t *testing.T) {
+	logger := zerolog.Nop()
+	cfg := &config.Config{
+		App:     config.AppConfig{Name: "test-app", Version: "1.0.0"},
+		Metrics: config.MetricsConfig{Enabled: true, Path: "/metrics"},
+		Auth: config.AuthConfig{
+			Enabled: true,
+			Keys:    []config.APIKeyConfig{{Name: "prometheus", Key: "viewer-key", Role: "viewer"}},
+		},
+		ETL: config.ETLConfig{
+			CircuitBreaker: config.CircuitBreakerConfig{Enabled: true, FailureThreshold: 5, SuccessThreshold: 2},
+		},
+	}
+
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	jobSvc := usecase.NewJobService(memory.NewJobRepository(), transportRepo)
+	watermarkSvc := usecase.NewWatermarkService(memory.NewWatermarkRepository())
+	broadcaster := sse.NewBroadcaster()
+	mockRepo := oracle.NewMockRepository()
+	appMetrics := newMetrics(cfg, broadcaster)
+	require.NotNil(t, appMetrics)
+	runner := newJobRunner(cfg, logger, mockRepo, nil, transportSvc, jobSvc, watermarkSvc, broadcaster, appMetrics, nil)
+
+	app := setupFiber(cfg, logger)
+	setupRoutes(app, cfg, mockRepo, nil, transportSvc, jobSvc, watermarkSvc, runner, broadcaster, appMetrics)
+
+	// 인증 정보 없음은 401
+	resp, err := app.Test(httptest.NewRequest("GET", "/metrics", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("X-API-Key", "viewer-key")
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "oracle_etl_sse_clients 0")
+	assert.Contains(t, string(body), "oracle_etl_worker_queue_depth 0")
+	assert.Contains(t, string(body), `oracle_etl_circuit_breaker_state{name="oracle"} 0`)
+	assert.Contains(t, string(body), `oracle_etl_circuit_breaker_state{name="gcs"} 0`)
+
+	// 비활성화 시 등록하지 않음
+	cfg.Metrics.Enabled = false
+	assert.Nil(t, newMetrics(cfg, broadcaster))
+}
+
+// TestNewResilienceConfig는 재시도/Circuit Breaker 설정 변환을 테스트합니다
+func TestNewResilienceConfig(t *testing.T) {
+	cfg := &config.Config{ETL: config.ETLConfig{
+		RetryAttempts:   4,
+		RetryBackoff:    "500ms",
+		RetryMaxBackoff: "10s",
+		RetryJitter:     0.1,
+		CircuitBreaker:  config.CircuitBreakerConfig{Enabled: true, FailureThreshold: 3, SuccessThreshold: 1, OpenTimeout: "1m"},
+	}}
+
+	rc := newResilienceConfig(cfg)
+	assert.Equal(t, 4, rc.Retry.MaxRetries)
+	assert.Equal(t, 500*time.Millisecond, rc.Retry.InitialDelay)
+	assert.Equal(t, 10*time.Second, rc.Retry.MaxDelay)
+	assert.Equal(t, 0.1, rc.Retry.Jitter)
+	require.NotNil(t, rc.OracleBreaker)
+	require.NotNil(t, rc.GCSBreaker)
+	assert.NotSame(t, rc.OracleBreaker, rc.GCSBreaker)
+
+	// 재시도 횟수가 없으면 한 번만 시도, 비활성화 시 Circuit Breaker 없음
+	rc = newResilienceConfig(&config.Config{})
+	assert.Equal(t, 1, rc.Retry.MaxRetries)
+	assert.Nil(t, rc.OracleBreaker)
+	assert.Nil(t, rc.GCSBreaker)
+}
+
+// TestNewLoadGovernor는 부하 조절 설정으로 Governor를 만드는지 테스트합니다
+func TestNewLoadGovernor(t *testing.T) {
+	logger := zerolog.Nop()
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockLoad = &domain.LoadMetrics{HostCPUPercent: 95}
+
+	// 비활성화 시 nil
+	assert.Nil(t, newLoadGovernor(&config.Config{}, logger, mockRepo))
+
+	cfg := &config.Config{ETL: config.ETLConfig{LoadGovernor: config.LoadGovernorConfig{
+		Enabled:       true,
+		MaxCursors:    6,
+		RowsPerSecond: 20000,
+		HostCPUSlow:   70,
+		HostCPUPause:  85,
+		SlowFactor:    0.5,
+	}}}
+	g := newLoadGovernor(cfg, logger, mockRepo)
+	require.NotNil(t, g)
+	status := g.Status()
+	assert.Equal(t, 6, status.MaxCursors)
+	assert.Equal(t, 20000.0, status.RowsPerSecond)
+
+	// 부하 지표 기준이 있으면 Oracle 부하 지표를 조회
+	require.NoError(t, g.Sample(context.Background()))
+	assert.Equal(t, governor.ModePaused, g.Status().Mode)
+
+	// 부하 지표 기준이 없으면 커서 수/row 속도만 제한
+	cfg.ETL.LoadGovernor.HostCPUSlow = 0
+	cfg.ETL.LoadGovernor.HostCPUPause = 0
+	g = newLoadGovernor(cfg, logger, mockRepo)
+	require.NoError(t, g.Sample(context.Background()))
+	assert.Equal(t, governor.ModeNormal, g.Status().Mode)
+}
+
+// TestNewMaintenance는 설정의 유지보수 시간대 파싱을 테스트합니다
+func TestNewMaintenance(t *testing.T) {
+	// 시간대가 없으면 제한 없음
+	mc, err := newMaintenance(&config.Config{Maintenance: config.MaintenanceConfig{OutsideWindow: "defer"}})
+	require.NoError(t, err)
+	assert.Nil(t, mc.Calendar)
+	assert.Equal(t, domain.OutsideWindowDefer, mc.OutsideWindow)
+
+	cfg := &config.Config{
+		Scheduler: config.SchedulerConfig{Timezone: "Asia/Seoul"},
+		Maintenance: config.MaintenanceConfig{
+			OnClose: "pause",
+			Windows: []config.MaintenanceWindowConfig{
+				{Name: "night", Type: "allow", Cron: "0 22 * * *", Duration: "8h"},
+				{Name: "month_end_close", Type: "blackout", Global: true, Ranges: []config.MaintenanceRangeConfig{
+					{Start: "2026-10-29", End: "2026-11-02"},
+				}},
+			},
+		},
+	}
+	mc, err = newMaintenance(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mc.Calendar)
+	assert.Equal(t, domain.WindowClosePause, mc.OnClose)
+	assert.True(t, mc.Calendar.Has("night"))
+
+	// 날짜만 지정한 구간은 scheduler.timezone 기준 마지막 날 전체를 포함
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	assert.False(t, mc.Calendar.Allowed(nil, time.Date(2026, 11, 2, 23, 59, 0, 0, seoul)))
+	assert.True(t, mc.Calendar.Allowed(nil, time.Date(2026, 11, 3, 0, 0, 0, 0, seoul)))
+	assert.True(t, mc.Calendar.Allowed([]string{"night"}, time.Date(2026, 10, 15, 23, 0, 0, 0, seoul)))
+	assert.False(t, mc.Calendar.Allowed([]string{"night"}, time.Date(2026, 10, 15, 12, 0, 0, 0, seoul)))
+
+	// 잘못된 정의
+	cfg.Maintenance.Windows[1].Ranges[0].End = "soon"
+	_, err = newMaintenance(cfg)
+	assert.Error(t, err)
+	cfg.Maintenance.Windows[1].Ranges[0].End = "2026-11-02"
+	cfg.Maintenance.Windows[0].Cron = ""
+	_, err = newMaintenance(cfg)
+	assert.Error(t, err)
+}
+
+// TestTableOwner는 데모 모드의 기본 스키마 소유자를 테스트합니다
+func TestTableOwner(t *testing.T) {
+	cfg := &config.Config{App: config.AppConfig{DemoMode: true}}
+	assert.Equal(t, demoOwner, tableOwner(cfg))
+
+	cfg.Oracle.DefaultOwner = "APPS"
+	assert.Equal(t, "APPS", tableOwner(cfg))
+
+	assert.Empty(t, tableOwner(&config.Config{}))
+}
+
 // TestSetupFiber_ErrorHandler는 에러 핸들러를 테스트합니다
 func TestSetupFiber_ErrorHandler(t *testing.T) {
 	logger := zerolog.Nop()
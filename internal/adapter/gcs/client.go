@@ -3,14 +3,21 @@
 package gcs
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
+
+	"oracle-etl/internal/domain"
 )
 
 // 기본 상수 정의
@@ -60,11 +67,29 @@ type Client interface {
 	// NewWriter는 객체에 쓰기 위한 Writer를 생성합니다
 	NewWriter(ctx context.Context, objectPath string) (io.WriteCloser, error)
 
-	// ObjectPath는 표준 객체 경로를 생성합니다
-	ObjectPath(transportID, jobVersion, tableName string) string
+	// ObjectPath는 출력 형식에 맞는 확장자로 표준 객체 경로를 생성합니다
+	ObjectPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string
 
 	// FullGCSPath는 전체 GCS URI를 반환합니다
-	FullGCSPath(transportID, jobVersion, tableName string) string
+	FullGCSPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string
+
+	// PartObjectPath는 분할 추출된 테이블의 part 객체 경로를 생성합니다
+	PartObjectPath(transportID, jobVersion, tableName string, part int, format domain.OutputFormat) string
+
+	// PartPrefix는 분할 추출된 테이블의 part 객체들이 위치한 GCS URI prefix를 반환합니다
+	PartPrefix(transportID, jobVersion, tableName string) string
+
+	// VersionObjectPath는 Job 버전 prefix 바로 아래의 객체 경로(manifest, marker 등)를 생성합니다
+	VersionObjectPath(transportID, jobVersion, name string) string
+
+	// LOBObjectPath는 object 정책 LOB 값의 객체 경로를 생성합니다
+	LOBObjectPath(transportID, jobVersion, tableName, column string, part int, row int64) string
+
+	// DeleteObject는 객체를 삭제합니다 (객체가 없으면 무시)
+	DeleteObject(ctx context.Context, objectPath string) error
+
+	// DeleteTableObjects는 테이블의 단일 객체와 part 객체를 모두 삭제하고 삭제된 객체 수를 반환합니다
+	DeleteTableObjects(ctx context.Context, transportID, jobVersion, tableName string) (int, error)
 
 	// BucketName은 버킷 이름을 반환합니다
 	BucketName() string
@@ -122,25 +147,119 @@ func (c *gcsClient) NewWriter(ctx context.Context, objectPath string) (io.WriteC
 	writer := obj.NewWriter(ctx)
 
 	// Resumable 업로드를 위한 청크 크기 설정
+	// writer마다 청크 크기만큼 버퍼를 할당하므로 row마다 생성되는 LOB 객체는 작은 청크를 사용
 	writer.ChunkSize = c.config.ChunkSize
+	if strings.HasSuffix(objectPath, lobObjectExtension) && writer.ChunkSize > lobChunkSize {
+		writer.ChunkSize = lobChunkSize
+	}
 
-	// Content-Type 설정
-	writer.ContentType = "application/gzip"
-	writer.ContentEncoding = "gzip"
+	writer.ContentType, writer.ContentEncoding = contentType(objectPath)
 
 	return writer, nil
 }
 
+// contentType은 객체 경로의 확장자로 Content-Type과 Content-Encoding을 결정합니다
+// Parquet은 자체 압축을 사용하므로 Content-Encoding을 지정하지 않습니다
+func contentType(objectPath string) (string, string) {
+	switch {
+	case strings.HasSuffix(objectPath, domain.OutputFormatParquet.Extension()):
+		return "application/vnd.apache.parquet", ""
+	case strings.HasSuffix(objectPath, domain.OutputFormatJSONL.Extension()):
+		return "application/gzip", "gzip"
+	case strings.HasSuffix(objectPath, ".json"):
+		return "application/json", ""
+	case strings.HasSuffix(objectPath, lobObjectExtension):
+		return "application/octet-stream", ""
+	default:
+		return "text/plain; charset=utf-8", ""
+	}
+}
+
 // ObjectPath는 표준 객체 경로를 생성합니다
-// 패턴: {transport_id}/{job_version}/{table_name}.jsonl.gz
-func (c *gcsClient) ObjectPath(transportID, jobVersion, tableName string) string {
-	return fmt.Sprintf("%s/%s/%s.jsonl.gz", transportID, jobVersion, tableName)
+// 패턴: {transport_id}/{job_version}/{table_name}.jsonl.gz (Parquet은 .parquet)
+func (c *gcsClient) ObjectPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string {
+	return fmt.Sprintf("%s/%s/%s%s", transportID, jobVersion, tableName, format.Extension())
 }
 
 // FullGCSPath는 전체 GCS URI를 반환합니다
-// 패턴: gs://{bucket}/{transport_id}/{job_version}/{table_name}.jsonl.gz
-func (c *gcsClient) FullGCSPath(transportID, jobVersion, tableName string) string {
-	return fmt.Sprintf("gs://%s/%s", c.config.BucketName, c.ObjectPath(transportID, jobVersion, tableName))
+// 패턴: gs://{bucket}/{transport_id}/{job_version}/{table_name}.jsonl.gz (Parquet은 .parquet)
+func (c *gcsClient) FullGCSPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string {
+	return fmt.Sprintf("gs://%s/%s", c.config.BucketName, c.ObjectPath(transportID, jobVersion, tableName, format))
+}
+
+// PartObjectPath는 분할 추출된 테이블의 part 객체 경로를 생성합니다
+// 패턴: {transport_id}/{job_version}/{table_name}/part-{part:05d}.jsonl.gz (Parquet은 .parquet)
+func (c *gcsClient) PartObjectPath(transportID, jobVersion, tableName string, part int, format domain.OutputFormat) string {
+	return fmt.Sprintf("%s/%s/%s/part-%05d%s", transportID, jobVersion, tableName, part, format.Extension())
+}
+
+// PartPrefix는 part 객체들이 위치한 GCS URI prefix를 반환합니다
+// 패턴: gs://{bucket}/{transport_id}/{job_version}/{table_name}/
+func (c *gcsClient) PartPrefix(transportID, jobVersion, tableName string) string {
+	return fmt.Sprintf("gs://%s/%s/%s/%s/", c.config.BucketName, transportID, jobVersion, tableName)
+}
+
+// VersionObjectPath는 Job 버전 prefix 아래의 객체 경로를 생성합니다
+// 패턴: {transport_id}/{job_version}/{name}
+func (c *gcsClient) VersionObjectPath(transportID, jobVersion, name string) string {
+	return fmt.Sprintf("%s/%s/%s", transportID, jobVersion, name)
+}
+
+// LOBObjectPath는 object 정책 LOB 값의 객체 경로를 생성합니다
+// 패턴: {transport_id}/{job_version}/{table_name}/_lobs/{column}/{part:05d}-{row:012d}.bin
+// 테이블 prefix 아래에 두어 재시도 시 테이블 객체와 함께 정리되고, '_' 접두어로 part 객체 목록과 구분됩니다
+func (c *gcsClient) LOBObjectPath(transportID, jobVersion, tableName, column string, part int, row int64) string {
+	return lobObjectPath(transportID, jobVersion, tableName, column, part, row)
+}
+
+// DeleteObject는 객체를 삭제합니다 (객체가 없으면 무시)
+func (c *gcsClient) DeleteObject(ctx context.Context, objectPath string) error {
+	if err := c.bucket.Object(objectPath).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("GCS 객체 삭제 실패 (%s): %w", objectPath, err)
+	}
+	return nil
+}
+
+// DeleteTableObjects는 테이블의 단일 객체와 part 객체를 모두 삭제합니다
+// 패턴: {transport_id}/{job_version}/{table_name}.* 및 {transport_id}/{job_version}/{table_name}/*
+func (c *gcsClient) DeleteTableObjects(ctx context.Context, transportID, jobVersion, tableName string) (int, error) {
+	deleted := 0
+	for _, prefix := range tableObjectPrefixes(transportID, jobVersion, tableName) {
+		it := c.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+		for {
+			attrs, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return deleted, fmt.Errorf("GCS 객체 목록 조회 실패: %w", err)
+			}
+			if err := c.bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+				return deleted, fmt.Errorf("GCS 객체 삭제 실패 (%s): %w", attrs.Name, err)
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+const (
+	// lobObjectExtension은 LOB 객체의 확장자입니다
+	lobObjectExtension = ".bin"
+	// lobChunkSize는 LOB 객체 업로드의 resumable 청크 크기입니다 (1MB)
+	lobChunkSize = 1024 * 1024
+)
+
+// lobObjectPath는 LOB 객체 경로를 생성합니다
+func lobObjectPath(transportID, jobVersion, tableName, column string, part int, row int64) string {
+	return fmt.Sprintf("%s/%s/%s/_lobs/%s/%05d-%012d%s", transportID, jobVersion, tableName, column, part, row, lobObjectExtension)
+}
+
+// tableObjectPrefixes는 테이블 객체를 찾기 위한 prefix 목록을 반환합니다
+// 이름이 같은 접두어로 시작하는 다른 테이블(VBRK, VBRK_X)을 포함하지 않도록 구분자까지 포함합니다
+func tableObjectPrefixes(transportID, jobVersion, tableName string) []string {
+	base := fmt.Sprintf("%s/%s/%s", transportID, jobVersion, tableName)
+	return []string{base + ".", base + "/"}
 }
 
 // BucketName은 버킷 이름을 반환합니다
@@ -154,17 +273,26 @@ func (c *gcsClient) Close() error {
 }
 
 // MockClient는 테스트용 Mock GCS 클라이언트입니다
+// Close가 성공한 객체의 내용을 메모리에 보관합니다
 type MockClient struct {
 	config GCSConfig
 	closed bool
+
+	mu      sync.Mutex
+	objects map[string][]byte // 완료된 객체 내용 (objectPath -> data)
+
+	// 테스트 설정 필드
+	WriteError error // Writer.Write 호출 시 반환할 에러
+	CloseError error // Writer.Close 호출 시 반환할 에러
 }
 
 // NewMockClient는 테스트용 Mock 클라이언트를 생성합니다
 func NewMockClient(config GCSConfig) Client {
 	config.ApplyDefaults()
 	return &MockClient{
-		config: config,
-		closed: false,
+		config:  config,
+		closed:  false,
+		objects: make(map[string][]byte),
 	}
 }
 
@@ -175,17 +303,85 @@ func (m *MockClient) Ping(ctx context.Context) error {
 
 // NewWriter는 Mock Writer를 반환합니다
 func (m *MockClient) NewWriter(ctx context.Context, objectPath string) (io.WriteCloser, error) {
-	return &mockWriter{}, nil
+	return &mockWriter{ctx: ctx, client: m, objectPath: objectPath}, nil
+}
+
+// Object는 완료된 객체의 내용을 반환합니다
+func (m *MockClient) Object(objectPath string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.objects[objectPath]
+	return data, ok
+}
+
+// ObjectPaths는 완료된 객체 경로 목록을 정렬하여 반환합니다
+func (m *MockClient) ObjectPaths() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	paths := make([]string, 0, len(m.objects))
+	for path := range m.objects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
 }
 
 // ObjectPath는 표준 객체 경로를 생성합니다
-func (m *MockClient) ObjectPath(transportID, jobVersion, tableName string) string {
-	return fmt.Sprintf("%s/%s/%s.jsonl.gz", transportID, jobVersion, tableName)
+func (m *MockClient) ObjectPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string {
+	return fmt.Sprintf("%s/%s/%s%s", transportID, jobVersion, tableName, format.Extension())
 }
 
 // FullGCSPath는 전체 GCS URI를 반환합니다
-func (m *MockClient) FullGCSPath(transportID, jobVersion, tableName string) string {
-	return fmt.Sprintf("gs://%s/%s", m.config.BucketName, m.ObjectPath(transportID, jobVersion, tableName))
+func (m *MockClient) FullGCSPath(transportID, jobVersion, tableName string, format domain.OutputFormat) string {
+	return fmt.Sprintf("gs://%s/%s", m.config.BucketName, m.ObjectPath(transportID, jobVersion, tableName, format))
+}
+
+// PartObjectPath는 분할 추출된 테이블의 part 객체 경로를 생성합니다
+func (m *MockClient) PartObjectPath(transportID, jobVersion, tableName string, part int, format domain.OutputFormat) string {
+	return fmt.Sprintf("%s/%s/%s/part-%05d%s", transportID, jobVersion, tableName, part, format.Extension())
+}
+
+// PartPrefix는 part 객체들이 위치한 GCS URI prefix를 반환합니다
+func (m *MockClient) PartPrefix(transportID, jobVersion, tableName string) string {
+	return fmt.Sprintf("gs://%s/%s/%s/%s/", m.config.BucketName, transportID, jobVersion, tableName)
+}
+
+// VersionObjectPath는 Job 버전 prefix 아래의 객체 경로를 생성합니다
+func (m *MockClient) VersionObjectPath(transportID, jobVersion, name string) string {
+	return fmt.Sprintf("%s/%s/%s", transportID, jobVersion, name)
+}
+
+// LOBObjectPath는 object 정책 LOB 값의 객체 경로를 생성합니다
+func (m *MockClient) LOBObjectPath(transportID, jobVersion, tableName, column string, part int, row int64) string {
+	return lobObjectPath(transportID, jobVersion, tableName, column, part, row)
+}
+
+// DeleteObject는 객체를 삭제합니다 (객체가 없으면 무시)
+func (m *MockClient) DeleteObject(ctx context.Context, objectPath string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.objects, objectPath)
+	return nil
+}
+
+// DeleteTableObjects는 테이블의 단일 객체와 part 객체를 모두 삭제합니다
+func (m *MockClient) DeleteTableObjects(ctx context.Context, transportID, jobVersion, tableName string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleted := 0
+	for _, prefix := range tableObjectPrefixes(transportID, jobVersion, tableName) {
+		for path := range m.objects {
+			if strings.HasPrefix(path, prefix) {
+				delete(m.objects, path)
+				deleted++
+			}
+		}
+	}
+	return deleted, nil
 }
 
 // BucketName은 버킷 이름을 반환합니다
@@ -200,16 +396,34 @@ func (m *MockClient) Close() error {
 }
 
 // mockWriter는 테스트용 Mock io.WriteCloser입니다
+// 실제 GCS Writer와 동일하게 컨텍스트가 취소된 경우 객체를 생성하지 않습니다
 type mockWriter struct {
+	ctx          context.Context
+	client       *MockClient
+	objectPath   string
+	buffer       bytes.Buffer
 	bytesWritten int64
 }
 
 func (w *mockWriter) Write(p []byte) (n int, err error) {
-	n = len(p)
+	if w.client.WriteError != nil {
+		return 0, w.client.WriteError
+	}
+	n, _ = w.buffer.Write(p)
 	w.bytesWritten += int64(n)
 	return n, nil
 }
 
 func (w *mockWriter) Close() error {
+	if w.client.CloseError != nil {
+		return w.client.CloseError
+	}
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+
+	w.client.mu.Lock()
+	defer w.client.mu.Unlock()
+	w.client.objects[w.objectPath] = w.buffer.Bytes()
 	return nil
 }
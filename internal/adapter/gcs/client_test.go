@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
 )
 
 func TestGCSConfig_Validate(t *testing.T) {
@@ -109,6 +111,7 @@ func TestMockClient_ObjectPath(t *testing.T) {
 		transportID string
 		jobVersion  string
 		tableName   string
+		format      domain.OutputFormat
 		expected    string
 	}{
 		{
@@ -121,13 +124,21 @@ func TestMockClient_ObjectPath(t *testing.T) {
 			transportID: "TRP-20260118-001",
 			jobVersion:  "v002",
 			tableName:   "LIKP",
+			format:      domain.OutputFormatJSONL,
 			expected:    "TRP-20260118-001/v002/LIKP.jsonl.gz",
 		},
+		{
+			transportID: "TRP-001",
+			jobVersion:  "v003",
+			tableName:   "VBRK",
+			format:      domain.OutputFormatParquet,
+			expected:    "TRP-001/v003/VBRK.parquet",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.tableName, func(t *testing.T) {
-			path := client.ObjectPath(tt.transportID, tt.jobVersion, tt.tableName)
+			path := client.ObjectPath(tt.transportID, tt.jobVersion, tt.tableName, tt.format)
 			assert.Equal(t, tt.expected, path)
 		})
 	}
@@ -141,11 +152,50 @@ func TestMockClient_FullGCSPath(t *testing.T) {
 
 	client := NewMockClient(config)
 
-	path := client.FullGCSPath("TRP-001", "v001", "VBRP")
+	path := client.FullGCSPath("TRP-001", "v001", "VBRP", domain.OutputFormatJSONL)
 	expected := "gs://oracle-etl-data/TRP-001/v001/VBRP.jsonl.gz"
 	assert.Equal(t, expected, path)
 }
 
+func TestMockClient_PartObjectPath(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "oracle-etl-data"})
+
+	assert.Equal(t, "TRP-001/v001/GL_JE_LINES/part-00003.jsonl.gz", client.PartObjectPath("TRP-001", "v001", "GL_JE_LINES", 3, domain.OutputFormatJSONL))
+	assert.Equal(t, "TRP-001/v001/GL_JE_LINES/part-00003.parquet", client.PartObjectPath("TRP-001", "v001", "GL_JE_LINES", 3, domain.OutputFormatParquet))
+	assert.Equal(t, "gs://oracle-etl-data/TRP-001/v001/GL_JE_LINES/", client.PartPrefix("TRP-001", "v001", "GL_JE_LINES"))
+}
+
+func TestMockClient_LOBObjectPath(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "oracle-etl-data"})
+
+	assert.Equal(t, "TRP-001/v001/FND_LOBS/_lobs/FILE_DATA/00000-000000000007.bin", client.LOBObjectPath("TRP-001", "v001", "FND_LOBS", "FILE_DATA", 0, 7))
+	assert.Equal(t, "TRP-001/v001/FND_LOBS/_lobs/FILE_DATA/00002-000000001234.bin", client.LOBObjectPath("TRP-001", "v001", "FND_LOBS", "FILE_DATA", 2, 1234))
+}
+
+func TestMockClient_DeleteTableObjects(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "oracle-etl-data"})
+	mock := client.(*MockClient)
+	ctx := context.Background()
+
+	paths := []string{
+		"TRP-001/v001/VBRK.jsonl.gz",
+		"TRP-001/v001/VBRK/part-00000.jsonl.gz",
+		"TRP-001/v001/VBRK/part-00001.jsonl.gz",
+		"TRP-001/v001/VBRK_X.jsonl.gz",
+		"TRP-001/v002/VBRK.jsonl.gz",
+	}
+	for _, path := range paths {
+		w, err := client.NewWriter(ctx, path)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+
+	deleted, err := client.DeleteTableObjects(ctx, "TRP-001", "v001", "VBRK")
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+	assert.Equal(t, []string{"TRP-001/v001/VBRK_X.jsonl.gz", "TRP-001/v002/VBRK.jsonl.gz"}, mock.ObjectPaths())
+}
+
 func TestMockClient_BucketName(t *testing.T) {
 	config := GCSConfig{
 		ProjectID:  "test-project",
@@ -167,3 +217,25 @@ func TestMockClient_Close(t *testing.T) {
 	err := client.Close()
 	require.NoError(t, err)
 }
+
+func TestContentType(t *testing.T) {
+	tests := []struct {
+		path     string
+		ctype    string
+		encoding string
+	}{
+		{path: "TRP-001/v001/VBRP.jsonl.gz", ctype: "application/gzip", encoding: "gzip"},
+		{path: "TRP-001/v001/VBRP/part-00000.parquet", ctype: "application/vnd.apache.parquet"},
+		{path: "TRP-001/v001/manifest.json", ctype: "application/json"},
+		{path: "TRP-001/v001/_FAILED", ctype: "text/plain; charset=utf-8"},
+		{path: "TRP-001/v001/FND_LOBS/_lobs/FILE_DATA/00000-000000000001.bin", ctype: "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			ctype, encoding := contentType(tt.path)
+			assert.Equal(t, tt.ctype, ctype)
+			assert.Equal(t, tt.encoding, encoding)
+		})
+	}
+}
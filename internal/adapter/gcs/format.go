@@ -0,0 +1,260 @@
+// Package gcs는 Google Cloud Storage 클라이언트 및 업로드 기능을 제공합니다.
+package gcs
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/pkg/buffer"
+	"oracle-etl/pkg/compress"
+	"oracle-etl/pkg/jsonl"
+	"oracle-etl/pkg/parquet"
+	"oracle-etl/pkg/rowset"
+)
+
+// FormatOptions는 업로드 객체의 출력 형식 설정입니다
+type FormatOptions struct {
+	Format  domain.OutputFormat // 출력 형식 (빈 값이면 JSONL)
+	Columns []domain.ColumnInfo // 컬럼 메타데이터 (Parquet 스키마 생성에 필요)
+	LOBs    domain.LOBPolicies  // LOB 컬럼별 출력 방식 (Parquet 스키마에 반영)
+	Buffer  buffer.Config       // 버퍼 설정 (Parquet row group 크기)
+}
+
+// RowEncoder는 row를 출력 형식으로 인코딩하여 하위 writer에 기록하는 인터페이스입니다
+type RowEncoder interface {
+	// Encode는 단일 row를 인코딩합니다
+	Encode(row rowset.Row) error
+
+	// Close는 남은 데이터와 형식별 trailer(gzip footer, Parquet footer)를 기록합니다
+	// 하위 writer는 닫지 않습니다
+	Close() error
+
+	// BytesWritten은 하위 writer에 기록된 바이트 수를 반환합니다
+	BytesWritten() int64
+
+	// BytesOriginal은 압축 전 바이트 수를 반환합니다
+	BytesOriginal() int64
+
+	// RowsEncoded는 출력 형식으로 인코딩된 row 수를 반환합니다
+	RowsEncoded() int64
+}
+
+// NewRowEncoder는 출력 형식에 맞는 RowEncoder를 생성합니다
+func NewRowEncoder(w io.Writer, opts FormatOptions) (RowEncoder, error) {
+	switch opts.Format {
+	case "", domain.OutputFormatJSONL:
+		gz := compress.NewGzipWriter(w)
+		gzipIn := &timedWriter{w: gz}
+		return &jsonlRowEncoder{gzip: gz, gzipIn: gzipIn, encoder: jsonl.NewEncoder(gzipIn)}, nil
+	case domain.OutputFormatParquet:
+		return newParquetRowEncoder(w, opts)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 출력 형식: %s", opts.Format)
+	}
+}
+
+// jsonlRowEncoder는 JSONL -> Gzip 인코더입니다
+type jsonlRowEncoder struct {
+	gzip    compress.GzipWriter
+	gzipIn  *timedWriter // gzip 입력 (압축 시간 측정)
+	encoder jsonl.Encoder
+}
+
+// Encode는 row를 JSON Lines로 인코딩합니다
+func (e *jsonlRowEncoder) Encode(row rowset.Row) error {
+	return e.encoder.EncodeRow(row)
+}
+
+// Close는 JSONL 버퍼를 플러시하고 gzip 스트림을 닫습니다
+func (e *jsonlRowEncoder) Close() error {
+	if err := e.encoder.Flush(); err != nil {
+		_ = e.gzip.Close() // 에러 경로에서 정리
+		return fmt.Errorf("JSONL 플러시 실패: %w", err)
+	}
+	start := time.Now()
+	err := e.gzip.Close()
+	e.gzipIn.elapsed += time.Since(start)
+	if err != nil {
+		return fmt.Errorf("gzip 스트림 닫기 실패: %w", err)
+	}
+	return nil
+}
+
+// compressElapsed는 gzip 압축(남은 데이터와 footer 기록 포함)에 걸린 누적 시간을 반환합니다
+func (e *jsonlRowEncoder) compressElapsed() time.Duration {
+	return e.gzipIn.elapsed
+}
+
+// BytesWritten은 압축 후 바이트 수를 반환합니다
+func (e *jsonlRowEncoder) BytesWritten() int64 {
+	return e.gzip.BytesWritten()
+}
+
+// BytesOriginal은 압축 전 JSONL 바이트 수를 반환합니다
+func (e *jsonlRowEncoder) BytesOriginal() int64 {
+	return e.gzip.BytesRead()
+}
+
+// RowsEncoded는 JSONL로 인코딩된 row 수를 반환합니다
+func (e *jsonlRowEncoder) RowsEncoded() int64 {
+	return e.encoder.RowsEncoded()
+}
+
+// parquetRowEncoder는 Oracle 컬럼 타입을 Parquet 스키마로 매핑하여 기록하는 인코더입니다
+type parquetRowEncoder struct {
+	writer *parquet.Writer
+	names  []string
+	base64 []bool // Parquet 컬럼별 base64 정책 LOB 여부 (바이너리 값을 base64 문자열로 기록)
+	values []interface{}
+	schema *rowset.Schema // index를 계산한 row 스키마
+	index  []int          // Parquet 컬럼별 row 값 위치 (row에 없으면 -1)
+}
+
+// newParquetRowEncoder는 컬럼 메타데이터로 Parquet 인코더를 생성합니다
+// row group은 메모리에 버퍼링되므로 크기는 buffer.Config.ParquetRowGroupSize를 따릅니다
+// skip 정책 LOB 컬럼은 값이 항상 NULL이므로 스키마에서 제외합니다
+func newParquetRowEncoder(w io.Writer, opts FormatOptions) (RowEncoder, error) {
+	if len(opts.Columns) == 0 {
+		return nil, errors.New("parquet 출력에는 컬럼 정보가 필요합니다")
+	}
+
+	fields := make([]parquet.Field, 0, len(opts.Columns))
+	names := make([]string, 0, len(opts.Columns))
+	encodeBase64 := make([]bool, 0, len(opts.Columns))
+	for _, col := range opts.Columns {
+		policy := opts.LOBs.Policy(col.Name)
+		if policy == domain.LOBPolicySkip {
+			continue
+		}
+		fields = append(fields, ParquetField(col, policy))
+		names = append(names, col.Name)
+		encodeBase64 = append(encodeBase64, policy == domain.LOBPolicyBase64)
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("parquet 출력에 기록할 컬럼이 없습니다 (모든 컬럼이 skip 정책)")
+	}
+
+	writer, err := parquet.NewWriter(w, fields, parquet.WriterOptions{
+		RowGroupSize: int64(opts.Buffer.ParquetRowGroupSize),
+		Codec:        parquet.CodecSnappy,
+		CreatedBy:    "oracle-etl",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parquet writer 생성 실패: %w", err)
+	}
+
+	return &parquetRowEncoder{
+		writer: writer,
+		names:  names,
+		base64: encodeBase64,
+		values: make([]interface{}, len(names)),
+	}, nil
+}
+
+// Encode는 row를 스키마 컬럼 순서로 기록합니다 (row에 없는 컬럼은 NULL)
+// 컬럼 위치는 row 스키마가 바뀔 때만 다시 계산합니다
+func (e *parquetRowEncoder) Encode(row rowset.Row) error {
+	if row.Schema != e.schema {
+		e.schema = row.Schema
+		e.index = e.index[:0]
+		for _, name := range e.names {
+			e.index = append(e.index, row.Schema.Index(name))
+		}
+	}
+	for i, idx := range e.index {
+		if idx < 0 {
+			e.values[i] = nil
+			continue
+		}
+		e.values[i] = row.Interface(idx)
+		if b, ok := e.values[i].([]byte); ok && e.base64[i] {
+			e.values[i] = base64.StdEncoding.EncodeToString(b)
+		}
+	}
+	return e.writer.Write(e.values)
+}
+
+// Close는 남은 row group과 footer를 기록합니다
+func (e *parquetRowEncoder) Close() error {
+	if err := e.writer.Close(); err != nil {
+		return fmt.Errorf("parquet 파일 완료 실패: %w", err)
+	}
+	return nil
+}
+
+// BytesWritten은 기록된 Parquet 파일 바이트 수를 반환합니다
+func (e *parquetRowEncoder) BytesWritten() int64 {
+	return e.writer.BytesWritten()
+}
+
+// BytesOriginal은 압축 전 data page 바이트 수를 반환합니다
+func (e *parquetRowEncoder) BytesOriginal() int64 {
+	return e.writer.BytesOriginal()
+}
+
+// RowsEncoded는 Parquet writer에 기록된 row 수를 반환합니다
+func (e *parquetRowEncoder) RowsEncoded() int64 {
+	return e.writer.RowsWritten()
+}
+
+// ParquetField는 Oracle 컬럼 타입을 Parquet 컬럼으로 매핑합니다
+//   - NUMBER(p,s): DECIMAL(p,s) (음수 scale은 DECIMAL(p-s,0)), NUMBER(*,0): DECIMAL(38,0)
+//   - NUMBER(정밀도 미지정), precision이 38을 넘는 NUMBER, FLOAT: STRING (정밀도 손실 없이 십진 문자열 그대로)
+//   - BINARY_FLOAT/DOUBLE: DOUBLE
+//   - DATE: TIMESTAMP(MILLIS, 로컬 시각)
+//   - TIMESTAMP: TIMESTAMP(MICROS, 로컬 시각), WITH (LOCAL) TIME ZONE은 UTC 기준
+//   - RAW, LONG RAW, BLOB: BYTE_ARRAY
+//   - VARCHAR2, CHAR, CLOB 등 그 외: STRING
+//   - LOB 정책이 지정된 컬럼: STRING (text는 문자열, base64는 base64 문자열, object는 객체 경로)
+//
+// skip 정책 컬럼은 호출하는 쪽에서 스키마에서 제외합니다
+// Oracle은 빈 문자열을 NULL로 저장하므로 NOT NULL 컬럼도 OPTIONAL로 기록합니다
+func ParquetField(col domain.ColumnInfo, policy domain.LOBPolicy) parquet.Field {
+	if policy != "" {
+		return parquet.String(col.Name)
+	}
+	dataType := strings.ToUpper(col.DataType)
+	switch {
+	case dataType == "NUMBER":
+		if col.Precision == nil {
+			if col.Scale != nil && *col.Scale == 0 {
+				// NUMBER(*,0): 정밀도 미지정 정수
+				return parquet.Decimal(col.Name, parquet.MaxDecimalPrecision, 0)
+			}
+			// 정밀도 미지정 NUMBER는 scale이 값마다 달라 고정 DECIMAL로 표현할 수 없음
+			return parquet.String(col.Name)
+		}
+		precision, scale := *col.Precision, 0
+		if col.Scale != nil {
+			scale = *col.Scale
+		}
+		if scale < 0 {
+			precision, scale = precision-scale, 0
+		}
+		if scale > precision {
+			precision = scale
+		}
+		if precision > parquet.MaxDecimalPrecision {
+			return parquet.String(col.Name)
+		}
+		return parquet.Decimal(col.Name, precision, scale)
+	case dataType == "FLOAT":
+		// FLOAT는 십진 NUMBER의 하위 타입이므로 DOUBLE로 변환하면 정밀도를 잃음
+		return parquet.String(col.Name)
+	case dataType == "BINARY_FLOAT" || dataType == "BINARY_DOUBLE":
+		return parquet.Double(col.Name)
+	case dataType == "DATE":
+		return parquet.Timestamp(col.Name, parquet.TimeUnitMillis, false)
+	case strings.HasPrefix(dataType, "TIMESTAMP"):
+		return parquet.Timestamp(col.Name, parquet.TimeUnitMicros, strings.HasSuffix(dataType, "TIME ZONE"))
+	case dataType == "RAW" || dataType == "LONG RAW" || dataType == "BLOB":
+		return parquet.Bytes(col.Name)
+	default:
+		return parquet.String(col.Name)
+	}
+}
@@ -0,0 +1,284 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	pq "github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/pkg/buffer"
+	"oracle-etl/pkg/parquet"
+	"oracle-etl/pkg/rowset"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestParquetField(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   domain.ColumnInfo
+		policy   domain.LOBPolicy
+		expected parquet.Field
+	}{
+		{
+			name:     "NUMBER(15,2)",
+			column:   domain.ColumnInfo{Name: "NETWR", DataType: "NUMBER", Precision: intPtr(15), Scale: intPtr(2)},
+			expected: parquet.Decimal("NETWR", 15, 2),
+		},
+		{
+			name:     "NUMBER(10)",
+			column:   domain.ColumnInfo{Name: "POSNR", DataType: "NUMBER", Precision: intPtr(10), Scale: intPtr(0)},
+			expected: parquet.Decimal("POSNR", 10, 0),
+		},
+		{
+			name:     "NUMBER(5,-2)",
+			column:   domain.ColumnInfo{Name: "ROUNDED", DataType: "NUMBER", Precision: intPtr(5), Scale: intPtr(-2)},
+			expected: parquet.Decimal("ROUNDED", 7, 0),
+		},
+		{
+			name:     "NUMBER(*,0)",
+			column:   domain.ColumnInfo{Name: "ID", DataType: "NUMBER", Scale: intPtr(0)},
+			expected: parquet.Decimal("ID", 38, 0),
+		},
+		{
+			name:     "정밀도 미지정 NUMBER",
+			column:   domain.ColumnInfo{Name: "AMOUNT", DataType: "NUMBER"},
+			expected: parquet.String("AMOUNT"),
+		},
+		{
+			name:     "precision 38 초과 NUMBER(38,-5)",
+			column:   domain.ColumnInfo{Name: "HUGE", DataType: "NUMBER", Precision: intPtr(38), Scale: intPtr(-5)},
+			expected: parquet.String("HUGE"),
+		},
+		{
+			name:     "FLOAT",
+			column:   domain.ColumnInfo{Name: "RATIO", DataType: "FLOAT", Precision: intPtr(126)},
+			expected: parquet.String("RATIO"),
+		},
+		{
+			name:     "BINARY_DOUBLE",
+			column:   domain.ColumnInfo{Name: "RATE", DataType: "BINARY_DOUBLE"},
+			expected: parquet.Double("RATE"),
+		},
+		{
+			name:     "DATE",
+			column:   domain.ColumnInfo{Name: "ERDAT", DataType: "DATE"},
+			expected: parquet.Timestamp("ERDAT", parquet.TimeUnitMillis, false),
+		},
+		{
+			name:     "TIMESTAMP(6)",
+			column:   domain.ColumnInfo{Name: "CREATED_AT", DataType: "TIMESTAMP(6)"},
+			expected: parquet.Timestamp("CREATED_AT", parquet.TimeUnitMicros, false),
+		},
+		{
+			name:     "TIMESTAMP WITH TIME ZONE",
+			column:   domain.ColumnInfo{Name: "CHANGED_AT", DataType: "TIMESTAMP(6) WITH TIME ZONE"},
+			expected: parquet.Timestamp("CHANGED_AT", parquet.TimeUnitMicros, true),
+		},
+		{
+			name:     "RAW",
+			column:   domain.ColumnInfo{Name: "GUID", DataType: "RAW"},
+			expected: parquet.Bytes("GUID"),
+		},
+		{
+			name:     "VARCHAR2",
+			column:   domain.ColumnInfo{Name: "VBELN", DataType: "VARCHAR2"},
+			expected: parquet.String("VBELN"),
+		},
+		{
+			name:     "CLOB",
+			column:   domain.ColumnInfo{Name: "NOTE", DataType: "CLOB"},
+			expected: parquet.String("NOTE"),
+		},
+		{
+			name:     "BLOB",
+			column:   domain.ColumnInfo{Name: "FILE_DATA", DataType: "BLOB"},
+			expected: parquet.Bytes("FILE_DATA"),
+		},
+		{
+			name:     "BLOB base64 정책",
+			column:   domain.ColumnInfo{Name: "FILE_DATA", DataType: "BLOB"},
+			policy:   domain.LOBPolicyBase64,
+			expected: parquet.String("FILE_DATA"),
+		},
+		{
+			name:     "BLOB object 정책",
+			column:   domain.ColumnInfo{Name: "FILE_DATA", DataType: "BLOB"},
+			policy:   domain.LOBPolicyObject,
+			expected: parquet.String("FILE_DATA"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParquetField(tt.column, tt.policy))
+		})
+	}
+}
+
+func TestStreamingUploader_UploadStreamFormat_Parquet(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	format := FormatOptions{
+		Format: domain.OutputFormatParquet,
+		Columns: []domain.ColumnInfo{
+			{Name: "VBELN", DataType: "VARCHAR2"},
+			{Name: "NETWR", DataType: "NUMBER", Precision: intPtr(15), Scale: intPtr(2)},
+			{Name: "ERDAT", DataType: "DATE"},
+		},
+		Buffer: buffer.DefaultConfig(),
+	}
+
+	// row 스키마에 없는 컬럼(ERDAT)은 NULL로 기록
+	erdat := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	full := rowset.NewSchema([]rowset.Column{
+		{Name: "VBELN", Kind: rowset.KindString},
+		{Name: "NETWR", Kind: rowset.KindNumber},
+		{Name: "ERDAT", Kind: rowset.KindTime},
+	})
+	partial := rowset.NewSchema([]rowset.Column{{Name: "VBELN", Kind: rowset.KindString}})
+
+	rowChan := make(chan rowset.Row, 3)
+	rowChan <- testRow(t, full, "0090000001", "100.50", erdat)
+	rowChan <- testRow(t, full, "0090000002", nil, nil)
+	rowChan <- testRow(t, partial, "0090000003")
+	close(rowChan)
+
+	objectPath := client.ObjectPath("TRP-001", "v001", "VBRP", domain.OutputFormatParquet)
+	result, err := uploader.UploadStreamFormat(context.Background(), objectPath, format, rowChan, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.RowsWritten)
+	assert.Equal(t, int64(3), result.RowsEncoded)
+
+	data, ok := client.Object("TRP-001/v001/VBRP.parquet")
+	require.True(t, ok)
+	assert.Equal(t, result.BytesWritten, int64(len(data)))
+	assert.Equal(t, "PAR1", string(data[:4]))
+	assert.Equal(t, "PAR1", string(data[len(data)-4:]))
+}
+
+func TestStreamingUploader_UploadStreamFormat_ParquetUnconstrainedNumber(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	format := FormatOptions{
+		Format:  domain.OutputFormatParquet,
+		Columns: []domain.ColumnInfo{{Name: "AMOUNT", DataType: "NUMBER"}},
+	}
+
+	// DOUBLE로는 표현할 수 없는 자릿수도 원본 십진 문자열 그대로 기록되어야 함
+	values := []string{"12345678901234567890.123456789", "-0.000000000000000000000000000001"}
+	schema := rowset.NewSchema([]rowset.Column{{Name: "AMOUNT", Kind: rowset.KindNumber}})
+	rowChan := make(chan rowset.Row, len(values))
+	for _, v := range values {
+		rowChan <- testRow(t, schema, v)
+	}
+	close(rowChan)
+
+	_, err := uploader.UploadStreamFormat(context.Background(), "TRP-001/v001/VBRP.parquet", format, rowChan, nil)
+	require.NoError(t, err)
+
+	data, ok := client.Object("TRP-001/v001/VBRP.parquet")
+	require.True(t, ok)
+	f, err := pq.OpenFile(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	rows := make([]pq.Row, len(values))
+	n, err := pq.NewReader(f).ReadRows(rows)
+	if !errors.Is(err, io.EOF) {
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(values), n)
+	for i, v := range values {
+		assert.Equal(t, v, rows[i][0].String())
+	}
+}
+
+func TestStreamingUploader_UploadStreamFormat_ParquetLOBPolicies(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	format := FormatOptions{
+		Format: domain.OutputFormatParquet,
+		Columns: []domain.ColumnInfo{
+			{Name: "ID", DataType: "VARCHAR2"},
+			{Name: "THUMBNAIL", DataType: "BLOB"},
+			{Name: "FILE_DATA", DataType: "BLOB"},
+			{Name: "NOTE", DataType: "CLOB"},
+		},
+		LOBs: domain.LOBPolicies{"THUMBNAIL": domain.LOBPolicyBase64, "FILE_DATA": domain.LOBPolicyObject, "NOTE": domain.LOBPolicySkip},
+	}
+
+	// 디코더는 base64 정책 값을 바이너리, object 정책 값을 객체 경로로, skip 정책 값을 NULL로 전달
+	schema := rowset.NewSchema([]rowset.Column{
+		{Name: "ID", Kind: rowset.KindString},
+		{Name: "THUMBNAIL", Kind: rowset.KindBytes},
+		{Name: "FILE_DATA", Kind: rowset.KindString},
+		{Name: "NOTE", Kind: rowset.KindString},
+	})
+	rowChan := make(chan rowset.Row, 1)
+	rowChan <- testRow(t, schema, "1", []byte{0xff, 0x00, 0x01}, "gs://test-bucket/TRP-001/v001/DOCS/_lobs/FILE_DATA/00000-000000000001.bin", nil)
+	close(rowChan)
+
+	_, err := uploader.UploadStreamFormat(context.Background(), "TRP-001/v001/DOCS.parquet", format, rowChan, nil)
+	require.NoError(t, err)
+
+	data, ok := client.Object("TRP-001/v001/DOCS.parquet")
+	require.True(t, ok)
+	f, err := pq.OpenFile(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	fields := f.Schema().Fields()
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name()
+		assert.NotNil(t, field.Type().LogicalType().UTF8, "%s은(는) STRING 컬럼", field.Name())
+	}
+	assert.Equal(t, []string{"ID", "THUMBNAIL", "FILE_DATA"}, names, "skip 정책 컬럼은 스키마에서 제외")
+
+	rows := make([]pq.Row, 1)
+	n, err := pq.NewReader(f).ReadRows(rows)
+	if !errors.Is(err, io.EOF) {
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, n)
+	assert.Equal(t, "/wAB", rows[0][1].String())
+	assert.Equal(t, "gs://test-bucket/TRP-001/v001/DOCS/_lobs/FILE_DATA/00000-000000000001.bin", rows[0][2].String())
+}
+
+func TestStreamingUploader_UploadStreamFormat_ParquetInvalidValue(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	format := FormatOptions{
+		Format:  domain.OutputFormatParquet,
+		Columns: []domain.ColumnInfo{{Name: "NETWR", DataType: "NUMBER", Precision: intPtr(3), Scale: intPtr(0)}},
+	}
+
+	schema := rowset.NewSchema([]rowset.Column{{Name: "NETWR", Kind: rowset.KindNumber}})
+	rowChan := make(chan rowset.Row, 1)
+	rowChan <- testRow(t, schema, "12345")
+	close(rowChan)
+
+	_, err := uploader.UploadStreamFormat(context.Background(), "TRP-001/v001/VBRP.parquet", format, rowChan, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "NETWR")
+
+	// 인코딩 실패 시 불완전한 객체가 확정되지 않아야 함
+	assert.Empty(t, client.ObjectPaths())
+}
+
+func TestNewRowEncoder_ParquetRequiresColumns(t *testing.T) {
+	_, err := NewRowEncoder(nil, FormatOptions{Format: domain.OutputFormatParquet})
+	assert.Error(t, err)
+
+	_, err = NewRowEncoder(nil, FormatOptions{Format: "csv"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package gcs
+
+import (
+	"io"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// timedWriter는 하위 writer의 Write 호출에 걸린 누적 시간을 측정합니다
+// 업로드 goroutine에서만 사용되므로 동기화하지 않습니다
+type timedWriter struct {
+	w       io.Writer
+	elapsed time.Duration
+}
+
+// Write는 하위 writer에 기록하고 소요 시간을 누적합니다
+func (t *timedWriter) Write(p []byte) (int, error) {
+	start := time.Now()
+	n, err := t.w.Write(p)
+	t.elapsed += time.Since(start)
+	return n, err
+}
+
+// compressTimer는 압축 단계 시간을 따로 측정하는 인코더(JSONL+gzip)가 구현합니다
+type compressTimer interface {
+	// compressElapsed는 압축과 그에 따른 하위 writer 기록에 걸린 누적 시간을 반환합니다
+	compressElapsed() time.Duration
+}
+
+// uploadStages는 업로드 파이프라인 단계별 누적 시간입니다 (gcs.upload span 속성)
+// 어느 단계(추출 대기, 인코딩, 압축, GCS 쓰기)가 병목인지 구분하는 데 사용합니다
+type uploadStages struct {
+	wait   time.Duration // 채널에서 row를 기다린 시간 (Oracle 추출 측 지연)
+	encode time.Duration // Encode/Close 호출 전체 시간 (압축과 GCS 쓰기 포함)
+	commit time.Duration // GCS 객체 확정(writer Close) 시간
+	sink   *timedWriter  // GCS writer(+CRC32C) 기록 시간
+}
+
+// attributes는 단계별 시간을 겹치지 않도록 분리하여 span 속성으로 반환합니다
+func (s *uploadStages) attributes(encoder RowEncoder) []attribute.KeyValue {
+	var write time.Duration
+	if s.sink != nil {
+		write = s.sink.elapsed
+	}
+
+	// 인코딩 시간에서 하위 단계(압축, GCS 쓰기) 시간을 제외
+	encode := s.encode - write
+	attrs := make([]attribute.KeyValue, 0, 5)
+	if ct, ok := encoder.(compressTimer); ok {
+		compressed := ct.compressElapsed()
+		encode = s.encode - compressed
+		attrs = append(attrs, attribute.Float64("etl.upload.compress_seconds", nonNegative(compressed-write).Seconds()))
+	}
+
+	return append(attrs,
+		attribute.Float64("etl.upload.wait_seconds", s.wait.Seconds()),
+		attribute.Float64("etl.upload.encode_seconds", nonNegative(encode).Seconds()),
+		attribute.Float64("etl.upload.write_seconds", write.Seconds()),
+		attribute.Float64("etl.upload.commit_seconds", s.commit.Seconds()),
+	)
+}
+
+// nonNegative는 측정 오차로 음수가 된 시간을 0으로 보정합니다
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
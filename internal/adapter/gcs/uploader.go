@@ -3,13 +3,19 @@ package gcs
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"fmt"
-	
+	"hash/crc32"
+	"io"
 	"sync/atomic"
 	"time"
 
-	"oracle-etl/pkg/compress"
-	"oracle-etl/pkg/jsonl"
+	"go.opentelemetry.io/otel/attribute"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/telemetry"
+	"oracle-etl/pkg/rowset"
 )
 
 // UploadProgress는 업로드 진행 상황을 나타냅니다
@@ -55,8 +61,20 @@ type UploadResult struct {
 	BytesWritten  int64         // 압축 후 전송된 바이트 수
 	BytesOriginal int64         // 압축 전 원본 바이트 수
 	RowsWritten   int64         // 기록된 row 수
+	RowsEncoded   int64         // 인코더가 출력 형식으로 기록한 row 수 (대사용)
 	Duration      time.Duration // 업로드 소요 시간
 	ObjectPath    string        // GCS 객체 경로
+	CRC32C        uint32        // 업로드된 객체 내용의 CRC32C (Castagnoli) 체크섬
+}
+
+// crc32cTable은 GCS가 객체 무결성 검증에 사용하는 Castagnoli 다항식 테이블입니다
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// EncodeCRC32C는 CRC32C 값을 GCS 메타데이터와 같은 형식(big-endian 4바이트의 base64)으로 변환합니다
+func EncodeCRC32C(sum uint32) string {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], sum)
+	return base64.StdEncoding.EncodeToString(b[:])
 }
 
 // CompressionRatio는 압축률을 반환합니다 (0.0-1.0)
@@ -86,16 +104,19 @@ func (r UploadResult) MBPerSecond() float64 {
 // Uploader는 GCS 스트리밍 업로드 인터페이스입니다
 type Uploader interface {
 	// Upload는 row 슬라이스를 GCS에 업로드합니다
-	Upload(ctx context.Context, objectPath string, rows []map[string]interface{}, callback ProgressCallback) (*UploadResult, error)
+	Upload(ctx context.Context, objectPath string, rows []rowset.Row, callback ProgressCallback) (*UploadResult, error)
 
 	// UploadStream은 채널에서 row를 읽어 스트리밍 업로드합니다
-	UploadStream(ctx context.Context, objectPath string, rowChan <-chan map[string]interface{}, callback ProgressCallback) (*UploadResult, error)
+	UploadStream(ctx context.Context, objectPath string, rowChan <-chan rowset.Row, callback ProgressCallback) (*UploadResult, error)
+
+	// UploadStreamFormat은 지정한 출력 형식으로 채널의 row를 스트리밍 업로드합니다
+	UploadStreamFormat(ctx context.Context, objectPath string, format FormatOptions, rowChan <-chan rowset.Row, callback ProgressCallback) (*UploadResult, error)
 }
 
 // StreamingUploader는 Uploader 인터페이스의 구현체입니다
 type StreamingUploader struct {
-	client            Client
-	progressInterval  time.Duration // 진행률 콜백 호출 간격
+	client           Client
+	progressInterval time.Duration // 진행률 콜백 호출 간격
 }
 
 // NewStreamingUploader는 새로운 스트리밍 업로더를 생성합니다
@@ -106,10 +127,8 @@ func NewStreamingUploader(client Client) Uploader {
 	}
 }
 
-// Upload는 row 슬라이스를 GCS에 업로드합니다
-func (u *StreamingUploader) Upload(ctx context.Context, objectPath string, rows []map[string]interface{}, callback ProgressCallback) (*UploadResult, error) {
-	startTime := time.Now()
-
+// Upload는 row 슬라이스를 GCS에 업로드합니다 (JSONL)
+func (u *StreamingUploader) Upload(ctx context.Context, objectPath string, rows []rowset.Row, callback ProgressCallback) (*UploadResult, error) {
 	// 컨텍스트 취소 확인
 	select {
 	case <-ctx.Done():
@@ -117,120 +136,109 @@ func (u *StreamingUploader) Upload(ctx context.Context, objectPath string, rows
 	default:
 	}
 
-	// GCS writer 생성
-	gcsWriter, err := u.client.NewWriter(ctx, objectPath)
-	if err != nil {
-		return nil, fmt.Errorf("GCS writer 생성 실패: %w", err)
-	}
-	defer gcsWriter.Close()
-
-	// 파이프라인: JSONL -> Gzip -> GCS
-	gzipWriter := compress.NewGzipWriter(gcsWriter)
-	jsonlEncoder := jsonl.NewEncoder(gzipWriter)
-
-	var rowsWritten int64
-	lastCallback := time.Now()
-
+	rowChan := make(chan rowset.Row, len(rows))
 	for _, row := range rows {
-		// 컨텍스트 취소 확인
-		select {
-		case <-ctx.Done():
-			_ = gzipWriter.Close() // 에러 경로에서 정리
-			return nil, ctx.Err()
-		default:
-		}
-
-		if err := jsonlEncoder.Encode(row); err != nil {
-			_ = gzipWriter.Close() // 에러 경로에서 정리
-			return nil, fmt.Errorf("row 인코딩 실패: %w", err)
-		}
-		atomic.AddInt64(&rowsWritten, 1)
-
-		// 진행률 콜백
-		if callback != nil && time.Since(lastCallback) >= u.progressInterval {
-			callback(UploadProgress{
-				BytesWritten: gzipWriter.BytesWritten(),
-				BytesTotal:   -1, // 스트리밍에서는 총량 알 수 없음
-				RowsWritten:  atomic.LoadInt64(&rowsWritten),
-				StartTime:    startTime,
-				LastUpdate:   time.Now(),
-			})
-			lastCallback = time.Now()
-		}
-	}
-
-	// JSONL 버퍼 플러시
-	if err := jsonlEncoder.Flush(); err != nil {
-		_ = gzipWriter.Close() // 에러 경로에서 정리
-		return nil, fmt.Errorf("JSONL 플러시 실패: %w", err)
+		rowChan <- row
 	}
+	close(rowChan)
 
-	// Gzip 스트림 닫기
-	if err := gzipWriter.Close(); err != nil {
-		return nil, fmt.Errorf("gzip 스트림 닫기 실패: %w", err)
-	}
-
-	// 최종 진행률 콜백
-	if callback != nil {
-		callback(UploadProgress{
-			BytesWritten: gzipWriter.BytesWritten(),
-			BytesTotal:   gzipWriter.BytesWritten(),
-			RowsWritten:  atomic.LoadInt64(&rowsWritten),
-			StartTime:    startTime,
-			LastUpdate:   time.Now(),
-		})
-	}
+	return u.UploadStreamFormat(ctx, objectPath, FormatOptions{}, rowChan, callback)
+}
 
-	return &UploadResult{
-		BytesWritten:  gzipWriter.BytesWritten(),
-		BytesOriginal: gzipWriter.BytesRead(),
-		RowsWritten:   atomic.LoadInt64(&rowsWritten),
-		Duration:      time.Since(startTime),
-		ObjectPath:    objectPath,
-	}, nil
+// UploadStream은 채널에서 row를 읽어 스트리밍 업로드합니다 (JSONL)
+func (u *StreamingUploader) UploadStream(ctx context.Context, objectPath string, rowChan <-chan rowset.Row, callback ProgressCallback) (*UploadResult, error) {
+	return u.UploadStreamFormat(ctx, objectPath, FormatOptions{}, rowChan, callback)
 }
 
-// UploadStream은 채널에서 row를 읽어 스트리밍 업로드합니다
-func (u *StreamingUploader) UploadStream(ctx context.Context, objectPath string, rowChan <-chan map[string]interface{}, callback ProgressCallback) (*UploadResult, error) {
+// UploadStreamFormat은 지정한 출력 형식으로 채널의 row를 스트리밍 업로드합니다
+// 추적이 활성화되어 있으면 gcs.upload span에 단계별(대기, 인코딩, 압축, GCS 쓰기, 확정) 누적 시간을 기록합니다
+func (u *StreamingUploader) UploadStreamFormat(ctx context.Context, objectPath string, format FormatOptions, rowChan <-chan rowset.Row, callback ProgressCallback) (_ *UploadResult, err error) {
 	startTime := time.Now()
 
-	// GCS writer 생성
-	gcsWriter, err := u.client.NewWriter(ctx, objectPath)
+	ctx, span := telemetry.StartSpan(ctx, "gcs.upload",
+		attribute.String("gcs.object", objectPath),
+		attribute.String("etl.format", string(format.Format)),
+	)
+	var (
+		stages      uploadStages
+		encoder     RowEncoder
+		rowsWritten int64
+	)
+	defer func() {
+		if encoder != nil {
+			span.SetAttributes(stages.attributes(encoder)...)
+			span.SetAttributes(
+				telemetry.AttrRows.Int64(atomic.LoadInt64(&rowsWritten)),
+				telemetry.AttrBytes.Int64(encoder.BytesWritten()),
+				attribute.Int64("etl.bytes_original", encoder.BytesOriginal()),
+			)
+		}
+		telemetry.EndSpan(span, err)
+	}()
+
+	// GCS writer 생성 (컨텍스트를 취소한 뒤 닫으면 객체가 확정되지 않음)
+	writerCtx, cancelWriter := context.WithCancel(ctx)
+	defer cancelWriter()
+	gcsWriter, err := u.client.NewWriter(writerCtx, objectPath)
 	if err != nil {
 		return nil, fmt.Errorf("GCS writer 생성 실패: %w", err)
 	}
-	defer gcsWriter.Close()
+	// 에러 경로에서만 정리: 불완전한 객체(footer 없는 Parquet 등)가 남지 않도록 업로드 취소
+	// (정상 경로는 Close 에러를 확인해야 객체 생성 실패를 감지할 수 있음)
+	writerClosed := false
+	defer func() {
+		if !writerClosed {
+			cancelWriter()
+			_ = gcsWriter.Close()
+		}
+	}()
 
-	// 파이프라인: JSONL -> Gzip -> GCS
-	gzipWriter := compress.NewGzipWriter(gcsWriter)
-	jsonlEncoder := jsonl.NewEncoder(gzipWriter)
+	// 파이프라인: 형식별 인코더(JSONL+Gzip 또는 Parquet) -> GCS (+ CRC32C 계산)
+	checksum := crc32.New(crc32cTable)
+	stages.sink = &timedWriter{w: io.MultiWriter(gcsWriter, checksum)}
+	encoder, err = NewRowEncoder(stages.sink, format)
+	if err != nil {
+		return nil, err
+	}
 
-	var rowsWritten int64
 	lastCallback := time.Now()
 
+	// 단계별 시간은 span이 기록될 때만 row 단위로 측정 (샘플링되지 않으면 time.Now 호출 생략)
+	timed := span.IsRecording()
+	mark := time.Now()
+
 	for {
 		select {
 		case <-ctx.Done():
-			_ = gzipWriter.Close() // 에러 경로에서 정리
 			return nil, ctx.Err()
 
 		case row, ok := <-rowChan:
+			if timed {
+				now := time.Now()
+				stages.wait += now.Sub(mark)
+				mark = now
+			}
 			if !ok {
 				// 채널 닫힘 - 업로드 완료
-				if err := jsonlEncoder.Flush(); err != nil {
-					_ = gzipWriter.Close() // 에러 경로에서 정리
-					return nil, fmt.Errorf("JSONL 플러시 실패: %w", err)
+				closeStart := time.Now()
+				if err := encoder.Close(); err != nil {
+					return nil, err
 				}
+				stages.encode += time.Since(closeStart)
 
-				if err := gzipWriter.Close(); err != nil {
-					return nil, fmt.Errorf("gzip 스트림 닫기 실패: %w", err)
+				// GCS 객체 완료 (Close 시점에 업로드가 확정됨)
+				writerClosed = true
+				commitStart := time.Now()
+				if err := gcsWriter.Close(); err != nil {
+					return nil, fmt.Errorf("GCS 객체 업로드 완료 실패: %w", err)
 				}
+				stages.commit = time.Since(commitStart)
 
 				// 최종 진행률 콜백
 				if callback != nil {
 					callback(UploadProgress{
-						BytesWritten: gzipWriter.BytesWritten(),
-						BytesTotal:   gzipWriter.BytesWritten(),
+						BytesWritten: encoder.BytesWritten(),
+						BytesTotal:   encoder.BytesWritten(),
 						RowsWritten:  atomic.LoadInt64(&rowsWritten),
 						StartTime:    startTime,
 						LastUpdate:   time.Now(),
@@ -238,25 +246,31 @@ func (u *StreamingUploader) UploadStream(ctx context.Context, objectPath string,
 				}
 
 				return &UploadResult{
-					BytesWritten:  gzipWriter.BytesWritten(),
-					BytesOriginal: gzipWriter.BytesRead(),
+					BytesWritten:  encoder.BytesWritten(),
+					BytesOriginal: encoder.BytesOriginal(),
 					RowsWritten:   atomic.LoadInt64(&rowsWritten),
+					RowsEncoded:   encoder.RowsEncoded(),
 					Duration:      time.Since(startTime),
 					ObjectPath:    objectPath,
+					CRC32C:        checksum.Sum32(),
 				}, nil
 			}
 
-			if err := jsonlEncoder.Encode(row); err != nil {
-				_ = gzipWriter.Close() // 에러 경로에서 정리
+			if err := encoder.Encode(row); err != nil {
 				return nil, fmt.Errorf("row 인코딩 실패: %w", err)
 			}
 			atomic.AddInt64(&rowsWritten, 1)
+			if timed {
+				now := time.Now()
+				stages.encode += now.Sub(mark)
+				mark = now
+			}
 
 			// 진행률 콜백
 			if callback != nil && time.Since(lastCallback) >= u.progressInterval {
 				callback(UploadProgress{
-					BytesWritten: gzipWriter.BytesWritten(),
-					BytesTotal:   -1,
+					BytesWritten: encoder.BytesWritten(),
+					BytesTotal:   -1, // 스트리밍에서는 총량 알 수 없음
 					RowsWritten:  atomic.LoadInt64(&rowsWritten),
 					StartTime:    startTime,
 					LastUpdate:   time.Now(),
@@ -281,14 +295,71 @@ func NewPipelineUploader(client Client) *PipelineUploader {
 	}
 }
 
-// UploadTable은 테이블 데이터를 GCS에 업로드합니다
-func (p *PipelineUploader) UploadTable(ctx context.Context, transportID, jobVersion, tableName string, rows []map[string]interface{}, callback ProgressCallback) (*UploadResult, error) {
-	objectPath := p.client.ObjectPath(transportID, jobVersion, tableName)
+// UploadTable은 테이블 데이터를 GCS에 업로드합니다 (JSONL)
+func (p *PipelineUploader) UploadTable(ctx context.Context, transportID, jobVersion, tableName string, rows []rowset.Row, callback ProgressCallback) (*UploadResult, error) {
+	objectPath := p.client.ObjectPath(transportID, jobVersion, tableName, domain.OutputFormatJSONL)
 	return p.uploader.Upload(ctx, objectPath, rows, callback)
 }
 
-// UploadTableStream은 테이블 데이터를 스트리밍으로 GCS에 업로드합니다
-func (p *PipelineUploader) UploadTableStream(ctx context.Context, transportID, jobVersion, tableName string, rowChan <-chan map[string]interface{}, callback ProgressCallback) (*UploadResult, error) {
-	objectPath := p.client.ObjectPath(transportID, jobVersion, tableName)
-	return p.uploader.UploadStream(ctx, objectPath, rowChan, callback)
+// UploadTableStream은 테이블 데이터를 지정한 출력 형식으로 스트리밍 업로드합니다
+func (p *PipelineUploader) UploadTableStream(ctx context.Context, transportID, jobVersion, tableName string, format FormatOptions, rowChan <-chan rowset.Row, callback ProgressCallback) (*UploadResult, error) {
+	objectPath := p.client.ObjectPath(transportID, jobVersion, tableName, format.Format)
+	return p.uploader.UploadStreamFormat(ctx, objectPath, format, rowChan, callback)
+}
+
+// UploadTablePartStream은 분할 추출된 테이블의 한 범위를 part 객체로 스트리밍 업로드합니다
+func (p *PipelineUploader) UploadTablePartStream(ctx context.Context, transportID, jobVersion, tableName string, part int, format FormatOptions, rowChan <-chan rowset.Row, callback ProgressCallback) (*UploadResult, error) {
+	objectPath := p.client.PartObjectPath(transportID, jobVersion, tableName, part, format.Format)
+	return p.uploader.UploadStreamFormat(ctx, objectPath, format, rowChan, callback)
+}
+
+// CopyObject는 r의 내용을 객체로 스트리밍 업로드하고 기록한 바이트 수와 CRC32C를 반환합니다 (LOB 객체 등)
+// 전체 내용을 메모리에 올리지 않고 writer의 청크 크기 단위로 전송합니다
+func CopyObject(ctx context.Context, client Client, objectPath string, r io.Reader) (*UploadResult, error) {
+	start := time.Now()
+	writerCtx, cancelWriter := context.WithCancel(ctx)
+	defer cancelWriter()
+
+	writer, err := client.NewWriter(writerCtx, objectPath)
+	if err != nil {
+		return nil, fmt.Errorf("GCS writer 생성 실패: %w", err)
+	}
+	checksum := crc32.New(crc32cTable)
+	n, err := io.Copy(io.MultiWriter(writer, checksum), r)
+	if err != nil {
+		cancelWriter()
+		_ = writer.Close()
+		return nil, fmt.Errorf("GCS 객체 쓰기 실패 (%s): %w", objectPath, err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("GCS 객체 업로드 완료 실패 (%s): %w", objectPath, err)
+	}
+	return &UploadResult{
+		BytesWritten:  n,
+		BytesOriginal: n,
+		Duration:      time.Since(start),
+		ObjectPath:    objectPath,
+		CRC32C:        checksum.Sum32(),
+	}, nil
+}
+
+// PutObject는 manifest, marker 같은 작은 객체를 한 번에 업로드합니다
+func PutObject(ctx context.Context, client Client, objectPath string, data []byte) error {
+	// 쓰기 실패 시 컨텍스트를 취소한 뒤 닫아 부분 객체가 확정되지 않도록 함
+	writerCtx, cancelWriter := context.WithCancel(ctx)
+	defer cancelWriter()
+
+	writer, err := client.NewWriter(writerCtx, objectPath)
+	if err != nil {
+		return fmt.Errorf("GCS writer 생성 실패: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		cancelWriter()
+		_ = writer.Close()
+		return fmt.Errorf("GCS 객체 쓰기 실패 (%s): %w", objectPath, err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("GCS 객체 업로드 완료 실패 (%s): %w", objectPath, err)
+	}
+	return nil
 }
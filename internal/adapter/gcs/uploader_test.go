@@ -5,16 +5,50 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
+	"hash/crc32"
 	"io"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"oracle-etl/pkg/rowset"
 )
 
+// idNameSchema는 업로더 테스트에서 사용하는 row 스키마입니다
+var idNameSchema = rowset.NewSchema([]rowset.Column{
+	{Name: "id", Kind: rowset.KindNumber},
+	{Name: "name", Kind: rowset.KindString},
+})
+
+// testRows는 값 목록으로 typed row를 생성합니다
+func testRows(t *testing.T, schema *rowset.Schema, values ...[]interface{}) []rowset.Row {
+	t.Helper()
+	rows := make([]rowset.Row, len(values))
+	for i, v := range values {
+		row, err := rowset.New(schema, v...)
+		require.NoError(t, err)
+		rows[i] = row
+	}
+	return rows
+}
+
+// testRow는 값 목록으로 typed row 하나를 생성합니다
+func testRow(t *testing.T, schema *rowset.Schema, values ...interface{}) rowset.Row {
+	t.Helper()
+	return testRows(t, schema, values)[0]
+}
+
 func TestUploadProgress(t *testing.T) {
 	progress := UploadProgress{
 		BytesWritten: 1000,
@@ -69,17 +103,18 @@ func TestStreamingUploader_Upload(t *testing.T) {
 	ctx := context.Background()
 	objectPath := "TRP-001/v001/VBRP.jsonl.gz"
 
-	rows := []map[string]interface{}{
-		{"id": 1, "name": "first"},
-		{"id": 2, "name": "second"},
-		{"id": 3, "name": "third"},
-	}
+	rows := testRows(t, idNameSchema,
+		[]interface{}{1, "first"},
+		[]interface{}{2, "second"},
+		[]interface{}{3, "third"},
+	)
 
 	result, err := uploader.Upload(ctx, objectPath, rows, nil)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
 	assert.Equal(t, int64(3), result.RowsWritten)
+	assert.Equal(t, int64(3), result.RowsEncoded)
 	assert.Greater(t, result.BytesWritten, int64(0))
 	assert.Greater(t, result.Duration, time.Duration(0))
 }
@@ -95,9 +130,9 @@ func TestStreamingUploader_UploadWithProgress(t *testing.T) {
 	ctx := context.Background()
 	objectPath := "TRP-001/v001/VBRP.jsonl.gz"
 
-	rows := make([]map[string]interface{}, 100)
+	rows := make([]rowset.Row, 100)
 	for i := 0; i < 100; i++ {
-		rows[i] = map[string]interface{}{"id": i, "name": "row"}
+		rows[i] = testRow(t, idNameSchema, i, "row")
 	}
 
 	var progressCalls int32
@@ -126,10 +161,14 @@ func TestStreamingUploader_UploadStream(t *testing.T) {
 	objectPath := "TRP-001/v001/VBRK.jsonl.gz"
 
 	// Row 채널 생성
-	rowChan := make(chan map[string]interface{}, 10)
+	rows := make([]rowset.Row, 50)
+	for i := range rows {
+		rows[i] = testRow(t, idNameSchema, i, "test")
+	}
+	rowChan := make(chan rowset.Row, 10)
 	go func() {
-		for i := 0; i < 50; i++ {
-			rowChan <- map[string]interface{}{"id": i, "value": "test"}
+		for _, row := range rows {
+			rowChan <- row
 		}
 		close(rowChan)
 	}()
@@ -153,7 +192,7 @@ func TestStreamingUploader_ContextCancel(t *testing.T) {
 	cancel() // 즉시 취소
 
 	objectPath := "TRP-001/v001/VBRP.jsonl.gz"
-	rows := []map[string]interface{}{{"id": 1}}
+	rows := testRows(t, idNameSchema, []interface{}{1, nil})
 
 	_, err := uploader.Upload(ctx, objectPath, rows, nil)
 	require.Error(t, err)
@@ -202,10 +241,14 @@ func TestVerifyCompressedOutput(t *testing.T) {
 	uploader := NewStreamingUploader(mockClient)
 
 	ctx := context.Background()
-	rows := []map[string]interface{}{
-		{"MANDT": "800", "VBELN": "0090000001"},
-		{"MANDT": "800", "VBELN": "0090000002"},
-	}
+	schema := rowset.NewSchema([]rowset.Column{
+		{Name: "MANDT", Kind: rowset.KindString},
+		{Name: "VBELN", Kind: rowset.KindString},
+	})
+	rows := testRows(t, schema,
+		[]interface{}{"800", "0090000001"},
+		[]interface{}{"800", "0090000002"},
+	)
 
 	_, err := uploader.Upload(ctx, "test/path.jsonl.gz", rows, nil)
 	require.NoError(t, err)
@@ -250,3 +293,151 @@ func (w *capturingWriter) Write(p []byte) (n int, err error) {
 func (w *capturingWriter) Close() error {
 	return nil
 }
+
+func TestStreamingUploader_UploadStream_StoresObject(t *testing.T) {
+	client := NewMockClient(GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	rowChan := make(chan rowset.Row, 2)
+	rowChan <- testRow(t, idNameSchema, 1, nil)
+	rowChan <- testRow(t, idNameSchema, 2, nil)
+	close(rowChan)
+
+	result, err := uploader.UploadStream(context.Background(), "TRP-001/v001/VBRP.jsonl.gz", rowChan, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.RowsWritten)
+
+	data, ok := client.Object("TRP-001/v001/VBRP.jsonl.gz")
+	require.True(t, ok)
+	assert.Equal(t, result.BytesWritten, int64(len(data)))
+	assert.Equal(t, crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)), result.CRC32C)
+}
+
+func TestEncodeCRC32C(t *testing.T) {
+	// gsutil hash 형식: big-endian 4바이트의 base64
+	assert.Equal(t, "AAAAAA==", EncodeCRC32C(0))
+	assert.Equal(t, "4waSgw==", EncodeCRC32C(crc32.Checksum([]byte("123456789"), crc32.MakeTable(crc32.Castagnoli))))
+}
+
+func TestStreamingUploader_CloseError(t *testing.T) {
+	client := NewMockClient(GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*MockClient)
+	client.CloseError = errors.New("googleapi: Error 503: backend error")
+	uploader := NewStreamingUploader(client)
+
+	rows := testRows(t, idNameSchema, []interface{}{1, nil})
+	_, err := uploader.Upload(context.Background(), "TRP-001/v001/VBRP.jsonl.gz", rows, nil)
+
+	// Close 실패는 업로드 실패로 보고되어야 함
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+	assert.Empty(t, client.ObjectPaths())
+}
+
+func TestStreamingUploader_UploadStream_CancelDoesNotFinalize(t *testing.T) {
+	client := NewMockClient(GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*MockClient)
+	uploader := NewStreamingUploader(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	row := testRow(t, idNameSchema, 1, nil)
+	rowChan := make(chan rowset.Row)
+	go func() {
+		rowChan <- row
+		cancel()
+	}()
+
+	_, err := uploader.UploadStream(ctx, "TRP-001/v001/VBRP.jsonl.gz", rowChan, nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// 취소된 업로드는 부분 객체를 남기지 않음
+	assert.Empty(t, client.ObjectPaths())
+}
+
+func TestPutObject(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	ctx := context.Background()
+
+	path := client.VersionObjectPath("TRP-001", "v001", "_SUCCESS")
+	assert.Equal(t, "TRP-001/v001/_SUCCESS", path)
+	require.NoError(t, PutObject(ctx, client, path, nil))
+	data, ok := client.Object(path)
+	require.True(t, ok)
+	assert.Empty(t, data)
+
+	require.NoError(t, client.DeleteObject(ctx, path))
+	_, ok = client.Object(path)
+	assert.False(t, ok)
+	// 없는 객체 삭제는 에러 없음
+	require.NoError(t, client.DeleteObject(ctx, path))
+
+	// 쓰기 실패 시 객체가 확정되지 않음
+	client.WriteError = errors.New("write failed")
+	err := PutObject(ctx, client, client.VersionObjectPath("TRP-001", "v001", "manifest.json"), []byte("{}"))
+	require.Error(t, err)
+	client.WriteError = nil
+	assert.Empty(t, client.ObjectPaths())
+}
+
+func TestCopyObject(t *testing.T) {
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*MockClient)
+	ctx := context.Background()
+
+	path := client.LOBObjectPath("TRP-001", "v001", "FND_LOBS", "FILE_DATA", 0, 1)
+	result, err := CopyObject(ctx, client, path, strings.NewReader("lob content"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), result.BytesWritten)
+	assert.Equal(t, path, result.ObjectPath)
+	data, ok := client.Object(path)
+	require.True(t, ok)
+	assert.Equal(t, "lob content", string(data))
+	assert.Equal(t, crc32.Checksum(data, crc32cTable), result.CRC32C)
+
+	// 읽기 실패 시 객체가 확정되지 않음
+	failPath := client.LOBObjectPath("TRP-001", "v001", "FND_LOBS", "FILE_DATA", 0, 2)
+	_, err = CopyObject(ctx, client, failPath, iotest.ErrReader(errors.New("ORA-22922")))
+	require.ErrorContains(t, err, "ORA-22922")
+	_, ok = client.Object(failPath)
+	assert.False(t, ok)
+}
+
+func TestStreamingUploader_StageSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	client := NewMockClient(GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"})
+	uploader := NewStreamingUploader(client)
+
+	rows := testRows(t, idNameSchema, []interface{}{1, "first"}, []interface{}{2, "second"})
+	_, err := uploader.Upload(context.Background(), "TRP-001/v001/VBRP.jsonl.gz", rows, nil)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "gcs.upload", spans[0].Name())
+
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range spans[0].Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	assert.Equal(t, "TRP-001/v001/VBRP.jsonl.gz", attrs["gcs.object"].AsString())
+	assert.Equal(t, int64(2), attrs["etl.rows"].AsInt64())
+	for _, key := range []attribute.Key{
+		"etl.upload.wait_seconds",
+		"etl.upload.encode_seconds",
+		"etl.upload.compress_seconds",
+		"etl.upload.write_seconds",
+		"etl.upload.commit_seconds",
+	} {
+		require.Contains(t, attrs, key)
+		assert.GreaterOrEqual(t, attrs[key].AsFloat64(), 0.0, key)
+	}
+}
@@ -0,0 +1,42 @@
+// Package handler는 HTTP 요청 핸들러를 제공합니다
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	apperrors "oracle-etl/internal/errors"
+	"oracle-etl/internal/telemetry"
+	"oracle-etl/internal/usecase"
+)
+
+// failure는 처리 실패 응답을 반환합니다
+// Oracle 에러(ORA- 코드)는 분류된 에러 코드와 HTTP 상태, 재시도 가능 여부, 조치 방법으로 응답하고,
+// 그 외 에러는 주어진 code와 message, 원인(error)으로 500을 응답합니다
+func failure(c *fiber.Ctx, code, message string, err error) error {
+	if resp := apperrors.ClassifyOracle(err); resp != nil {
+		if traceID := telemetry.TraceID(c.UserContext()); traceID != "" {
+			resp.WithTraceID(traceID)
+		}
+		return c.Status(resp.HTTPStatus()).JSON(resp)
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"code":    code,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
+
+// outsideWindow는 유지보수 시간대 밖 실행 요청에 대해 다음 실행 가능 시각과 함께 409를 응답합니다
+func outsideWindow(c *fiber.Ctx, err error) error {
+	resp := fiber.Map{
+		"code":    "OUTSIDE_MAINTENANCE_WINDOW",
+		"message": err.Error(),
+	}
+	var windowErr *usecase.WindowError
+	if errors.As(err, &windowErr) && !windowErr.NextAllowedAt.IsZero() {
+		resp["next_allowed_at"] = windowErr.NextAllowedAt
+	}
+	return c.Status(fiber.StatusConflict).JSON(resp)
+}
@@ -2,37 +2,206 @@
 package handler
 
 import (
+	"context"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+
+	apperrors "oracle-etl/internal/errors"
+	"oracle-etl/internal/resilience"
+)
+
+const (
+	// defaultReadinessTimeout은 readiness 의존성 검사 하나의 기본 제한 시간입니다
+	defaultReadinessTimeout = 5 * time.Second
 )
 
+// HealthCheckFunc는 외부 의존성(Oracle, GCS 등)의 연결 상태를 검사하는 함수입니다
+type HealthCheckFunc func(ctx context.Context) error
+
 // HealthHandler는 헬스 체크 엔드포인트 핸들러입니다
 type HealthHandler struct {
 	version string
+	demo    bool
+	timeout time.Duration
+	names   []string
+	checks  map[string]HealthCheckFunc
+
+	breakers map[string]*resilience.CircuitBreaker
 }
 
 // HealthResponse는 /api/health 응답 구조체입니다
 type HealthResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
-	Version   string    `json:"version"`
+	Status    string                      `json:"status"`
+	Timestamp time.Time                   `json:"timestamp"`
+	Version   string                      `json:"version"`
+	Demo      bool                        `json:"demo,omitempty"`
+	Checks    map[string]DependencyHealth `json:"checks,omitempty"`
+
+	CircuitBreakers map[string]CircuitBreakerHealth `json:"circuit_breakers,omitempty"`
+}
+
+// CircuitBreakerHealth는 의존성별 Circuit Breaker 상태입니다
+type CircuitBreakerHealth struct {
+	State      string `json:"state"`      // closed, open, half-open
+	Requests   int64  `json:"requests"`   // 허용된 호출 수
+	Failures   int64  `json:"failures"`   // 장애로 집계된 호출 수
+	Rejections int64  `json:"rejections"` // Open 상태에서 거부된 호출 수
+}
+
+// DependencyHealth는 readiness 응답의 의존성별 검사 결과입니다
+type DependencyHealth struct {
+	Status    string `json:"status"` // ok, error
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+	Code      string `json:"code,omitempty"` // Oracle 에러 분류 코드 (예: ORACLE_INVALID_CREDENTIALS)
+	Hint      string `json:"hint,omitempty"` // 조치 방법
 }
 
 // NewHealthHandler는 새로운 HealthHandler를 생성합니다
 func NewHealthHandler(version string) *HealthHandler {
 	return &HealthHandler{
 		version: version,
+		timeout: defaultReadinessTimeout,
+		checks:  make(map[string]HealthCheckFunc),
+
+		breakers: make(map[string]*resilience.CircuitBreaker),
 	}
 }
 
-// Check는 시스템 상태를 확인하고 JSON 응답을 반환합니다
+// AddCircuitBreaker는 health 응답에 의존성 Circuit Breaker 상태를 추가합니다 (같은 이름이면 교체)
+func (h *HealthHandler) AddCircuitBreaker(name string, cb *resilience.CircuitBreaker) {
+	h.breakers[name] = cb
+}
+
+// AddCheck는 readiness 검사에 의존성 검사를 추가합니다 (같은 이름이면 교체)
+func (h *HealthHandler) AddCheck(name string, check HealthCheckFunc) {
+	if _, ok := h.checks[name]; !ok {
+		h.names = append(h.names, name)
+		sort.Strings(h.names)
+	}
+	h.checks[name] = check
+}
+
+// SetDemo는 데모 모드(Mock Oracle 저장소) 여부를 응답에 표시하도록 설정합니다
+func (h *HealthHandler) SetDemo(demo bool) {
+	h.demo = demo
+}
+
+// SetTimeout은 의존성 검사 하나의 제한 시간을 설정합니다
+func (h *HealthHandler) SetTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		h.timeout = timeout
+	}
+}
+
+// Check는 시스템 상태를 확인하고 JSON 응답을 반환합니다 (GET /api/health)
+// 프로세스 생존 여부(liveness)만 확인하며 외부 의존성은 검사하지 않습니다
+// Circuit Breaker가 Open이면 status는 degraded이지만 프로세스는 살아 있으므로 200을 반환합니다
 func (h *HealthHandler) Check(c *fiber.Ctx) error {
 	response := HealthResponse{
 		Status:    "ok",
 		Timestamp: time.Now().UTC(),
 		Version:   h.version,
+		Demo:      h.demo,
+	}
+	if h.circuitBreakers(&response) {
+		response.Status = "degraded"
+	}
+
+	return c.JSON(response)
+}
+
+// Ready는 등록된 의존성을 병렬로 검사하여 요청 처리 가능 여부를 반환합니다 (GET /api/health/ready)
+// 하나라도 실패하면 status가 unavailable인 503을 반환합니다
+// 응답 예시:
+//
+//	{
+//	  "status": "ok",
+//	  "timestamp": "2024-01-15T10:30:00Z",
+//	  "version": "1.0.0",
+//	  "checks": {
+//	    "gcs": {"status": "ok", "latency_ms": 45},
+//	    "oracle": {"status": "ok", "latency_ms": 3}
+//	  }
+//	}
+func (h *HealthHandler) Ready(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	results := make([]DependencyHealth, len(h.names))
+	var wg sync.WaitGroup
+	for i, name := range h.names {
+		wg.Add(1)
+		go func(i int, check HealthCheckFunc) {
+			defer wg.Done()
+			results[i] = h.runCheck(ctx, check)
+		}(i, h.checks[name])
+	}
+	wg.Wait()
+
+	response := HealthResponse{
+		Status:    "ok",
+		Timestamp: time.Now().UTC(),
+		Version:   h.version,
+		Demo:      h.demo,
+		Checks:    make(map[string]DependencyHealth, len(h.names)),
+	}
+	for i, name := range h.names {
+		response.Checks[name] = results[i]
+		if results[i].Status != "ok" {
+			response.Status = "unavailable"
+		}
 	}
+	h.circuitBreakers(&response)
 
+	if response.Status != "ok" {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(response)
+	}
 	return c.JSON(response)
 }
+
+// circuitBreakers는 등록된 Circuit Breaker 상태를 응답에 채우고, Open인 것이 있는지 반환합니다
+func (h *HealthHandler) circuitBreakers(response *HealthResponse) (open bool) {
+	if len(h.breakers) == 0 {
+		return false
+	}
+	response.CircuitBreakers = make(map[string]CircuitBreakerHealth, len(h.breakers))
+	for name, cb := range h.breakers {
+		state := cb.State()
+		metrics := cb.Metrics()
+		response.CircuitBreakers[name] = CircuitBreakerHealth{
+			State:      state.String(),
+			Requests:   metrics.TotalRequests,
+			Failures:   metrics.Failures,
+			Rejections: metrics.Rejections,
+		}
+		if state == resilience.StateOpen {
+			open = true
+		}
+	}
+	return open
+}
+
+// runCheck는 제한 시간 안에서 의존성 검사 하나를 실행합니다
+func (h *HealthHandler) runCheck(ctx context.Context, check HealthCheckFunc) DependencyHealth {
+	checkCtx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(checkCtx)
+	result := DependencyHealth{
+		Status:    "ok",
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = "error"
+		result.Error = err.Error()
+		if resp := apperrors.ClassifyOracle(err); resp != nil {
+			result.Code = resp.Code
+			result.Hint = resp.Hint
+		}
+	}
+	return result
+}
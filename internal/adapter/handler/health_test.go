@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +12,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/resilience"
 )
 
 // TestHealthHandler_Success는 정상적인 health check 응답을 테스트
@@ -76,3 +80,87 @@ func TestHealthHandler_TimestampFormat(t *testing.T) {
 	diff := now.Sub(healthResp.Timestamp)
 	assert.Less(t, diff.Abs(), 5*time.Second)
 }
+
+// TestHealthHandler_Ready는 의존성 검사 결과에 따른 readiness 응답을 테스트
+func TestHealthHandler_Ready(t *testing.T) {
+	app := fiber.New()
+	handler := NewHealthHandler("1.0.0")
+	handler.SetTimeout(50 * time.Millisecond)
+	handler.AddCheck("oracle", func(ctx context.Context) error { return nil })
+	handler.AddCheck("gcs", func(ctx context.Context) error {
+		// 제한 시간을 넘기는 검사는 실패 처리
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	app.Get("/api/health/ready", handler.Ready)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/health/ready", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+
+	var healthResp HealthResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&healthResp))
+	assert.Equal(t, "unavailable", healthResp.Status)
+	assert.Equal(t, "ok", healthResp.Checks["oracle"].Status)
+	assert.Equal(t, "error", healthResp.Checks["gcs"].Status)
+	assert.Contains(t, healthResp.Checks["gcs"].Error, "deadline exceeded")
+
+	// 같은 이름으로 다시 등록하면 교체
+	handler.AddCheck("gcs", func(ctx context.Context) error { return nil })
+	resp, err = app.Test(httptest.NewRequest("GET", "/api/health/ready", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&healthResp))
+	assert.Equal(t, "ok", healthResp.Status)
+	assert.Len(t, healthResp.Checks, 2)
+}
+
+// TestHealthHandler_Demo는 데모 모드 표시를 테스트
+func TestHealthHandler_Demo(t *testing.T) {
+	app := fiber.New()
+	handler := NewHealthHandler("1.0.0")
+	handler.SetDemo(true)
+	app.Get("/api/health", handler.Check)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/health", nil), -1)
+	require.NoError(t, err)
+
+	var healthResp HealthResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&healthResp))
+	assert.True(t, healthResp.Demo)
+	assert.Nil(t, healthResp.Checks)
+}
+
+// TestHealthHandler_CircuitBreakers는 Circuit Breaker 상태 표시와 Open 시 degraded 응답을 테스트
+func TestHealthHandler_CircuitBreakers(t *testing.T) {
+	app := fiber.New()
+	handler := NewHealthHandler("1.0.0")
+	oracleCB := resilience.NewCircuitBreaker(resilience.CircuitConfig{FailureThreshold: 1, SuccessThreshold: 1, Timeout: time.Minute})
+	gcsCB := resilience.NewCircuitBreaker(resilience.DefaultCircuitConfig())
+	handler.AddCircuitBreaker("oracle", oracleCB)
+	handler.AddCircuitBreaker("gcs", gcsCB)
+	app.Get("/api/health", handler.Check)
+
+	get := func() HealthResponse {
+		resp, err := app.Test(httptest.NewRequest("GET", "/api/health", nil), -1)
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		var healthResp HealthResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&healthResp))
+		return healthResp
+	}
+
+	healthResp := get()
+	assert.Equal(t, "ok", healthResp.Status)
+	assert.Equal(t, "closed", healthResp.CircuitBreakers["oracle"].State)
+	assert.Equal(t, "closed", healthResp.CircuitBreakers["gcs"].State)
+
+	// Oracle Circuit이 열리면 liveness는 유지하되 degraded로 표시
+	_ = oracleCB.Execute(func() error { return errors.New("ORA-03113") })
+	_ = oracleCB.Execute(func() error { return nil })
+
+	healthResp = get()
+	assert.Equal(t, "degraded", healthResp.Status)
+	assert.Equal(t, CircuitBreakerHealth{State: "open", Requests: 1, Failures: 1, Rejections: 1}, healthResp.CircuitBreakers["oracle"])
+}
@@ -2,6 +2,7 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
@@ -14,12 +15,15 @@ import (
 // JobHandler는 Job 관련 HTTP 핸들러입니다
 type JobHandler struct {
 	jobSvc *usecase.JobService
+	runner *usecase.JobRunner
 }
 
 // NewJobHandler는 새로운 JobHandler를 생성합니다
-func NewJobHandler(jobSvc *usecase.JobService) *JobHandler {
+// runner가 nil이면 Oracle 미설정 상태로 간주하여 취소 요청을 거부합니다
+func NewJobHandler(jobSvc *usecase.JobService, runner *usecase.JobRunner) *JobHandler {
 	return &JobHandler{
 		jobSvc: jobSvc,
+		runner: runner,
 	}
 }
 
@@ -42,7 +46,7 @@ func (h *JobHandler) List(c *fiber.Ctx) error {
 		filter.Limit = limit
 	}
 
-	resp, err := h.jobSvc.List(c.Context(), filter)
+	resp, err := h.jobSvc.List(c.UserContext(), filter)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"code":    "INTERNAL_ERROR",
@@ -59,7 +63,7 @@ func (h *JobHandler) GetByID(c *fiber.Ctx) error {
 	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
 	id := strings.Clone(c.Params("id"))
 
-	job, err := h.jobSvc.GetByID(c.Context(), id)
+	job, err := h.jobSvc.GetByID(c.UserContext(), id)
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"code":    "JOB_NOT_FOUND",
@@ -69,3 +73,98 @@ func (h *JobHandler) GetByID(c *fiber.Ctx) error {
 
 	return c.JSON(job)
 }
+
+// Cancel은 실행 중인 Job을 취소합니다
+// POST /api/jobs/:id/cancel
+func (h *JobHandler) Cancel(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	if h.runner == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"code":    "ORACLE_NOT_CONFIGURED",
+			"message": "Oracle 연결이 설정되지 않아 Job을 취소할 수 없습니다",
+		})
+	}
+
+	job, err := h.runner.Cancel(c.UserContext(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrJobNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"code":    "JOB_NOT_FOUND",
+				"message": err.Error(),
+			})
+		case errors.Is(err, usecase.ErrJobNotCancellable):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"code":    "JOB_NOT_CANCELLABLE",
+				"message": "이미 종료된 Job은 취소할 수 없습니다",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"code":    "JOB_CANCEL_FAILED",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	return c.JSON(job)
+}
+
+// Retry는 실패/취소된 Job의 완료되지 않은 테이블을 같은 버전으로 다시 추출합니다
+// POST /api/jobs/:id/retry
+func (h *JobHandler) Retry(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	if h.runner == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"code":    "ORACLE_NOT_CONFIGURED",
+			"message": "Oracle 연결이 설정되지 않아 Job을 재시도할 수 없습니다",
+		})
+	}
+
+	job, err := h.runner.Retry(c.UserContext(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrJobNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"code":    "JOB_NOT_FOUND",
+				"message": err.Error(),
+			})
+		case errors.Is(err, usecase.ErrJobNotRetryable):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"code":    "JOB_NOT_RETRYABLE",
+				"message": err.Error(),
+			})
+		case errors.Is(err, usecase.ErrTransportNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"code":    "TRANSPORT_NOT_FOUND",
+				"message": err.Error(),
+			})
+		case errors.Is(err, usecase.ErrTransportNotExecutable):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"code":    "TRANSPORT_NOT_EXECUTABLE",
+				"message": "Transport가 이미 실행 중이거나 비활성화 상태입니다",
+			})
+		case errors.Is(err, usecase.ErrOutsideMaintenanceWindow):
+			return outsideWindow(c, err)
+		case errors.Is(err, usecase.ErrRunnerShutdown):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"code":    "SERVER_SHUTTING_DOWN",
+				"message": err.Error(),
+			})
+		default:
+			return failure(c, "JOB_RETRY_FAILED", "Job 재시도 실패", err)
+		}
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(domain.ExecuteJobResponse{
+		JobID:         job.ID,
+		TransportID:   job.TransportID,
+		Version:       job.Version,
+		Status:        job.Status,
+		Attempt:       job.Attempt,
+		DeferredUntil: job.DeferredUntil,
+	})
+}
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/domain"
 	"oracle-etl/internal/repository/memory"
 	"oracle-etl/internal/usecase"
@@ -23,7 +26,7 @@ func setupJobTestApp() (*fiber.App, *JobHandler, *usecase.TransportService, *use
 	jobRepo := memory.NewJobRepository()
 	transportSvc := usecase.NewTransportService(transportRepo)
 	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
-	handler := NewJobHandler(jobSvc)
+	handler := NewJobHandler(jobSvc, nil)
 
 	api := app.Group("/api")
 	api.Get("/jobs", handler.List)
@@ -113,8 +116,15 @@ func TestJobHandler_IntegrationWithTransport(t *testing.T) {
 	transportSvc := usecase.NewTransportService(transportRepo)
 	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
 
-	transportHandler := NewTransportHandler(transportSvc, jobSvc)
-	jobHandler := NewJobHandler(jobSvc)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 500, IsLastChunk: true, TotalRowsSent: 500},
+	}
+	executor := usecase.NewParallelExecutor(mockRepo, nil, nil, 2)
+	runner := usecase.NewJobRunner(transportSvc, jobSvc, nil, executor, nil, usecase.JobRunnerConfig{Owner: "SAPSR3"})
+
+	transportHandler := NewTransportHandler(transportSvc, jobSvc, nil, runner)
+	jobHandler := NewJobHandler(jobSvc, runner)
 
 	api := app.Group("/api")
 	api.Post("/transports", transportHandler.Create)
@@ -146,6 +156,9 @@ func TestJobHandler_IntegrationWithTransport(t *testing.T) {
 	var execResp domain.ExecuteJobResponse
 	_ = json.Unmarshal(respBody, &execResp)
 
+	// 백그라운드 실행 완료 대기
+	runner.Wait()
+
 	// Job 조회
 	req = httptest.NewRequest("GET", "/api/jobs/"+execResp.JobID, nil)
 	resp, err = app.Test(req, -1)
@@ -159,6 +172,9 @@ func TestJobHandler_IntegrationWithTransport(t *testing.T) {
 	assert.Equal(t, execResp.JobID, job.ID)
 	assert.Equal(t, transport.ID, job.TransportID)
 	assert.Equal(t, 1, job.Version)
+	assert.Equal(t, domain.JobStatusCompleted, job.Status)
+	assert.Len(t, job.Extractions, 2)
+	assert.Equal(t, int64(1000), job.Metrics.TotalRows)
 
 	// Job 목록에서 확인
 	req = httptest.NewRequest("GET", "/api/jobs?transport_id="+transport.ID, nil)
@@ -173,3 +189,107 @@ func TestJobHandler_IntegrationWithTransport(t *testing.T) {
 	assert.Len(t, listResp.Jobs, 1)
 	assert.Equal(t, 1, listResp.Total)
 }
+
+// TestJobHandler_Cancel은 Job 취소 API의 에러 응답을 테스트합니다
+func TestJobHandler_Cancel(t *testing.T) {
+	app := fiber.New()
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	jobSvc := usecase.NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := usecase.NewParallelExecutor(oracle.NewMockRepository(), nil, nil, 2)
+	runner := usecase.NewJobRunner(transportSvc, jobSvc, nil, executor, nil, usecase.JobRunnerConfig{Owner: "SAPSR3"})
+
+	app.Post("/api/jobs/:id/cancel", NewJobHandler(jobSvc, runner).Cancel)
+	app.Post("/api/unconfigured/jobs/:id/cancel", NewJobHandler(jobSvc, nil).Cancel)
+
+	transport, err := transportSvc.Create(context.Background(), domain.CreateTransportRequest{Name: "Test", Tables: []string{"TABLE1"}})
+	require.NoError(t, err)
+	job, err := runner.Trigger(context.Background(), transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		code   string
+	}{
+		{name: "존재하지 않는 Job", path: "/api/jobs/JOB-NOT-EXIST/cancel", status: 404, code: "JOB_NOT_FOUND"},
+		{name: "이미 완료된 Job", path: "/api/jobs/" + job.ID + "/cancel", status: 409, code: "JOB_NOT_CANCELLABLE"},
+		{name: "Oracle 미설정", path: "/api/unconfigured/jobs/" + job.ID + "/cancel", status: 503, code: "ORACLE_NOT_CONFIGURED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest("POST", tt.path, nil), -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.code, body["code"])
+		})
+	}
+}
+
+// TestJobHandler_Retry는 Job 재시도 API의 응답을 테스트합니다
+func TestJobHandler_Retry(t *testing.T) {
+	app := fiber.New()
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.TableErrors = map[string]error{"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist")}
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	jobSvc := usecase.NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := usecase.NewParallelExecutor(mockRepo, nil, nil, 2)
+	runner := usecase.NewJobRunner(transportSvc, jobSvc, nil, executor, nil, usecase.JobRunnerConfig{Owner: "SAPSR3"})
+
+	app.Post("/api/jobs/:id/retry", NewJobHandler(jobSvc, runner).Retry)
+	app.Post("/api/unconfigured/jobs/:id/retry", NewJobHandler(jobSvc, nil).Retry)
+
+	ctx := context.Background()
+	failing, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Failing", Tables: []string{"TABLE1", "FAIL_TABLE"}})
+	require.NoError(t, err)
+	failedJob, err := runner.Trigger(ctx, failing.ID)
+	require.NoError(t, err)
+
+	passing, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Passing", Tables: []string{"TABLE1"}})
+	require.NoError(t, err)
+	completedJob, err := runner.Trigger(ctx, passing.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	// 실패한 Job 재시도
+	resp, err := app.Test(httptest.NewRequest("POST", "/api/jobs/"+failedJob.ID+"/retry", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, 202, resp.StatusCode)
+
+	var result domain.ExecuteJobResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, failedJob.ID, result.JobID)
+	assert.Equal(t, 1, result.Version)
+	assert.Equal(t, 2, result.Attempt)
+	runner.Wait()
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		code   string
+	}{
+		{name: "존재하지 않는 Job", path: "/api/jobs/JOB-NOT-EXIST/retry", status: 404, code: "JOB_NOT_FOUND"},
+		{name: "완료된 Job", path: "/api/jobs/" + completedJob.ID + "/retry", status: 409, code: "JOB_NOT_RETRYABLE"},
+		{name: "Oracle 미설정", path: "/api/unconfigured/jobs/" + failedJob.ID + "/retry", status: 503, code: "ORACLE_NOT_CONFIGURED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest("POST", tt.path, nil), -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.code, body["code"])
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package handler는 HTTP 요청 핸들러를 제공합니다
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+
+	"oracle-etl/pkg/metrics"
+)
+
+// MetricsHandler는 Prometheus 메트릭 엔드포인트 핸들러입니다
+type MetricsHandler struct {
+	handler fiber.Handler // promhttp 핸들러를 fiber 핸들러로 변환한 것
+}
+
+// NewMetricsHandler는 새로운 MetricsHandler를 생성합니다
+func NewMetricsHandler(registry *metrics.Registry) *MetricsHandler {
+	return &MetricsHandler{
+		handler: adaptor.HTTPHandler(registry.Handler()),
+	}
+}
+
+// Get은 등록된 메트릭을 Prometheus 노출 형식으로 반환합니다 (GET /metrics)
+func (h *MetricsHandler) Get(c *fiber.Ctx) error {
+	return h.handler(c)
+}
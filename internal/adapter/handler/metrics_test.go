@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/pkg/metrics"
+)
+
+// TestMetricsHandler_Get은 Prometheus 텍스트 형식 응답을 테스트
+func TestMetricsHandler_Get(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("oracle_etl_jobs_total", "종료된 Job 수", "outcome").Inc("completed")
+
+	app := fiber.New()
+	app.Get("/metrics", NewMetricsHandler(registry).Get)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/metrics", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/plain")
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "# TYPE oracle_etl_jobs_total counter\n")
+	assert.Contains(t, string(body), `oracle_etl_jobs_total{outcome="completed"} 1`)
+}
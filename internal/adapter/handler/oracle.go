@@ -12,6 +12,7 @@ type OracleHandler struct {
 }
 
 // NewOracleHandler는 새로운 OracleHandler를 생성합니다
+// repo가 nil이면 Oracle 미설정 상태로 모든 요청에 503을 반환합니다
 func NewOracleHandler(repo oracle.Repository) *OracleHandler {
 	return &OracleHandler{
 		repo: repo,
@@ -32,16 +33,23 @@ func NewOracleHandler(repo oracle.Repository) *OracleHandler {
 //	  }
 //	}
 func (h *OracleHandler) GetStatus(c *fiber.Ctx) error {
-	ctx := c.Context()
+	if h.repo == nil {
+		return oracleNotConfigured(c)
+	}
+	ctx := c.UserContext()
 
 	status, err := h.repo.GetStatus(ctx)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"code":    "ORACLE_STATUS_ERROR",
-			"message": "Oracle 상태 조회 실패",
-			"error":   err.Error(),
-		})
+		return failure(c, "ORACLE_STATUS_ERROR", "Oracle 상태 조회 실패", err)
 	}
 
 	return c.JSON(status)
 }
+
+// oracleNotConfigured는 Oracle 연결이 설정되지 않은 서버의 503 응답을 반환합니다
+func oracleNotConfigured(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"code":    "ORACLE_NOT_CONFIGURED",
+		"message": "Oracle 연결이 설정되지 않았습니다",
+	})
+}
@@ -2,6 +2,8 @@
 package handler
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/domain"
@@ -14,6 +16,7 @@ type TableHandler struct {
 }
 
 // NewTableHandler는 새로운 TableHandler를 생성합니다
+// repo가 nil이면 Oracle 미설정 상태로 모든 요청에 503을 반환합니다
 func NewTableHandler(repo oracle.Repository, defaultOwner string) *TableHandler {
 	return &TableHandler{
 		repo:         repo,
@@ -36,7 +39,10 @@ func NewTableHandler(repo oracle.Repository, defaultOwner string) *TableHandler
 //	  "total": 40
 //	}
 func (h *TableHandler) GetTables(c *fiber.Ctx) error {
-	ctx := c.Context()
+	if h.repo == nil {
+		return oracleNotConfigured(c)
+	}
+	ctx := c.UserContext()
 
 	// 쿼리 파라미터에서 owner 추출 (없으면 기본값 사용)
 	owner := c.Query("owner", h.defaultOwner)
@@ -49,11 +55,7 @@ func (h *TableHandler) GetTables(c *fiber.Ctx) error {
 
 	tables, err := h.repo.GetTables(ctx, owner)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"code":    "TABLE_LIST_ERROR",
-			"message": "테이블 목록 조회 실패",
-			"error":   err.Error(),
-		})
+		return failure(c, "TABLE_LIST_ERROR", "테이블 목록 조회 실패", err)
 	}
 
 	response := domain.TableListResponse{
@@ -65,6 +67,7 @@ func (h *TableHandler) GetTables(c *fiber.Ctx) error {
 }
 
 // GetSampleData는 테이블의 샘플 데이터를 반환합니다 (GET /api/tables/:name/sample)
+// include_columns/exclude_columns 쿼리 파라미터(쉼표 구분 glob 패턴)로 Transport의 컬럼 필터를 미리 확인할 수 있습니다
 // 응답 예시:
 //
 //	{
@@ -77,7 +80,10 @@ func (h *TableHandler) GetTables(c *fiber.Ctx) error {
 //	  "count": 100
 //	}
 func (h *TableHandler) GetSampleData(c *fiber.Ctx) error {
-	ctx := c.Context()
+	if h.repo == nil {
+		return oracleNotConfigured(c)
+	}
+	ctx := c.UserContext()
 
 	// 경로 파라미터에서 테이블 이름 추출
 	tableName := c.Params("name")
@@ -105,21 +111,31 @@ func (h *TableHandler) GetSampleData(c *fiber.Ctx) error {
 		limit = 1000 // 최대 1000개로 제한
 	}
 
-	sample, err := h.repo.GetSampleData(ctx, owner, tableName, limit)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"code":    "SAMPLE_DATA_ERROR",
-			"message": "샘플 데이터 조회 실패",
-			"error":   err.Error(),
+	filter := domain.ColumnFilter{
+		IncludeColumns: splitQueryList(c.Query("include_columns")),
+		ExcludeColumns: splitQueryList(c.Query("exclude_columns")),
+	}
+	if err := filter.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"code":    "VALIDATION_ERROR",
+			"message": err.Error(),
 		})
 	}
 
+	sample, err := h.repo.GetSampleData(ctx, owner, tableName, limit, filter)
+	if err != nil {
+		return failure(c, "SAMPLE_DATA_ERROR", "샘플 데이터 조회 실패", err)
+	}
+
 	return c.JSON(sample)
 }
 
 // GetTableColumns는 테이블의 컬럼 정보를 반환합니다 (GET /api/tables/:name/columns)
 func (h *TableHandler) GetTableColumns(c *fiber.Ctx) error {
-	ctx := c.Context()
+	if h.repo == nil {
+		return oracleNotConfigured(c)
+	}
+	ctx := c.UserContext()
 
 	// 경로 파라미터에서 테이블 이름 추출
 	tableName := c.Params("name")
@@ -141,11 +157,7 @@ func (h *TableHandler) GetTableColumns(c *fiber.Ctx) error {
 
 	columns, err := h.repo.GetTableColumns(ctx, owner, tableName)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"code":    "COLUMN_INFO_ERROR",
-			"message": "컬럼 정보 조회 실패",
-			"error":   err.Error(),
-		})
+		return failure(c, "COLUMN_INFO_ERROR", "컬럼 정보 조회 실패", err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -154,3 +166,14 @@ func (h *TableHandler) GetTableColumns(c *fiber.Ctx) error {
 		"count":   len(columns),
 	})
 }
+
+// splitQueryList는 쉼표로 구분된 쿼리 파라미터 값을 목록으로 변환합니다 (빈 값은 nil)
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
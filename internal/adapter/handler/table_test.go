@@ -140,6 +140,27 @@ func TestTableHandler_GetSampleData(t *testing.T) {
 				assert.Equal(t, "VBRP", sample.TableName)
 			},
 		},
+		{
+			name:        "컬럼 필터 적용",
+			tableName:   "VBRP",
+			queryParams: "?include_columns=MANDT,VB*&exclude_columns=vbeln",
+			setupMock: func(m *oracle.MockRepository) {
+				// 기본 mock 설정 사용
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, sample *domain.SampleData) {
+				assert.Equal(t, []string{"MANDT"}, sample.Columns)
+				require.Len(t, sample.Rows, 2)
+				assert.Equal(t, map[string]interface{}{"MANDT": "800"}, sample.Rows[0])
+			},
+		},
+		{
+			name:           "잘못된 컬럼 패턴",
+			tableName:      "VBRP",
+			queryParams:    "?exclude_columns=ATTRIBUTE%5B",
+			expectedStatus: http.StatusBadRequest,
+			checkResponse:  nil,
+		},
 		{
 			name:        "조회 실패 시 에러 반환",
 			tableName:   "VBRP",
@@ -191,8 +212,8 @@ func TestTableHandler_GetSampleData(t *testing.T) {
 				tt.checkResponse(t, &sample)
 			}
 
-			// Mock 메서드 호출 확인
-			assert.True(t, mockRepo.GetSampleCalled)
+			// Mock 메서드 호출 확인 (요청 검증 실패 시 조회하지 않음)
+			assert.Equal(t, tt.expectedStatus != http.StatusBadRequest, mockRepo.GetSampleCalled)
 		})
 	}
 }
@@ -217,3 +238,52 @@ func TestTableHandler_GetTables_NoOwner(t *testing.T) {
 	// 상태 코드 확인 (400 Bad Request)
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
+
+func TestTableHandler_NotConfigured(t *testing.T) {
+	// Oracle 저장소 없이 생성 (Oracle 미설정)
+	handler := NewTableHandler(nil, "SAPSR3")
+
+	app := fiber.New()
+	app.Get("/api/tables", handler.GetTables)
+	app.Get("/api/tables/:name/sample", handler.GetSampleData)
+	app.Get("/api/tables/:name/columns", handler.GetTableColumns)
+
+	for _, path := range []string{"/api/tables", "/api/tables/VBRP/sample", "/api/tables/VBRP/columns"} {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, path)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "ORACLE_NOT_CONFIGURED")
+	}
+}
+
+func TestTableHandler_OracleErrorClassification(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.ShouldError = true
+	mockRepo.ErrorMessage = "샘플 데이터 조회 실패: ORA-00942: table or view does not exist"
+
+	handler := NewTableHandler(mockRepo, "SAPSR3")
+	app := fiber.New()
+	app.Get("/api/tables/:name/sample", handler.GetSampleData)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tables/NOPE/sample", nil)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// ORA-00942는 500이 아닌 404로 분류되어야 함
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "ORACLE_TABLE_NOT_FOUND", body["code"])
+	assert.NotEmpty(t, body["hint"])
+	_, hasRetryable := body["retryable"]
+	assert.False(t, hasRetryable)
+
+	details, ok := body["details"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "ORA-00942", details["ora_code"])
+}
@@ -2,6 +2,7 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
@@ -15,13 +16,18 @@ import (
 type TransportHandler struct {
 	transportSvc *usecase.TransportService
 	jobSvc       *usecase.JobService
+	watermarkSvc *usecase.WatermarkService
+	runner       *usecase.JobRunner
 }
 
 // NewTransportHandler는 새로운 TransportHandler를 생성합니다
-func NewTransportHandler(transportSvc *usecase.TransportService, jobSvc *usecase.JobService) *TransportHandler {
+// runner가 nil이면 Oracle 미설정 상태로 간주하여 실행 요청을 거부합니다
+func NewTransportHandler(transportSvc *usecase.TransportService, jobSvc *usecase.JobService, watermarkSvc *usecase.WatermarkService, runner *usecase.JobRunner) *TransportHandler {
 	return &TransportHandler{
 		transportSvc: transportSvc,
 		jobSvc:       jobSvc,
+		watermarkSvc: watermarkSvc,
+		runner:       runner,
 	}
 }
 
@@ -36,10 +42,17 @@ func (h *TransportHandler) Create(c *fiber.Ctx) error {
 		})
 	}
 
-	transport, err := h.transportSvc.Create(c.Context(), req)
+	transport, err := h.transportSvc.Create(c.UserContext(), req)
 	if err != nil {
+		code := "VALIDATION_ERROR"
+		switch {
+		case errors.Is(err, usecase.ErrInvalidSource):
+			code = "INVALID_SOURCE"
+		case errors.Is(err, usecase.ErrInvalidColumns):
+			code = "INVALID_COLUMNS"
+		}
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"code":    "VALIDATION_ERROR",
+			"code":    code,
 			"message": err.Error(),
 		})
 	}
@@ -53,7 +66,7 @@ func (h *TransportHandler) List(c *fiber.Ctx) error {
 	offset, _ := strconv.Atoi(c.Query("offset", "0"))
 	limit, _ := strconv.Atoi(c.Query("limit", "20"))
 
-	resp, err := h.transportSvc.List(c.Context(), offset, limit)
+	resp, err := h.transportSvc.List(c.UserContext(), offset, limit)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"code":    "INTERNAL_ERROR",
@@ -70,7 +83,7 @@ func (h *TransportHandler) GetByID(c *fiber.Ctx) error {
 	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
 	id := strings.Clone(c.Params("id"))
 
-	transport, err := h.transportSvc.GetByID(c.Context(), id)
+	transport, err := h.transportSvc.GetByID(c.UserContext(), id)
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"code":    "TRANSPORT_NOT_FOUND",
@@ -78,66 +91,346 @@ func (h *TransportHandler) GetByID(c *fiber.Ctx) error {
 		})
 	}
 
+	setETag(c, transport)
 	return c.JSON(transport)
 }
 
+// Update는 Transport 정의를 요청 본문으로 교체합니다
+// If-Match 헤더가 있으면 해당 ETag 이후 수정된 경우 412를 반환합니다
+// PUT /api/transports/:id
+func (h *TransportHandler) Update(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	expected, ok := ifMatch(c)
+	if !ok {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"code":    "TRANSPORT_MODIFIED",
+			"message": "If-Match 헤더가 유효한 ETag가 아닙니다",
+		})
+	}
+
+	var req domain.UpdateTransportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"code":    "INVALID_REQUEST",
+			"message": "요청 본문을 파싱할 수 없습니다: " + err.Error(),
+		})
+	}
+
+	transport, err := h.transportSvc.Replace(c.UserContext(), id, req, expected)
+	if err != nil {
+		return transportUpdateError(c, err)
+	}
+
+	setETag(c, transport)
+	return c.JSON(transport)
+}
+
+// Patch는 JSON Merge Patch(RFC 7386)로 Transport 정의의 일부를 수정합니다
+// PATCH /api/transports/:id
+func (h *TransportHandler) Patch(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	expected, ok := ifMatch(c)
+	if !ok {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"code":    "TRANSPORT_MODIFIED",
+			"message": "If-Match 헤더가 유효한 ETag가 아닙니다",
+		})
+	}
+
+	transport, err := h.transportSvc.Patch(c.UserContext(), id, c.Body(), expected)
+	if err != nil {
+		return transportUpdateError(c, err)
+	}
+
+	setETag(c, transport)
+	return c.JSON(transport)
+}
+
+// ListRevisions는 Transport 정의의 리비전 이력을 최신순으로 조회합니다
+// GET /api/transports/:id/revisions
+func (h *TransportHandler) ListRevisions(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	resp, err := h.transportSvc.ListRevisions(c.UserContext(), id)
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.JSON(resp)
+}
+
+// GetRevision은 Transport의 특정 리비전을 조회합니다
+// GET /api/transports/:id/revisions/:revision
+func (h *TransportHandler) GetRevision(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	revision, err := c.ParamsInt("revision")
+	if err != nil || revision < 1 {
+		return invalidRevision(c, c.Params("revision"))
+	}
+
+	rev, err := h.transportSvc.GetRevision(c.UserContext(), id, revision)
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.JSON(rev)
+}
+
+// DiffRevisions는 두 리비전 사이에 바뀐 정의 필드를 조회합니다
+// from 쿼리를 생략하면 직전 리비전과 비교합니다
+// GET /api/transports/:id/revisions/:revision/diff?from=N
+func (h *TransportHandler) DiffRevisions(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	revision, err := c.ParamsInt("revision")
+	if err != nil || revision < 1 {
+		return invalidRevision(c, c.Params("revision"))
+	}
+	from := revision - 1
+	if raw := c.Query("from"); raw != "" {
+		if from, err = strconv.Atoi(raw); err != nil || from < 1 {
+			return invalidRevision(c, raw)
+		}
+	}
+	if from < 1 {
+		return invalidRevision(c, strconv.Itoa(from))
+	}
+
+	diff, err := h.transportSvc.DiffRevisions(c.UserContext(), id, from, revision)
+	if err != nil {
+		return revisionError(c, err)
+	}
+
+	return c.JSON(diff)
+}
+
+// Rollback은 지정한 리비전의 정의를 새 리비전으로 복원합니다
+// POST /api/transports/:id/revisions/:revision/rollback
+func (h *TransportHandler) Rollback(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	revision, err := c.ParamsInt("revision")
+	if err != nil || revision < 1 {
+		return invalidRevision(c, c.Params("revision"))
+	}
+	expected, ok := ifMatch(c)
+	if !ok {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"code":    "TRANSPORT_MODIFIED",
+			"message": "If-Match 헤더가 유효한 ETag가 아닙니다",
+		})
+	}
+
+	transport, err := h.transportSvc.Rollback(c.UserContext(), id, revision, expected)
+	if err != nil {
+		if errors.Is(err, usecase.ErrRevisionNotFound) {
+			return revisionError(c, err)
+		}
+		return transportUpdateError(c, err)
+	}
+
+	setETag(c, transport)
+	return c.JSON(transport)
+}
+
+// transportETag는 Transport 정의의 리비전으로 ETag 값을 생성합니다
+// Job 실행으로 바뀌는 상태나 UpdatedAt은 정의 수정이 아니므로 ETag에 반영하지 않습니다
+func transportETag(transport *domain.Transport) string {
+	return `"r` + strconv.Itoa(transport.Revision) + `"`
+}
+
+// setETag는 응답에 Transport의 ETag 헤더를 설정합니다
+func setETag(c *fiber.Ctx, transport *domain.Transport) {
+	c.Set(fiber.HeaderETag, transportETag(transport))
+}
+
+// ifMatch는 If-Match 헤더의 ETag를 리비전 번호로 변환합니다
+// 헤더가 없거나 *이면 조건 없이 수정하도록 0을 반환하며, 형식이 잘못되면 false를 반환합니다
+func ifMatch(c *fiber.Ctx) (int, bool) {
+	value := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if value == "" || value == "*" {
+		return 0, true
+	}
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	if !strings.HasPrefix(value, "r") {
+		return 0, false
+	}
+	revision, err := strconv.Atoi(value[1:])
+	if err != nil || revision < 1 {
+		return 0, false
+	}
+	return revision, true
+}
+
+// transportUpdateError는 Transport 수정 실패를 HTTP 응답으로 변환합니다
+func transportUpdateError(c *fiber.Ctx, err error) error {
+	status, code := fiber.StatusBadRequest, "VALIDATION_ERROR"
+	switch {
+	case errors.Is(err, usecase.ErrTransportNotFound):
+		status, code = fiber.StatusNotFound, "TRANSPORT_NOT_FOUND"
+	case errors.Is(err, usecase.ErrTransportModified):
+		status, code = fiber.StatusPreconditionFailed, "TRANSPORT_MODIFIED"
+	case errors.Is(err, usecase.ErrInvalidPatch):
+		code = "INVALID_PATCH"
+	case errors.Is(err, usecase.ErrInvalidSource):
+		code = "INVALID_SOURCE"
+	case errors.Is(err, usecase.ErrInvalidColumns):
+		code = "INVALID_COLUMNS"
+	}
+	return c.Status(status).JSON(fiber.Map{
+		"code":    code,
+		"message": err.Error(),
+	})
+}
+
+// revisionError는 리비전 조회 실패를 HTTP 응답으로 변환합니다
+func revisionError(c *fiber.Ctx, err error) error {
+	status, code := fiber.StatusInternalServerError, "INTERNAL_ERROR"
+	switch {
+	case errors.Is(err, usecase.ErrTransportNotFound):
+		status, code = fiber.StatusNotFound, "TRANSPORT_NOT_FOUND"
+	case errors.Is(err, usecase.ErrRevisionNotFound):
+		status, code = fiber.StatusNotFound, "REVISION_NOT_FOUND"
+	}
+	return c.Status(status).JSON(fiber.Map{
+		"code":    code,
+		"message": err.Error(),
+	})
+}
+
+// invalidRevision은 잘못된 리비전 번호에 대한 400 응답을 반환합니다
+func invalidRevision(c *fiber.Ctx, value string) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"code":    "INVALID_REQUEST",
+		"message": "리비전 번호가 유효하지 않습니다: " + value,
+	})
+}
+
 // Delete는 Transport를 삭제합니다
 // DELETE /api/transports/:id
 func (h *TransportHandler) Delete(c *fiber.Ctx) error {
 	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
 	id := strings.Clone(c.Params("id"))
 
-	if err := h.transportSvc.Delete(c.Context(), id); err != nil {
+	if err := h.transportSvc.Delete(c.UserContext(), id); err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"code":    "TRANSPORT_NOT_FOUND",
 			"message": err.Error(),
 		})
 	}
 
+	// 삭제된 Transport의 증분 추출 기준값 정리
+	if h.watermarkSvc != nil {
+		if err := h.watermarkSvc.Reset(c.UserContext(), id); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			})
+		}
+	}
+
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
 // Execute는 Transport를 실행하고 새 Job을 생성합니다
+// Job은 백그라운드에서 실행되며 응답은 pending 상태의 Job 정보입니다
+// 요청 본문은 선택이며 full_reload가 true면 watermark를 무시하고 전체 추출합니다
 // POST /api/transports/:id/execute
 func (h *TransportHandler) Execute(c *fiber.Ctx) error {
 	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
 	transportID := strings.Clone(c.Params("id"))
 
-	// Transport 존재 여부 및 실행 가능 여부 확인
-	transport, err := h.transportSvc.GetByID(c.Context(), transportID)
+	if h.runner == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"code":    "ORACLE_NOT_CONFIGURED",
+			"message": "Oracle 연결이 설정되지 않아 Transport를 실행할 수 없습니다",
+		})
+	}
+
+	var req domain.ExecuteTransportRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"code":    "INVALID_REQUEST",
+				"message": "요청 본문을 파싱할 수 없습니다: " + err.Error(),
+			})
+		}
+	}
+
+	// Job 생성 및 백그라운드 실행 시작
+	job, err := h.runner.TriggerWithOptions(c.UserContext(), transportID, usecase.TriggerOptions{
+		FullReload: req.FullReload,
+	})
 	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTransportNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"code":    "TRANSPORT_NOT_FOUND",
+				"message": err.Error(),
+			})
+		case errors.Is(err, usecase.ErrTransportNotExecutable):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"code":    "TRANSPORT_NOT_EXECUTABLE",
+				"message": "Transport가 이미 실행 중이거나 비활성화 상태입니다",
+			})
+		case errors.Is(err, usecase.ErrOutsideMaintenanceWindow):
+			return outsideWindow(c, err)
+		case errors.Is(err, usecase.ErrRunnerShutdown):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"code":    "SERVER_SHUTTING_DOWN",
+				"message": err.Error(),
+			})
+		default:
+			return failure(c, "JOB_CREATION_FAILED", "Job 생성 실패", err)
+		}
+	}
+
+	// 응답 생성
+	resp := domain.ExecuteJobResponse{
+		JobID:         job.ID,
+		TransportID:   job.TransportID,
+		Version:       job.Version,
+		Status:        job.Status,
+		Attempt:       job.Attempt,
+		DeferredUntil: job.DeferredUntil,
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(resp)
+}
+
+// ListWatermarks는 Transport의 테이블별 증분 추출 기준값을 조회합니다
+// GET /api/transports/:id/watermarks
+func (h *TransportHandler) ListWatermarks(c *fiber.Ctx) error {
+	// fasthttp 버퍼 재사용 문제 방지를 위해 문자열 복사
+	id := strings.Clone(c.Params("id"))
+
+	if _, err := h.transportSvc.GetByID(c.UserContext(), id); err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"code":    "TRANSPORT_NOT_FOUND",
 			"message": err.Error(),
 		})
 	}
 
-	if !transport.CanExecute() {
-		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
-			"code":    "TRANSPORT_NOT_EXECUTABLE",
-			"message": "Transport가 이미 실행 중이거나 비활성화 상태입니다",
-		})
+	if h.watermarkSvc == nil {
+		return c.JSON(domain.WatermarkListResponse{TransportID: id, Watermarks: []domain.Watermark{}})
 	}
 
-	// 새 Job 생성
-	job, err := h.jobSvc.CreateJob(c.Context(), transportID)
+	resp, err := h.watermarkSvc.List(c.UserContext(), id)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"code":    "JOB_CREATION_FAILED",
+			"code":    "INTERNAL_ERROR",
 			"message": err.Error(),
 		})
 	}
 
-	// 응답 생성
-	resp := domain.ExecuteJobResponse{
-		JobID:       job.ID,
-		TransportID: job.TransportID,
-		Version:     job.Version,
-		Status:      job.Status,
-	}
-
-	// Note: 실제 ETL 실행은 비동기로 처리됩니다
-	// TODO: M5에서 goroutine으로 Oracle 추출 + GCS 업로드 실행
-
-	return c.Status(fiber.StatusAccepted).JSON(resp)
+	return c.JSON(resp)
 }
@@ -2,16 +2,22 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/domain"
+	"oracle-etl/internal/maintenance"
 	"oracle-etl/internal/repository/memory"
 	"oracle-etl/internal/usecase"
 )
@@ -23,7 +29,10 @@ func setupTransportTestApp() (*fiber.App, *TransportHandler) {
 	jobRepo := memory.NewJobRepository()
 	transportSvc := usecase.NewTransportService(transportRepo)
 	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
-	handler := NewTransportHandler(transportSvc, jobSvc)
+	executor := usecase.NewParallelExecutor(oracle.NewMockRepository(), nil, nil, 2)
+	watermarkSvc := usecase.NewWatermarkService(memory.NewWatermarkRepository())
+	runner := usecase.NewJobRunner(transportSvc, jobSvc, watermarkSvc, executor, nil, usecase.JobRunnerConfig{Owner: "SAPSR3"})
+	handler := NewTransportHandler(transportSvc, jobSvc, watermarkSvc, runner)
 
 	api := app.Group("/api")
 	api.Post("/transports", handler.Create)
@@ -31,6 +40,13 @@ func setupTransportTestApp() (*fiber.App, *TransportHandler) {
 	api.Get("/transports/:id", handler.GetByID)
 	api.Delete("/transports/:id", handler.Delete)
 	api.Post("/transports/:id/execute", handler.Execute)
+	api.Get("/transports/:id/watermarks", handler.ListWatermarks)
+	api.Put("/transports/:id", handler.Update)
+	api.Patch("/transports/:id", handler.Patch)
+	api.Get("/transports/:id/revisions", handler.ListRevisions)
+	api.Get("/transports/:id/revisions/:revision", handler.GetRevision)
+	api.Get("/transports/:id/revisions/:revision/diff", handler.DiffRevisions)
+	api.Post("/transports/:id/revisions/:revision/rollback", handler.Rollback)
 
 	return app, handler
 }
@@ -214,3 +230,402 @@ func TestTransportHandler_Execute(t *testing.T) {
 	assert.Equal(t, 1, execResp.Version)
 	assert.Equal(t, domain.JobStatusPending, execResp.Status)
 }
+
+// TestTransportHandler_ExecuteNotFound는 존재하지 않는 Transport 실행을 테스트합니다
+func TestTransportHandler_ExecuteNotFound(t *testing.T) {
+	app, _ := setupTransportTestApp()
+
+	req := httptest.NewRequest("POST", "/api/transports/non-existent/execute", nil)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+// TestTransportHandler_ExecuteWithoutRunner는 Oracle 미설정 시 실행 거부를 테스트합니다
+func TestTransportHandler_ExecuteWithoutRunner(t *testing.T) {
+	app := fiber.New()
+	transportRepo := memory.NewTransportRepository()
+	jobRepo := memory.NewJobRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	jobSvc := usecase.NewJobService(jobRepo, transportRepo)
+	handler := NewTransportHandler(transportSvc, jobSvc, nil, nil)
+	app.Post("/api/transports/:id/execute", handler.Execute)
+
+	req := httptest.NewRequest("POST", "/api/transports/TRPID-12345678/execute", nil)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+}
+
+// TestTransportHandler_ExecuteOutsideMaintenanceWindow는 유지보수 시간대 밖 실행 거부와 실행 가능 상태 조회를 테스트합니다
+func TestTransportHandler_ExecuteOutsideMaintenanceWindow(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	calendar, err := maintenance.New([]maintenance.Window{
+		{Name: "month_end_close", Type: maintenance.TypeBlackout, Global: true, Ranges: []maintenance.Range{
+			{Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+		}},
+	})
+	require.NoError(t, err)
+	cfg := usecase.MaintenanceConfig{Calendar: calendar}
+
+	app := fiber.New()
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := usecase.NewTransportService(transportRepo)
+	transportSvc.SetMaintenance(cfg)
+	jobSvc := usecase.NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := usecase.NewParallelExecutor(oracle.NewMockRepository(), nil, nil, 2)
+	runner := usecase.NewJobRunner(transportSvc, jobSvc, nil, executor, nil, usecase.JobRunnerConfig{Owner: "SAPSR3"})
+	runner.SetMaintenance(cfg)
+	handler := NewTransportHandler(transportSvc, jobSvc, nil, runner)
+	app.Get("/api/transports/:id", handler.GetByID)
+	app.Post("/api/transports/:id/execute", handler.Execute)
+
+	transport, err := transportSvc.Create(context.Background(), domain.CreateTransportRequest{Name: "Test", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/api/transports/"+transport.ID+"/execute", nil)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 409, resp.StatusCode)
+
+	var errResp struct {
+		Code          string    `json:"code"`
+		NextAllowedAt time.Time `json:"next_allowed_at"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, "OUTSIDE_MAINTENANCE_WINDOW", errResp.Code)
+	assert.True(t, errResp.NextAllowedAt.Equal(now.Add(time.Hour)))
+
+	// 조회 시 실행 가능 상태 포함
+	req = httptest.NewRequest("GET", "/api/transports/"+transport.ID, nil)
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	var fetched domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&fetched))
+	require.NotNil(t, fetched.ExecutionWindow)
+	assert.False(t, fetched.ExecutionWindow.Allowed)
+	assert.Equal(t, []string{"month_end_close"}, fetched.ExecutionWindow.Windows)
+	assert.Contains(t, fetched.ExecutionWindow.Reason, "month_end_close")
+}
+
+// TestTransportHandler_CreateWithSchedule은 스케줄 포함 Transport 생성 API를 테스트합니다
+func TestTransportHandler_CreateWithSchedule(t *testing.T) {
+	app, _ := setupTransportTestApp()
+
+	body := []byte(`{"name":"Nightly","tables":["VBRP"],"schedule":{"expression":"0 2 * * *"}}`)
+	req := httptest.NewRequest("POST", "/api/transports", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode)
+
+	respBody, _ := io.ReadAll(resp.Body)
+	var transport domain.Transport
+	require.NoError(t, json.Unmarshal(respBody, &transport))
+	require.NotNil(t, transport.Schedule)
+	assert.Equal(t, "Asia/Seoul", transport.Schedule.Timezone)
+	require.NotNil(t, transport.Schedule.NextRunAt)
+	assert.True(t, transport.Schedule.NextRunAt.After(*transport.Schedule.PrevRunAt))
+
+	// 잘못된 cron 표현식
+	body = []byte(`{"name":"Bad","tables":["VBRP"],"schedule":{"expression":"every night"}}`)
+	req = httptest.NewRequest("POST", "/api/transports", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+// TestTransportHandler_ExecuteFullReload는 실행 요청 본문(full_reload) 처리를 테스트합니다
+func TestTransportHandler_ExecuteFullReload(t *testing.T) {
+	app, handler := setupTransportTestApp()
+
+	body := []byte(`{"name":"Incremental","tables":["VBRP"],"table_options":{"VBRP":{"watermark_column":"LAST_UPDATE_DATE"}}}`)
+	req := httptest.NewRequest("POST", "/api/transports", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode)
+
+	respBody, _ := io.ReadAll(resp.Body)
+	var created domain.Transport
+	require.NoError(t, json.Unmarshal(respBody, &created))
+	assert.Equal(t, "LAST_UPDATE_DATE", created.TableOptions["VBRP"].WatermarkColumn)
+
+	// 잘못된 본문
+	req = httptest.NewRequest("POST", "/api/transports/"+created.ID+"/execute", bytes.NewReader([]byte(`{"full_reload":`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+
+	req = httptest.NewRequest("POST", "/api/transports/"+created.ID+"/execute", bytes.NewReader([]byte(`{"full_reload":true}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 202, resp.StatusCode)
+	handler.runner.Wait()
+}
+
+// TestTransportHandler_CreateInvalidTableOptions는 잘못된 테이블 옵션 거부를 테스트합니다
+func TestTransportHandler_CreateInvalidTableOptions(t *testing.T) {
+	app, _ := setupTransportTestApp()
+
+	bodies := []string{
+		`{"name":"Bad","tables":["VBRP"],"table_options":{"VBRK":{"watermark_column":"AEDAT"}}}`,
+		`{"name":"Bad","tables":["VBRP"],"table_options":{"VBRP":{"watermark_column":"AEDAT; DROP TABLE X"}}}`,
+		`{"name":"Bad","tables":["VBRP"],"table_options":{"VBRP":{"exclude_columns":["ATTRIBUTE1, (SELECT"]}}}`,
+	}
+	for _, body := range bodies {
+		req := httptest.NewRequest("POST", "/api/transports", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode)
+	}
+}
+
+// TestTransportHandler_CreateInvalidSource는 추출 원본 구문 검증 실패 응답을 테스트합니다
+func TestTransportHandler_CreateInvalidSource(t *testing.T) {
+	app, handler := setupTransportTestApp()
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.SourceErrors = map[string]error{
+		"BAD_JOIN": errors.New("ORA-00942: table or view does not exist"),
+	}
+	handler.transportSvc.SetSchemaValidator(mockRepo, "APPS")
+
+	body := `{"name":"Bad","sources":[{"name":"BAD_JOIN","sql":"SELECT * FROM missing_view"}]}`
+	req := httptest.NewRequest("POST", "/api/transports", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var errResp map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, "INVALID_SOURCE", errResp["code"])
+	assert.Contains(t, errResp["message"], "ORA-00942")
+
+	// 원본 정의 형식 오류는 일반 유효성 검사 에러
+	body = `{"name":"Bad","sources":[{"name":"DDL","sql":"DELETE FROM ap_invoices_all"}]}`
+	req = httptest.NewRequest("POST", "/api/transports", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+	errResp = nil
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, "VALIDATION_ERROR", errResp["code"])
+}
+
+// TestTransportHandler_ListWatermarks는 Watermark 조회 API를 테스트합니다
+func TestTransportHandler_ListWatermarks(t *testing.T) {
+	app, handler := setupTransportTestApp()
+	ctx := context.Background()
+
+	created, err := handler.transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Incremental",
+		Tables: []string{"VBRP"},
+		TableOptions: map[string]domain.TableOptions{
+			"VBRP": {WatermarkColumn: "LAST_UPDATE_DATE"},
+		},
+	})
+	require.NoError(t, err)
+
+	to := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, handler.watermarkSvc.Advance(ctx, created.ID, "JOB-001", []domain.Extraction{
+		{TableName: "VBRP", Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", To: &to}},
+	}))
+
+	req := httptest.NewRequest("GET", "/api/transports/"+created.ID+"/watermarks", nil)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	respBody, _ := io.ReadAll(resp.Body)
+	var list domain.WatermarkListResponse
+	require.NoError(t, json.Unmarshal(respBody, &list))
+	assert.Equal(t, created.ID, list.TransportID)
+	require.Len(t, list.Watermarks, 1)
+	assert.Equal(t, "LAST_UPDATE_DATE", list.Watermarks[0].Column)
+	assert.True(t, to.Equal(list.Watermarks[0].Value))
+
+	// 존재하지 않는 Transport
+	req = httptest.NewRequest("GET", "/api/transports/non-existent/watermarks", nil)
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+// TestTransportHandler_UpdateWithETag는 PUT/PATCH의 ETag 기반 동시성 제어를 테스트합니다
+func TestTransportHandler_UpdateWithETag(t *testing.T) {
+	app, _ := setupTransportTestApp()
+
+	send := func(method, path, ifMatch string, body []byte) *http.Response {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := send("POST", "/api/transports", "", []byte(`{"name":"Billing","tables":["VBRK"]}`))
+	require.Equal(t, 201, resp.StatusCode)
+	var created domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp = send("GET", "/api/transports/"+created.ID, "", nil)
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// 현재 ETag로 교체
+	resp = send("PUT", "/api/transports/"+created.ID, etag, []byte(`{"name":"Billing v2","tables":["VBRK","VBRP"]}`))
+	require.Equal(t, 200, resp.StatusCode)
+	var updated domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, []string{"VBRK", "VBRP"}, updated.Tables)
+	newETag := resp.Header.Get("ETag")
+	assert.NotEqual(t, etag, newETag)
+
+	// 이전 ETag는 412
+	resp = send("PATCH", "/api/transports/"+created.ID, etag, []byte(`{"description":"stale"}`))
+	assert.Equal(t, 412, resp.StatusCode)
+	var errResp map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, "TRANSPORT_MODIFIED", errResp["code"])
+
+	resp = send("PATCH", "/api/transports/"+created.ID, "W/"+newETag, []byte(`{"description":"야간 적재"}`))
+	require.Equal(t, 200, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+	assert.Equal(t, 3, updated.Revision)
+	assert.Equal(t, "Billing v2", updated.Name)
+	assert.Equal(t, "야간 적재", updated.Description)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		ifMatch  string
+		body     string
+		expected int
+		code     string
+	}{
+		{"잘못된 If-Match", "PUT", "/api/transports/" + created.ID, `"abc"`, `{"name":"x","tables":["A"]}`, 412, "TRANSPORT_MODIFIED"},
+		{"필수 필드 누락", "PUT", "/api/transports/" + created.ID, "*", `{"name":"x"}`, 400, "VALIDATION_ERROR"},
+		{"알 수 없는 patch 필드", "PATCH", "/api/transports/" + created.ID, "", `{"nope":true}`, 400, "INVALID_PATCH"},
+		{"존재하지 않는 Transport", "PATCH", "/api/transports/non-existent", "", `{"name":"x"}`, 404, "TRANSPORT_NOT_FOUND"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := send(tt.method, tt.path, tt.ifMatch, []byte(tt.body))
+			assert.Equal(t, tt.expected, resp.StatusCode)
+			var errResp map[string]interface{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+			assert.Equal(t, tt.code, errResp["code"])
+		})
+	}
+}
+
+// TestTransportHandler_ETagSurvivesStatusChange는 Job 실행에 따른 상태 변경 후에도 이전 ETag로 수정할 수 있는지 테스트합니다
+func TestTransportHandler_ETagSurvivesStatusChange(t *testing.T) {
+	app, handler := setupTransportTestApp()
+
+	req := httptest.NewRequest("POST", "/api/transports", bytes.NewReader([]byte(`{"name":"Billing","tables":["VBRK"]}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode)
+	var created domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/api/transports/"+created.ID, nil), -1)
+	require.NoError(t, err)
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// Job 시작/종료로 상태와 UpdatedAt이 바뀜
+	ctx := context.Background()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, handler.transportSvc.UpdateStatus(ctx, created.ID, domain.TransportStatusRunning))
+	require.NoError(t, handler.transportSvc.UpdateStatus(ctx, created.ID, domain.TransportStatusIdle))
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/api/transports/"+created.ID, nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, etag, resp.Header.Get("ETag"), "정의가 바뀌지 않으면 ETag도 같아야 함")
+
+	req = httptest.NewRequest("PATCH", "/api/transports/"+created.ID, bytes.NewReader([]byte(`{"description":"야간 적재"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
+	resp, err = app.Test(req, -1)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+	var updated domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, "야간 적재", updated.Description)
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+}
+
+// TestTransportHandler_Revisions는 리비전 조회, 비교, 롤백 API를 테스트합니다
+func TestTransportHandler_Revisions(t *testing.T) {
+	app, _ := setupTransportTestApp()
+
+	send := func(method, path string, body []byte) *http.Response {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := send("POST", "/api/transports", []byte(`{"name":"Billing","tables":["VBRK"]}`))
+	require.Equal(t, 201, resp.StatusCode)
+	var created domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	base := "/api/transports/" + created.ID
+
+	require.Equal(t, 200, send("PATCH", base, []byte(`{"tables":["VBRK","VBRP"]}`)).StatusCode)
+
+	resp = send("GET", base+"/revisions", nil)
+	require.Equal(t, 200, resp.StatusCode)
+	var list domain.TransportRevisionListResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	assert.Equal(t, 2, list.CurrentRevision)
+	require.Len(t, list.Revisions, 2)
+
+	// from 생략 시 직전 리비전과 비교
+	resp = send("GET", base+"/revisions/2/diff", nil)
+	require.Equal(t, 200, resp.StatusCode)
+	var diff domain.TransportRevisionDiff
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&diff))
+	assert.Equal(t, 1, diff.FromRevision)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "tables", diff.Changes[0].Field)
+
+	resp = send("POST", base+"/revisions/1/rollback", nil)
+	require.Equal(t, 200, resp.StatusCode)
+	var rolledBack domain.Transport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&rolledBack))
+	assert.Equal(t, 3, rolledBack.Revision)
+	assert.Equal(t, []string{"VBRK"}, rolledBack.Tables)
+
+	resp = send("GET", base+"/revisions/3", nil)
+	require.Equal(t, 200, resp.StatusCode)
+	var rev domain.TransportRevision
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&rev))
+	assert.Equal(t, domain.RevisionActionRollback, rev.Action)
+	assert.Equal(t, 1, rev.SourceRevision)
+
+	assert.Equal(t, 404, send("GET", base+"/revisions/9", nil).StatusCode)
+	assert.Equal(t, 404, send("POST", base+"/revisions/9/rollback", nil).StatusCode)
+	assert.Equal(t, 400, send("GET", base+"/revisions/abc", nil).StatusCode)
+	assert.Equal(t, 400, send("GET", base+"/revisions/1/diff", nil).StatusCode)
+	assert.Equal(t, 404, send("GET", "/api/transports/non-existent/revisions", nil).StatusCode)
+}
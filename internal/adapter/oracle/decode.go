@@ -0,0 +1,148 @@
+// Package oracle은 Oracle 데이터베이스 연결 및 데이터 추출 기능을 제공합니다.
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/pkg/rowset"
+)
+
+// rowDecoder는 쿼리 결과 row를 typed row로 디코딩합니다
+// 스캔 대상 슬라이스는 쿼리당 한 번 만들어 모든 row에서 재사용합니다
+type rowDecoder struct {
+	schema   *rowset.Schema
+	kinds    []rowset.Kind
+	policies []domain.LOBPolicy // 컬럼별 LOB 정책 (LOB이 아니거나 기본 정책이면 빈 값)
+	limits   []int64            // 컬럼별 row에 포함하는 값의 최대 바이트 수 (LOB 컬럼만, 0이면 제한 없음)
+	writer   domain.LOBWriter   // object 정책 LOB 기록 대상
+	raw      []interface{}
+	dest     []interface{}
+	rows     int64 // 디코딩한 row 수 (object 정책 LOB의 row 번호)
+}
+
+// newRowDecoder는 컬럼 타입 정보로 rowDecoder를 생성합니다
+// LOB 정책이 지정된 컬럼은 정책에 맞는 값 표현을 사용합니다 (text/object는 문자열, base64는 바이너리)
+// maxInline이 0보다 크면 row에 포함하는 LOB 값이 이 크기를 넘을 때 디코딩에 실패합니다
+func newRowDecoder(colTypes []*sql.ColumnType, lobs domain.LOBPolicies, writer domain.LOBWriter, maxInline int64) (*rowDecoder, error) {
+	columns := make([]rowset.Column, len(colTypes))
+	kinds := make([]rowset.Kind, len(colTypes))
+	policies := make([]domain.LOBPolicy, len(colTypes))
+	limits := make([]int64, len(colTypes))
+	for i, ct := range colTypes {
+		kinds[i] = valueKind(ct.DatabaseTypeName())
+		if domain.IsLOBType(ct.DatabaseTypeName()) {
+			limits[i] = maxInline
+		}
+		if policy := lobs.Policy(ct.Name()); policy != "" {
+			if !domain.IsLOBType(ct.DatabaseTypeName()) {
+				return nil, fmt.Errorf("LOB 정책 컬럼 %s이(가) LOB 타입이 아닙니다 (%s)", ct.Name(), ct.DatabaseTypeName())
+			}
+			if policy == domain.LOBPolicyObject && writer == nil {
+				return nil, fmt.Errorf("LOB 컬럼 %s의 object 정책에는 GCS 설정이 필요합니다", ct.Name())
+			}
+			policies[i] = policy
+			switch policy {
+			case domain.LOBPolicyText, domain.LOBPolicyObject:
+				kinds[i] = rowset.KindString
+			case domain.LOBPolicyBase64:
+				kinds[i] = rowset.KindBytes
+			}
+		}
+		columns[i] = rowset.Column{Name: ct.Name(), Kind: kinds[i]}
+	}
+
+	d := &rowDecoder{
+		schema:   rowset.NewSchema(columns),
+		kinds:    kinds,
+		policies: policies,
+		limits:   limits,
+		writer:   writer,
+		raw:      make([]interface{}, len(colTypes)),
+		dest:     make([]interface{}, len(colTypes)),
+	}
+	for i := range d.raw {
+		d.dest[i] = &d.raw[i]
+	}
+	return d, nil
+}
+
+// scan은 현재 row를 읽어 buf의 슬랩에 디코딩합니다
+// LOB 값은 다음 row를 fetch하기 전에 정책에 따라 읽거나(text/base64), 객체로 스트리밍하거나(object), 읽지 않습니다(skip)
+func (d *rowDecoder) scan(ctx context.Context, rows *sql.Rows, buf *rowset.Buffer) (rowset.Row, error) {
+	if err := rows.Scan(d.dest...); err != nil {
+		return rowset.Row{}, fmt.Errorf("데이터 스캔 실패: %w", err)
+	}
+	d.rows++
+
+	row := buf.Next()
+	for i, kind := range d.kinds {
+		var err error
+		switch d.policies[i] {
+		case domain.LOBPolicySkip:
+			row.Values[i] = rowset.Value{}
+		case domain.LOBPolicyObject:
+			err = d.writeLOB(ctx, i, &row.Values[i])
+		default:
+			err = row.Values[i].SetLimit(kind, d.raw[i], d.limits[i])
+			if errors.Is(err, rowset.ErrValueTooLarge) {
+				err = fmt.Errorf("%w (큰 LOB은 lob_columns에 object 정책을 지정하세요)", err)
+			}
+		}
+		if err != nil {
+			return rowset.Row{}, fmt.Errorf("컬럼 %s 변환 실패: %w", d.schema.Column(i).Name, err)
+		}
+		d.raw[i] = nil
+	}
+	return row, nil
+}
+
+// writeLOB은 i번째 컬럼의 LOB 값을 LOBWriter로 스트리밍하고 값에 객체 경로를 저장합니다 (NULL LOB은 기록하지 않음)
+// locator는 다음 row를 fetch하면 무효가 되므로 업로드는 row마다 동기로 수행되며, 그동안 커서와 커서 슬롯을 점유합니다
+// (object 정책은 수가 적고 큰 LOB을 위한 것으로, 작은 LOB이 많은 컬럼은 추출 속도가 GCS 업로드 왕복 시간에 묶입니다)
+func (d *rowDecoder) writeLOB(ctx context.Context, i int, v *rowset.Value) error {
+	var r io.Reader
+	switch val := d.raw[i].(type) {
+	case nil:
+		*v = rowset.Value{}
+		return nil
+	case io.Reader:
+		r = val
+	case []byte:
+		r = bytes.NewReader(val)
+	case string:
+		r = strings.NewReader(val)
+	default:
+		return fmt.Errorf("LOB으로 읽을 수 없는 타입 %T", val)
+	}
+
+	path, err := d.writer.WriteLOB(ctx, d.schema.Column(i).Name, d.rows, r)
+	if err != nil {
+		return fmt.Errorf("LOB 객체 기록 실패: %w", err)
+	}
+	return v.Set(rowset.KindString, path)
+}
+
+// valueKind는 Oracle 데이터 타입 이름(godror DatabaseTypeName)의 값 표현 종류를 반환합니다
+func valueKind(typeName string) rowset.Kind {
+	switch typeName = strings.ToUpper(typeName); {
+	case typeName == "NUMBER", typeName == "BINARY_INTEGER":
+		return rowset.KindNumber
+	case typeName == "FLOAT", typeName == "DOUBLE", typeName == "BINARY_FLOAT", typeName == "BINARY_DOUBLE":
+		return rowset.KindDouble
+	case strings.HasSuffix(typeName, "TIME ZONE"):
+		return rowset.KindTimeTZ
+	case typeName == "DATE", strings.HasPrefix(typeName, "TIMESTAMP"):
+		return rowset.KindTime
+	case typeName == "RAW", typeName == "LONG RAW", typeName == "BLOB", typeName == "BFILE":
+		return rowset.KindBytes
+	default:
+		return rowset.KindString
+	}
+}
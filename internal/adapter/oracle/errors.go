@@ -0,0 +1,57 @@
+// Package oracle은 Oracle 데이터베이스 연결 및 데이터 추출 기능을 제공합니다.
+package oracle
+
+import (
+	"errors"
+	"fmt"
+
+	apperrors "oracle-etl/internal/errors"
+)
+
+// ErrSnapshotTooOld는 ORA-01555(snapshot too old) 에러 분류입니다
+// 추출 중 undo 보존 기간을 넘긴 경우로, 새 SCN으로 다시 실행하면 성공할 수 있습니다
+var ErrSnapshotTooOld = errors.New("ORA-01555 snapshot too old")
+
+// SnapshotTooOldError는 특정 SCN 기준 조회가 ORA-01555로 실패했음을 나타냅니다
+type SnapshotTooOldError struct {
+	SCN uint64 // 조회 기준 SCN (0이면 현재 시점 조회)
+	Err error  // 원인 에러
+}
+
+// Error는 에러 메시지를 반환합니다
+func (e *SnapshotTooOldError) Error() string {
+	if e.SCN == 0 {
+		return fmt.Sprintf("스냅샷이 너무 오래되었습니다: %v", e.Err)
+	}
+	return fmt.Sprintf("SCN %d 스냅샷이 너무 오래되었습니다: %v", e.SCN, e.Err)
+}
+
+// Unwrap은 원인 에러를 반환합니다
+func (e *SnapshotTooOldError) Unwrap() error {
+	return e.Err
+}
+
+// Is는 errors.Is(err, ErrSnapshotTooOld) 판별을 지원합니다
+func (e *SnapshotTooOldError) Is(target error) bool {
+	return target == ErrSnapshotTooOld
+}
+
+// oraCode는 godror 에러에서 Oracle 에러 코드를 추출합니다 (없으면 0)
+func oraCode(err error) int {
+	var coder interface{ Code() int }
+	if errors.As(err, &coder) {
+		return coder.Code()
+	}
+	return 0
+}
+
+// classifyError는 스트리밍 중 발생한 Oracle 에러를 apperrors의 Oracle 에러 분류 기준으로 분류합니다
+func classifyError(err error, scn uint64) error {
+	if err == nil {
+		return nil
+	}
+	if code, ok := apperrors.OraCode(err); ok && apperrors.OracleErrorCode(code) == apperrors.ErrCodeOracleSnapshotTooOld {
+		return &SnapshotTooOldError{SCN: scn, Err: err}
+	}
+	return err
+}
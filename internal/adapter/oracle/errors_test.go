@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOraErr는 godror.OraErr처럼 Code()를 제공하는 테스트용 에러입니다
+type fakeOraErr struct {
+	code int
+}
+
+func (e fakeOraErr) Error() string { return fmt.Sprintf("ORA-%05d: test", e.code) }
+func (e fakeOraErr) Code() int     { return e.code }
+
+// TestClassifyError는 ORA-01555 분류를 테스트합니다
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		snapshot bool
+	}{
+		{"에러 코드", fakeOraErr{code: 1555}, true},
+		{"래핑된 에러 코드", fmt.Errorf("fetch: %w", fakeOraErr{code: 1555}), true},
+		{"메시지", errors.New("ORA-01555: snapshot too old: rollback segment number 9 too small"), true},
+		{"다른 Oracle 에러", fakeOraErr{code: 942}, false},
+		{"일반 에러", errors.New("connection reset"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("데이터 순회 실패: %w", classifyError(tt.err, 12345))
+			assert.Equal(t, tt.snapshot, errors.Is(err, ErrSnapshotTooOld))
+			assert.ErrorIs(t, err, tt.err)
+
+			var snapshotErr *SnapshotTooOldError
+			if assert.Equal(t, tt.snapshot, errors.As(err, &snapshotErr)) && tt.snapshot {
+				assert.Equal(t, uint64(12345), snapshotErr.SCN)
+				assert.Contains(t, err.Error(), "SCN 12345")
+			}
+		})
+	}
+
+	assert.Nil(t, classifyError(nil, 0))
+}
@@ -4,6 +4,7 @@ package oracle
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"sync"
@@ -23,6 +24,7 @@ type MockRepository struct {
 	MockColumns       []domain.ColumnInfo
 	MockSampleData    *domain.SampleData
 	MockChunks        []*domain.ChunkResult
+	MockSCN           uint64
 	PingCalled        bool
 	CloseCalled       bool
 	GetStatusCalled   bool
@@ -33,8 +35,20 @@ type MockRepository struct {
 	// 테이블별 에러 설정 (부분 실패 테스트용)
 	TableErrors map[string]error
 
+	// 테이블별 분할 범위 (분할 추출 테스트용)
+	MockRanges map[string][]domain.TableRange
+
 	// 커스텀 StreamTableData 함수 (동시성 테스트용)
 	StreamTableDataFunc func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error
+
+	// 테이블별 COUNT(*) 결과 (없으면 MockChunks의 row 수 합계, 대사 테스트용)
+	MockCounts map[string]int64
+
+	// 원본 이름별 구문 검증 에러 (원본 검증 테스트용)
+	SourceErrors map[string]error
+
+	// 부하 지표 (nil이면 부하가 없는 지표 반환, 부하 조절 테스트용)
+	MockLoad *domain.LoadMetrics
 }
 
 // NewMockRepository는 새로운 MockRepository를 생성합니다
@@ -100,12 +114,33 @@ func (m *MockRepository) GetTableColumns(ctx context.Context, owner, tableName s
 }
 
 // GetSampleData는 테이블의 샘플 데이터를 반환합니다
-func (m *MockRepository) GetSampleData(ctx context.Context, owner, tableName string, limit int) (*domain.SampleData, error) {
+// filter가 비어있지 않으면 필터와 일치하는 컬럼만 남깁니다
+func (m *MockRepository) GetSampleData(ctx context.Context, owner, tableName string, limit int, filter domain.ColumnFilter) (*domain.SampleData, error) {
 	m.GetSampleCalled = true
 	if m.ShouldError {
 		return nil, errors.New(m.ErrorMessage)
 	}
-	return m.MockSampleData, nil
+	if filter.Empty() || m.MockSampleData == nil {
+		return m.MockSampleData, nil
+	}
+
+	columns := make([]domain.ColumnInfo, len(m.MockSampleData.Columns))
+	for i, name := range m.MockSampleData.Columns {
+		columns[i] = domain.ColumnInfo{Name: name, Position: i + 1}
+	}
+	sample := &domain.SampleData{
+		TableName: m.MockSampleData.TableName,
+		Columns:   domain.ColumnNames(filter.Apply(columns)),
+		Count:     m.MockSampleData.Count,
+	}
+	for _, row := range m.MockSampleData.Rows {
+		filtered := make(map[string]interface{}, len(sample.Columns))
+		for _, name := range sample.Columns {
+			filtered[name] = row[name]
+		}
+		sample.Rows = append(sample.Rows, filtered)
+	}
+	return sample, nil
 }
 
 // StreamTableData는 테이블 데이터를 청크 단위로 스트리밍합니다
@@ -142,6 +177,100 @@ func (m *MockRepository) StreamTableData(ctx context.Context, owner, tableName s
 	return nil
 }
 
+// CountRows는 MockCounts에 설정된 row 수를 반환합니다 (없으면 MockChunks의 row 수 합계)
+func (m *MockRepository) CountRows(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions) (int64, error) {
+	if err, ok := m.TableErrors[tableName]; ok {
+		return 0, err
+	}
+	if m.ShouldError {
+		return 0, errors.New(m.ErrorMessage)
+	}
+	if count, ok := m.MockCounts[tableName]; ok {
+		return count, nil
+	}
+
+	var count int64
+	for _, chunk := range m.MockChunks {
+		count += int64(chunk.RowCount)
+	}
+	return count, nil
+}
+
+// ValidateSource는 원본 정의를 검사하고 SourceErrors에 설정된 구문 검증 에러를 반환합니다
+func (m *MockRepository) ValidateSource(ctx context.Context, owner string, source domain.Source) error {
+	if err := source.Validate(); err != nil {
+		return err
+	}
+	if err, ok := m.SourceErrors[source.Name]; ok {
+		return err
+	}
+	if m.ShouldError {
+		return errors.New(m.ErrorMessage)
+	}
+	return nil
+}
+
+// GetSourceColumns는 MockColumns 중 원본의 컬럼 목록에 해당하는 컬럼을 반환합니다 (목록이 없으면 전체)
+func (m *MockRepository) GetSourceColumns(ctx context.Context, owner string, source domain.Source) ([]domain.ColumnInfo, error) {
+	if m.ShouldError {
+		return nil, errors.New(m.ErrorMessage)
+	}
+	if len(source.Columns) == 0 {
+		return m.MockColumns, nil
+	}
+
+	columns := make([]domain.ColumnInfo, 0, len(source.Columns))
+	for _, name := range source.Columns {
+		for _, col := range m.MockColumns {
+			if strings.EqualFold(col.Name, name) {
+				col.Position = len(columns) + 1
+				columns = append(columns, col)
+			}
+		}
+	}
+	return columns, nil
+}
+
+// SplitTable은 MockRanges에 설정된 테이블 분할 범위를 반환합니다
+func (m *MockRepository) SplitTable(ctx context.Context, owner, tableName string, opts domain.SplitOptions) ([]domain.TableRange, error) {
+	if m.ShouldError {
+		return nil, errors.New(m.ErrorMessage)
+	}
+	if !opts.Enabled() {
+		return nil, nil
+	}
+	return m.MockRanges[tableName], nil
+}
+
+// GetCurrentSCN은 데이터베이스의 현재 SCN을 반환합니다
+func (m *MockRepository) GetCurrentSCN(ctx context.Context) (uint64, error) {
+	if m.ShouldError {
+		return 0, errors.New(m.ErrorMessage)
+	}
+	return m.MockSCN, nil
+}
+
+// SetLoad는 실행 중에 반환할 부하 지표를 바꿉니다 (부하 변화 테스트용)
+func (m *MockRepository) SetLoad(load *domain.LoadMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.MockLoad = load
+}
+
+// GetLoadMetrics는 MockLoad에 설정된 부하 지표를 반환합니다
+func (m *MockRepository) GetLoadMetrics(ctx context.Context) (*domain.LoadMetrics, error) {
+	if m.ShouldError {
+		return nil, errors.New(m.ErrorMessage)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.MockLoad == nil {
+		return &domain.LoadMetrics{SampledAt: time.Now().UTC()}, nil
+	}
+	load := *m.MockLoad
+	return &load, nil
+}
+
 // Ping은 Oracle 연결을 테스트합니다
 func (m *MockRepository) Ping(ctx context.Context) error {
 	m.PingCalled = true
@@ -5,12 +5,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
-	_ "github.com/godror/godror"
+	"github.com/godror/godror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"oracle-etl/internal/domain"
+	"oracle-etl/internal/telemetry"
+	"oracle-etl/pkg/rowset"
 )
 
 // PoolConfig는 Oracle 커넥션 풀 설정입니다
@@ -43,10 +48,10 @@ type Pool struct {
 	db              *sql.DB
 	config          PoolConfig
 	mu              sync.RWMutex //nolint:unused // 향후 스레드 안전 작업에 사용 예정
-	activeConns     int //nolint:unused // 커넥션 모니터링용
-	lastCheckedAt   time.Time //nolint:unused // 마지막 체크 시간 기록용
-	databaseVersion string //nolint:unused // 캐싱된 버전 정보
-	instanceName    string //nolint:unused // 캐싱된 인스턴스 이름
+	activeConns     int          //nolint:unused // 커넥션 모니터링용
+	lastCheckedAt   time.Time    //nolint:unused // 마지막 체크 시간 기록용
+	databaseVersion string       //nolint:unused // 캐싱된 버전 정보
+	instanceName    string       //nolint:unused // 캐싱된 인스턴스 이름
 }
 
 // NewPool은 새로운 Oracle 커넥션 풀을 생성합니다
@@ -106,11 +111,65 @@ func (p *Pool) DB() *sql.DB {
 	return p.db
 }
 
+// Stats는 커넥션 풀 통계를 반환합니다 (메트릭 수집용)
+func (p *Pool) Stats() sql.DBStats {
+	return p.db.Stats()
+}
+
 // Ping은 Oracle 연결을 테스트합니다
 func (p *Pool) Ping(ctx context.Context) error {
 	return p.db.PingContext(ctx)
 }
 
+// GetCurrentSCN은 데이터베이스의 현재 SCN을 반환합니다
+// V$DATABASE 조회 권한이 필요합니다 (SELECT_CATALOG_ROLE 등)
+func (p *Pool) GetCurrentSCN(ctx context.Context) (uint64, error) {
+	var scn uint64
+	if err := p.db.QueryRowContext(ctx, "SELECT CURRENT_SCN FROM V$DATABASE").Scan(&scn); err != nil {
+		return 0, fmt.Errorf("현재 SCN 조회 실패: %w", err)
+	}
+	return scn, nil
+}
+
+// loadMetricsQuery는 V$SYSMETRIC의 최근 60초 구간(group_id 2) 부하 지표를 조회합니다
+const loadMetricsQuery = `
+	SELECT metric_name, value
+	FROM v$sysmetric
+	WHERE group_id = 2
+	  AND metric_name IN ('Host CPU Utilization (%)', 'Average Active Sessions')
+`
+
+// GetLoadMetrics는 데이터베이스의 부하 지표를 반환합니다
+// V$SYSMETRIC 조회 권한이 필요합니다 (SELECT_CATALOG_ROLE 등)
+func (p *Pool) GetLoadMetrics(ctx context.Context) (*domain.LoadMetrics, error) {
+	rows, err := p.db.QueryContext(ctx, loadMetricsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("부하 지표 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := &domain.LoadMetrics{SampledAt: time.Now().UTC()}
+	for rows.Next() {
+		var (
+			name  string
+			value float64
+		)
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("부하 지표 스캔 실패: %w", err)
+		}
+		switch name {
+		case "Host CPU Utilization (%)":
+			metrics.HostCPUPercent = value
+		case "Average Active Sessions":
+			metrics.ActiveSessions = value
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("부하 지표 조회 실패: %w", err)
+	}
+	return metrics, nil
+}
+
 // Close는 커넥션 풀을 종료합니다
 func (p *Pool) Close() error {
 	return p.db.Close()
@@ -201,7 +260,9 @@ func (p *Pool) GetTableColumns(ctx context.Context, owner, tableName string) ([]
 			column_name,
 			data_type,
 			CASE WHEN nullable = 'Y' THEN 1 ELSE 0 END as nullable,
-			column_id
+			column_id,
+			data_precision,
+			data_scale
 		FROM all_tab_columns
 		WHERE owner = :1 AND table_name = :2
 		ORDER BY column_id
@@ -217,10 +278,19 @@ func (p *Pool) GetTableColumns(ctx context.Context, owner, tableName string) ([]
 	for rows.Next() {
 		var c domain.ColumnInfo
 		var nullable int
-		if err := rows.Scan(&c.Name, &c.DataType, &nullable, &c.Position); err != nil {
+		var precision, scale sql.NullInt64
+		if err := rows.Scan(&c.Name, &c.DataType, &nullable, &c.Position, &precision, &scale); err != nil {
 			return nil, fmt.Errorf("컬럼 정보 스캔 실패: %w", err)
 		}
 		c.Nullable = nullable == 1
+		if precision.Valid {
+			p := int(precision.Int64)
+			c.Precision = &p
+		}
+		if scale.Valid {
+			s := int(scale.Int64)
+			c.Scale = &s
+		}
 		columns = append(columns, c)
 	}
 
@@ -232,7 +302,8 @@ func (p *Pool) GetTableColumns(ctx context.Context, owner, tableName string) ([]
 }
 
 // GetSampleData는 테이블의 샘플 데이터를 반환합니다
-func (p *Pool) GetSampleData(ctx context.Context, owner, tableName string, limit int) (*domain.SampleData, error) {
+// filter가 비어있지 않으면 필터를 적용한 컬럼만 조회하여 추출 결과와 같은 형태로 미리보기를 제공합니다
+func (p *Pool) GetSampleData(ctx context.Context, owner, tableName string, limit int, filter domain.ColumnFilter) (*domain.SampleData, error) {
 	if limit <= 0 {
 		limit = 100
 	}
@@ -243,14 +314,21 @@ func (p *Pool) GetSampleData(ctx context.Context, owner, tableName string, limit
 		return nil, err
 	}
 
-	columnNames := make([]string, len(columns))
-	for i, c := range columns {
-		columnNames[i] = c.Name
+	projection := "*"
+	if !filter.Empty() {
+		columns = filter.Apply(columns)
+		if len(columns) == 0 {
+			return nil, fmt.Errorf("컬럼 필터와 일치하는 컬럼이 없습니다: %s.%s", owner, tableName)
+		}
+		if projection, err = quoteColumns(domain.ColumnNames(columns)); err != nil {
+			return nil, err
+		}
 	}
+	columnNames := domain.ColumnNames(columns)
 
 	// 샘플 데이터 조회
-	// #nosec G201 -- owner와 tableName은 API 레벨에서 검증된 입력값입니다
-	query := fmt.Sprintf("SELECT * FROM %s.%s WHERE ROWNUM <= :1", owner, tableName)
+	// #nosec G201 -- owner와 tableName은 API 레벨에서 검증된 입력값이고 projection은 데이터 딕셔너리의 컬럼 이름입니다
+	query := fmt.Sprintf("SELECT %s FROM %s.%s WHERE ROWNUM <= :1", projection, owner, tableName)
 	rows, err := p.db.QueryContext(ctx, query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("샘플 데이터 조회 실패: %w", err)
@@ -269,23 +347,17 @@ func (p *Pool) GetSampleData(ctx context.Context, owner, tableName string, limit
 		return nil, fmt.Errorf("컬럼 타입 조회 실패: %w", err)
 	}
 
+	// 추출 결과와 같은 값 표현을 사용하도록 typed row로 디코딩한 뒤 map으로 변환
+	dec, err := newRowDecoder(colTypes, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
 	for rows.Next() {
-		// 동적 스캔을 위한 슬라이스 생성
-		values := make([]interface{}, len(colTypes))
-		valuePtrs := make([]interface{}, len(colTypes))
-		for i := range values {
-			valuePtrs[i] = &values[i]
-		}
-
-		if err := rows.Scan(valuePtrs...); err != nil {
-			return nil, fmt.Errorf("데이터 스캔 실패: %w", err)
+		row, err := dec.scan(ctx, rows, rowset.NewBuffer(dec.schema, 1))
+		if err != nil {
+			return nil, err
 		}
-
-		row := make(map[string]interface{})
-		for i, col := range columnNames {
-			row[col] = convertValue(values[i])
-		}
-		data.Rows = append(data.Rows, row)
+		data.Rows = append(data.Rows, row.Map())
 	}
 
 	if err := rows.Err(); err != nil {
@@ -305,13 +377,34 @@ func (p *Pool) StreamTableData(ctx context.Context, owner, tableName string, opt
 		opts.FetchArraySize = p.config.FetchArraySize
 	}
 
-	// 전체 데이터 조회 쿼리
-	// #nosec G201 -- owner와 tableName은 API 레벨에서 검증된 입력값입니다
-	query := fmt.Sprintf("SELECT * FROM %s.%s", owner, tableName)
-	rows, err := p.db.QueryContext(ctx, query)
+	query, args, err := buildStreamQuery(owner, tableName, opts)
 	if err != nil {
+		return err
+	}
+	q, release, err := p.sourceQueryer(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// LOB 정책이 있으면 LOB을 locator(io.Reader)로 받아 다음 row fetch 전에 정책에 따라 조각 단위로 읽음
+	// (LOB 값마다 읽기 round-trip이 추가되므로 정책이 없는 테이블은 드라이버가 값을 한 번에 가져옴)
+	if len(opts.LOBs) > 0 {
+		args = append(args, godror.LobAsReader())
+	}
+
+	// 쿼리 span은 파싱/실행과 첫 fetch까지의 시간을 기록
+	_, querySpan := telemetry.StartSpan(ctx, "oracle.query",
+		telemetry.AttrTable.String(owner+"."+tableName),
+		attribute.Int64("oracle.as_of_scn", int64(opts.AsOfSCN)),
+	)
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		err = classifyError(err, opts.AsOfSCN)
+		telemetry.EndSpan(querySpan, err)
 		return fmt.Errorf("데이터 스트리밍 시작 실패: %w", err)
 	}
+	querySpan.End()
 	defer rows.Close()
 
 	// 컬럼 정보 조회
@@ -320,53 +413,84 @@ func (p *Pool) StreamTableData(ctx context.Context, owner, tableName string, opt
 		return fmt.Errorf("컬럼 타입 조회 실패: %w", err)
 	}
 
-	columnNames := make([]string, len(colTypes))
+	// 컬럼 스키마와 스캔 대상은 쿼리당 한 번 만들고, row 값은 청크 단위 슬랩에 저장
+	dec, err := newRowDecoder(colTypes, opts.LOBs, opts.LOBWriter, opts.LOBMaxInline)
+	if err != nil {
+		return err
+	}
+	watermarkIdx := -1
 	for i, ct := range colTypes {
-		columnNames[i] = ct.Name()
+		if opts.Watermark != nil && strings.EqualFold(ct.Name(), opts.Watermark.Column) {
+			watermarkIdx = i
+		}
+	}
+	if opts.Watermark != nil && watermarkIdx < 0 {
+		return fmt.Errorf("watermark 컬럼 %s을(를) %s.%s에서 찾을 수 없습니다", opts.Watermark.Column, owner, tableName)
+	}
+
+	// 증분 추출 시 기준 컬럼 최대값 추적
+	var maxWatermark *time.Time
+	if opts.Watermark != nil && opts.Watermark.From != nil {
+		from := *opts.Watermark.From
+		maxWatermark = &from
 	}
 
 	chunkNumber := 0
 	var totalRowsSent int64
-	chunkRows := make([]map[string]interface{}, 0, opts.ChunkSize)
+	chunkRows := make([]rowset.Row, 0, opts.ChunkSize)
+	buf := rowset.NewBuffer(dec.schema, opts.ChunkSize)
 
-	for rows.Next() {
-		// 동적 스캔
-		values := make([]interface{}, len(colTypes))
-		valuePtrs := make([]interface{}, len(colTypes))
-		for i := range values {
-			valuePtrs[i] = &values[i]
-		}
+	var chunkBytes int64
 
-		if err := rows.Scan(valuePtrs...); err != nil {
-			return fmt.Errorf("데이터 스캔 실패: %w", err)
-		}
+	// 청크 fetch span은 핸들러(인코딩/업로드 전달) 시간을 제외한 fetch와 디코딩 시간을 기록
+	_, fetchSpan := telemetry.StartSpan(ctx, "oracle.fetch_chunk")
+	defer fetchSpan.End()
 
-		row := make(map[string]interface{})
-		for i, col := range columnNames {
-			row[col] = convertValue(values[i])
+	for rows.Next() {
+		row, err := dec.scan(ctx, rows, buf)
+		if err != nil {
+			err = classifyError(err, opts.AsOfSCN)
+			endFetchSpan(fetchSpan, chunkNumber+1, len(chunkRows), chunkBytes, err)
+			return err
 		}
 		chunkRows = append(chunkRows, row)
+		chunkBytes += int64(row.Size())
+
+		if watermarkIdx >= 0 {
+			if v := row.Values[watermarkIdx]; v.Valid && (maxWatermark == nil || v.Time.After(*maxWatermark)) {
+				ts := v.Time
+				maxWatermark = &ts
+			}
+		}
 
 		// 청크가 가득 찼으면 핸들러 호출
-		if len(chunkRows) >= opts.ChunkSize {
+		if chunkFull(opts, len(chunkRows), chunkBytes) {
 			chunkNumber++
 			totalRowsSent += int64(len(chunkRows))
 			chunk := &domain.ChunkResult{
-				TableName:     tableName,
-				ChunkNumber:   chunkNumber,
-				Rows:          chunkRows,
-				RowCount:      len(chunkRows),
-				IsLastChunk:   false,
-				TotalRowsSent: totalRowsSent,
+				TableName:      tableName,
+				ChunkNumber:    chunkNumber,
+				Rows:           chunkRows,
+				RowCount:       len(chunkRows),
+				IsLastChunk:    false,
+				TotalRowsSent:  totalRowsSent,
+				WatermarkValue: copyTime(maxWatermark),
 			}
+			endFetchSpan(fetchSpan, chunkNumber, chunk.RowCount, chunkBytes, nil)
 			if err := chunkHandler(chunk); err != nil {
 				return fmt.Errorf("청크 핸들러 오류: %w", err)
 			}
-			chunkRows = make([]map[string]interface{}, 0, opts.ChunkSize)
+			// 핸들러에 넘긴 row는 업로드 중에도 참조되므로 다음 청크는 새 슬랩을 사용
+			chunkRows = make([]rowset.Row, 0, opts.ChunkSize)
+			buf = rowset.NewBuffer(dec.schema, opts.ChunkSize)
+			chunkBytes = 0
+			_, fetchSpan = telemetry.StartSpan(ctx, "oracle.fetch_chunk")
 		}
 	}
 
 	if err := rows.Err(); err != nil {
+		err = classifyError(err, opts.AsOfSCN)
+		endFetchSpan(fetchSpan, chunkNumber+1, len(chunkRows), chunkBytes, err)
 		return fmt.Errorf("데이터 순회 실패: %w", err)
 	}
 
@@ -375,13 +499,15 @@ func (p *Pool) StreamTableData(ctx context.Context, owner, tableName string, opt
 		chunkNumber++
 		totalRowsSent += int64(len(chunkRows))
 		chunk := &domain.ChunkResult{
-			TableName:     tableName,
-			ChunkNumber:   chunkNumber,
-			Rows:          chunkRows,
-			RowCount:      len(chunkRows),
-			IsLastChunk:   true,
-			TotalRowsSent: totalRowsSent,
+			TableName:      tableName,
+			ChunkNumber:    chunkNumber,
+			Rows:           chunkRows,
+			RowCount:       len(chunkRows),
+			IsLastChunk:    true,
+			TotalRowsSent:  totalRowsSent,
+			WatermarkValue: copyTime(maxWatermark),
 		}
+		endFetchSpan(fetchSpan, chunkNumber, chunk.RowCount, chunkBytes, nil)
 		if err := chunkHandler(chunk); err != nil {
 			return fmt.Errorf("마지막 청크 핸들러 오류: %w", err)
 		}
@@ -390,6 +516,36 @@ func (p *Pool) StreamTableData(ctx context.Context, owner, tableName string, opt
 	return nil
 }
 
+// endFetchSpan은 청크 fetch span에 청크 번호와 크기를 기록하고 종료합니다
+func endFetchSpan(span trace.Span, chunkNumber, rows int, bytes int64, err error) {
+	span.SetAttributes(
+		telemetry.AttrChunk.Int(chunkNumber),
+		telemetry.AttrRows.Int(rows),
+		telemetry.AttrBytes.Int64(bytes),
+	)
+	telemetry.EndSpan(span, err)
+}
+
+// CountRows는 StreamTableData와 같은 조건(분할 범위, AS OF SCN, watermark 하한)의 row 수를 조회합니다
+func (p *Pool) CountRows(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions) (int64, error) {
+	query, args, err := buildCountQuery(owner, tableName, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	q, release, err := p.sourceQueryer(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	var count int64
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("row 수 조회 실패: %w", classifyError(err, opts.AsOfSCN))
+	}
+	return count, nil
+}
+
 // extractVersion은 Oracle 버전 문자열에서 버전 번호를 추출합니다
 func extractVersion(banner string) string {
 	// 예: "Oracle Database 19c Enterprise Edition Release 19.0.0.0.0"
@@ -402,19 +558,177 @@ func extractVersion(banner string) string {
 	return banner
 }
 
-// convertValue는 Oracle 값을 JSON 직렬화 가능한 형태로 변환합니다
-func convertValue(v interface{}) interface{} {
-	if v == nil {
+// 추출 쿼리가 내부적으로 사용하는 바인드 변수 이름 (사용자 정의 바인드 변수와 함께 이름으로 바인딩)
+const (
+	bindSCN           = domain.ReservedBindPrefix + "scn"
+	bindRowIDStart    = domain.ReservedBindPrefix + "rowid_start"
+	bindRowIDEnd      = domain.ReservedBindPrefix + "rowid_end"
+	bindWatermarkFrom = domain.ReservedBindPrefix + "watermark_from"
+)
+
+// buildStreamQuery는 테이블 스트리밍 쿼리와 바인드 인자를 생성합니다
+// 분할 범위가 있으면 해당 파티션 또는 ROWID 범위만 조회하고,
+// AsOfSCN이 있으면 해당 SCN 시점으로 조회하며 (flashback query),
+// 증분 추출 시 기준 컬럼이 하한 이상인 row만 조회합니다 (경계값은 재추출하여 누락 방지)
+// 추출 원본(opts.Source)이 있으면 컬럼 목록과 WHERE 조건 또는 사용자 정의 SQL로 조회합니다
+// 바인드 인자는 사용자 정의 바인드 변수와 섞일 수 있도록 모두 이름으로 바인딩합니다 (sql.Named)
+func buildStreamQuery(owner, tableName string, opts domain.ExtractionOptions) (string, []interface{}, error) {
+	return buildTableQuery("*", owner, tableName, opts)
+}
+
+// buildCountQuery는 스트리밍 쿼리와 같은 조건(범위, SCN, watermark)의 row 수 조회 쿼리를 생성합니다
+func buildCountQuery(owner, tableName string, opts domain.ExtractionOptions) (string, []interface{}, error) {
+	return buildTableQuery("COUNT(*)", owner, tableName, opts)
+}
+
+// buildTableQuery는 projection을 조회하는 테이블 쿼리와 바인드 인자를 생성합니다
+// projection이 "*"이면 추출 옵션의 컬럼 목록, 원본의 컬럼 목록 순으로 조회할 컬럼을 정합니다
+func buildTableQuery(projection, owner, tableName string, opts domain.ExtractionOptions) (string, []interface{}, error) {
+	if projection == "*" && len(opts.Columns) > 0 {
+		quoted, err := quoteColumns(opts.Columns)
+		if err != nil {
+			return "", nil, err
+		}
+		projection = quoted
+	}
+
+	src := opts.Source
+	if src != nil && src.Kind() == domain.SourceKindQuery {
+		return buildSQLSourceQuery(projection, src, opts)
+	}
+
+	table := tableName
+	if src != nil {
+		table = src.Table
+		if projection == "*" && len(src.Columns) > 0 {
+			projection = strings.Join(src.Columns, ", ")
+		}
+	}
+
+	// #nosec G201 -- owner와 table은 검증된 식별자이고 projection은 상수 또는 식별자 검증을 통과한 컬럼 목록입니다
+	query := fmt.Sprintf("SELECT %s FROM %s.%s", projection, owner, table)
+	var (
+		args       []interface{}
+		conditions []string
+	)
+
+	if opts.Range != nil && opts.Range.Partition != "" {
+		if err := domain.ValidateIdentifier(opts.Range.Partition); err != nil {
+			return "", nil, fmt.Errorf("파티션 이름 검증 실패: %w", err)
+		}
+		// #nosec G201 -- 파티션 이름은 식별자 형식 검증을 통과한 값입니다
+		query += fmt.Sprintf(" PARTITION (%s)", opts.Range.Partition)
+	}
+
+	if opts.AsOfSCN > 0 {
+		args = append(args, sql.Named(bindSCN, opts.AsOfSCN))
+		query += " AS OF SCN :" + bindSCN
+	}
+
+	if src != nil && src.Where != "" {
+		// where는 괄호 밖으로 벗어나지 않는 단일 조건식만 허용하고 (Source.Validate) 저장 시 DBMS_SQL.PARSE로 검증합니다
+		conditions = append(conditions, "("+src.Where+")")
+	}
+
+	if opts.Range != nil && opts.Range.Partition == "" {
+		if opts.Range.StartRowID == "" || opts.Range.EndRowID == "" {
+			return "", nil, fmt.Errorf("ROWID 범위가 비어있습니다: %s", opts.Range)
+		}
+		args = append(args, sql.Named(bindRowIDStart, opts.Range.StartRowID), sql.Named(bindRowIDEnd, opts.Range.EndRowID))
+		conditions = append(conditions, fmt.Sprintf("ROWID BETWEEN CHARTOROWID(:%s) AND CHARTOROWID(:%s)", bindRowIDStart, bindRowIDEnd))
+	}
+
+	cond, wmArgs, err := watermarkCondition(opts.Watermark)
+	if err != nil {
+		return "", nil, err
+	}
+	if cond != "" {
+		conditions = append(conditions, cond)
+		args = append(args, wmArgs...)
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return query, append(args, sourceBinds(src)...), nil
+}
+
+// buildSQLSourceQuery는 사용자 정의 SQL을 inline view로 감싼 쿼리와 바인드 인자를 생성합니다
+// AS OF SCN 절은 테이블에만 붙일 수 있으므로 SCN 시점 조회는 세션 단위 flashback으로 처리합니다 (sourceQueryer)
+func buildSQLSourceQuery(projection string, src *domain.Source, opts domain.ExtractionOptions) (string, []interface{}, error) {
+	if opts.Range != nil {
+		return "", nil, fmt.Errorf("SQL 원본 %s은(는) 분할 추출할 수 없습니다", src.Name)
+	}
+
+	// #nosec G201 -- SQL 원본은 단일 조회문 검증과 DBMS_SQL.PARSE 구문 검증을 통과한 값입니다
+	query := fmt.Sprintf("SELECT %s FROM (%s) src", projection, src.SQL)
+	cond, args, err := watermarkCondition(opts.Watermark)
+	if err != nil {
+		return "", nil, err
+	}
+	if cond != "" {
+		query += " WHERE " + cond
+	}
+	return query, append(args, sourceBinds(src)...), nil
+}
+
+// quoteColumns는 데이터 딕셔너리의 컬럼 이름을 대소문자를 보존하는 인용 식별자 목록으로 변환합니다
+func quoteColumns(columns []string) (string, error) {
+	quoted := make([]string, len(columns))
+	for i, name := range columns {
+		if name == "" || strings.Contains(name, `"`) {
+			return "", fmt.Errorf("잘못된 컬럼 이름: %q", name)
+		}
+		quoted[i] = `"` + name + `"`
+	}
+	return strings.Join(quoted, ", "), nil
+}
+
+// watermarkCondition은 증분 추출 하한 조건과 바인드 인자를 생성합니다 (전체 추출이면 빈 문자열)
+// 직전 최대값과 같은 시각에 나중에 커밋된 row를 놓치지 않도록 하한을 포함(>=)하며,
+// 하한과 같은 값의 row는 직전 버전과 중복될 수 있습니다 (domain.WatermarkRange 참고)
+func watermarkCondition(watermark *domain.WatermarkRange) (string, []interface{}, error) {
+	if watermark == nil || watermark.IsFullLoad() {
+		return "", nil, nil
+	}
+	if err := domain.ValidateIdentifier(watermark.Column); err != nil {
+		return "", nil, fmt.Errorf("watermark 컬럼 검증 실패: %w", err)
+	}
+	// #nosec G201 -- watermark 컬럼은 식별자 형식 검증을 통과한 값입니다
+	return fmt.Sprintf("%s >= :%s", watermark.Column, bindWatermarkFrom), []interface{}{sql.Named(bindWatermarkFrom, *watermark.From)}, nil
+}
+
+// sourceBinds는 원본의 사용자 정의 바인드 변수를 이름순 바인드 인자로 변환합니다
+func sourceBinds(src *domain.Source) []interface{} {
+	if src == nil || len(src.Binds) == 0 {
 		return nil
 	}
-	switch val := v.(type) {
-	case []byte:
-		return string(val)
-	case time.Time:
-		return val.Format(time.RFC3339)
-	default:
-		return val
+	names := make([]string, 0, len(src.Binds))
+	for name := range src.Binds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = sql.Named(name, src.Binds[name])
+	}
+	return args
+}
+
+// chunkFull은 청크가 row 수 또는 바이트 수 한도에 도달했는지 반환합니다
+// 바이트 한도는 큰 LOB 값이 많은 테이블에서 ChunkSize개의 row가 메모리에 쌓이지 않도록 합니다
+func chunkFull(opts domain.ExtractionOptions, rows int, bytes int64) bool {
+	return rows >= opts.ChunkSize || (opts.ChunkMaxBytes > 0 && bytes >= opts.ChunkMaxBytes)
+}
+
+// copyTime은 시간 포인터의 복사본을 반환합니다
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
 	}
+	v := *t
+	return &v
 }
 
 // 인터페이스 구현 확인
@@ -2,12 +2,17 @@ package oracle
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"oracle-etl/internal/domain"
+	"oracle-etl/pkg/rowset"
 )
 
 func TestDefaultPoolConfig(t *testing.T) {
@@ -79,7 +84,7 @@ func TestMockRepository_GetSampleData(t *testing.T) {
 
 	// 샘플 데이터 조회 테스트
 	ctx := context.Background()
-	sample, err := mock.GetSampleData(ctx, "SAPSR3", "VBRP", 100)
+	sample, err := mock.GetSampleData(ctx, "SAPSR3", "VBRP", 100, domain.ColumnFilter{})
 
 	require.NoError(t, err)
 	assert.True(t, mock.GetSampleCalled)
@@ -191,43 +196,257 @@ func TestExtractVersion(t *testing.T) {
 	}
 }
 
-func TestConvertValue(t *testing.T) {
+func TestValueKind(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    interface{}
-		expected interface{}
+		typeName string
+		expected rowset.Kind
+	}{
+		{"NUMBER", rowset.KindNumber},
+		{"BINARY_INTEGER", rowset.KindNumber},
+		{"BINARY_DOUBLE", rowset.KindDouble},
+		{"FLOAT", rowset.KindDouble},
+		{"DATE", rowset.KindTime},
+		{"TIMESTAMP", rowset.KindTime},
+		{"TIMESTAMP WITH TIME ZONE", rowset.KindTimeTZ},
+		{"TIMESTAMP WITH LOCAL TIME ZONE", rowset.KindTimeTZ},
+		{"RAW", rowset.KindBytes},
+		{"BLOB", rowset.KindBytes},
+		{"VARCHAR2", rowset.KindString},
+		{"CLOB", rowset.KindString},
+		{"ROWID", rowset.KindString},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, valueKind(tt.typeName))
+		})
+	}
+}
+
+func TestBuildStreamQuery(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		opts        domain.ExtractionOptions
+		expected    string
+		expectArgs  int
+		expectError bool
 	}{
 		{
-			name:     "nil 값",
-			input:    nil,
-			expected: nil,
+			name:     "전체 추출",
+			opts:     domain.ExtractionOptions{},
+			expected: "SELECT * FROM APPS.OE_ORDER_LINES_ALL",
+		},
+		{
+			name:     "watermark 초기 실행 (하한 없음)",
+			opts:     domain.ExtractionOptions{Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE"}},
+			expected: "SELECT * FROM APPS.OE_ORDER_LINES_ALL",
+		},
+		{
+			name:       "증분 추출",
+			opts:       domain.ExtractionOptions{Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from}},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL WHERE LAST_UPDATE_DATE >= :etl_watermark_from",
+			expectArgs: 1,
+		},
+		{
+			name:       "SCN 스냅샷",
+			opts:       domain.ExtractionOptions{AsOfSCN: 4815162342},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL AS OF SCN :etl_scn",
+			expectArgs: 1,
+		},
+		{
+			name:       "SCN 스냅샷 + 증분 추출",
+			opts:       domain.ExtractionOptions{AsOfSCN: 4815162342, Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from}},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL AS OF SCN :etl_scn WHERE LAST_UPDATE_DATE >= :etl_watermark_from",
+			expectArgs: 2,
+		},
+		{
+			name:       "ROWID 범위",
+			opts:       domain.ExtractionOptions{Range: &domain.TableRange{StartRowID: "AAAR3sAAEAAAACXAAA", EndRowID: "AAAR3sAAEAAAAD/H//"}},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL WHERE ROWID BETWEEN CHARTOROWID(:etl_rowid_start) AND CHARTOROWID(:etl_rowid_end)",
+			expectArgs: 2,
+		},
+		{
+			name: "ROWID 범위 + SCN + 증분 추출",
+			opts: domain.ExtractionOptions{
+				AsOfSCN:   4815162342,
+				Range:     &domain.TableRange{StartRowID: "AAAR3sAAEAAAACXAAA", EndRowID: "AAAR3sAAEAAAAD/H//"},
+				Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from},
+			},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL AS OF SCN :etl_scn WHERE ROWID BETWEEN CHARTOROWID(:etl_rowid_start) AND CHARTOROWID(:etl_rowid_end) AND LAST_UPDATE_DATE >= :etl_watermark_from",
+			expectArgs: 4,
+		},
+		{
+			name:       "파티션 + SCN",
+			opts:       domain.ExtractionOptions{AsOfSCN: 4815162342, Range: &domain.TableRange{Partition: "P_2024_01"}},
+			expected:   "SELECT * FROM APPS.OE_ORDER_LINES_ALL PARTITION (P_2024_01) AS OF SCN :etl_scn",
+			expectArgs: 1,
+		},
+		{
+			name: "컬럼 목록 + WHERE 조건 원본",
+			opts: domain.ExtractionOptions{
+				AsOfSCN: 4815162342,
+				Source: &domain.Source{
+					Name:    "OE_LINES_204",
+					Table:   "OE_ORDER_LINES_ALL",
+					Columns: []string{"LINE_ID", "ORG_ID"},
+					Where:   "ORG_ID = :org_id",
+					Binds:   map[string]interface{}{"org_id": float64(204)},
+				},
+				Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from},
+			},
+			expected:   "SELECT LINE_ID, ORG_ID FROM APPS.OE_ORDER_LINES_ALL AS OF SCN :etl_scn WHERE (ORG_ID = :org_id) AND LAST_UPDATE_DATE >= :etl_watermark_from",
+			expectArgs: 3,
+		},
+		{
+			name: "SQL 원본 (SCN은 세션 flashback으로 적용)",
+			opts: domain.ExtractionOptions{
+				AsOfSCN: 4815162342,
+				Source: &domain.Source{
+					Name:  "AR_INVOICES",
+					SQL:   "SELECT h.TRX_NUMBER, l.LAST_UPDATE_DATE FROM RA_CUSTOMER_TRX_ALL h JOIN RA_CUSTOMER_TRX_LINES_ALL l ON l.CUSTOMER_TRX_ID = h.CUSTOMER_TRX_ID WHERE h.ORG_ID = :org_id",
+					Binds: map[string]interface{}{"org_id": float64(204)},
+				},
+				Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from},
+			},
+			expected:   "SELECT * FROM (SELECT h.TRX_NUMBER, l.LAST_UPDATE_DATE FROM RA_CUSTOMER_TRX_ALL h JOIN RA_CUSTOMER_TRX_LINES_ALL l ON l.CUSTOMER_TRX_ID = h.CUSTOMER_TRX_ID WHERE h.ORG_ID = :org_id) src WHERE LAST_UPDATE_DATE >= :etl_watermark_from",
+			expectArgs: 2,
+		},
+		{
+			name:     "컬럼 필터 적용",
+			opts:     domain.ExtractionOptions{Columns: []string{"LINE_ID", "ORDERED_ITEM", "SYS_NC00042$"}},
+			expected: `SELECT "LINE_ID", "ORDERED_ITEM", "SYS_NC00042$" FROM APPS.OE_ORDER_LINES_ALL`,
 		},
 		{
-			name:     "문자열",
-			input:    "test",
-			expected: "test",
+			name: "SQL 원본 + 컬럼 필터",
+			opts: domain.ExtractionOptions{
+				Source:  &domain.Source{Name: "AR_INVOICES", SQL: "SELECT * FROM RA_CUSTOMER_TRX_ALL"},
+				Columns: []string{"TRX_NUMBER"},
+			},
+			expected: `SELECT "TRX_NUMBER" FROM (SELECT * FROM RA_CUSTOMER_TRX_ALL) src`,
 		},
 		{
-			name:     "바이트 슬라이스",
-			input:    []byte("hello"),
-			expected: "hello",
+			name:        "잘못된 컬럼 이름",
+			opts:        domain.ExtractionOptions{Columns: []string{`X" FROM DUAL --`}},
+			expectError: true,
 		},
 		{
-			name:     "정수",
-			input:    42,
-			expected: 42,
+			name: "SQL 원본 분할 추출",
+			opts: domain.ExtractionOptions{
+				Source: &domain.Source{Name: "AR_INVOICES", SQL: "SELECT * FROM RA_CUSTOMER_TRX_ALL"},
+				Range:  &domain.TableRange{Partition: "P_2024_01"},
+			},
+			expectError: true,
 		},
 		{
-			name:     "시간",
-			input:    time.Date(2026, 1, 18, 10, 30, 0, 0, time.UTC),
-			expected: "2026-01-18T10:30:00Z",
+			name:        "잘못된 파티션 이름",
+			opts:        domain.ExtractionOptions{Range: &domain.TableRange{Partition: "P1) UNION SELECT"}},
+			expectError: true,
+		},
+		{
+			name:        "빈 ROWID 범위",
+			opts:        domain.ExtractionOptions{Range: &domain.TableRange{StartRowID: "AAAR3sAAEAAAACXAAA"}},
+			expectError: true,
+		},
+		{
+			name:        "잘못된 컬럼명",
+			opts:        domain.ExtractionOptions{Watermark: &domain.WatermarkRange{Column: "X; DROP TABLE Y", From: &from}},
+			expectError: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := convertValue(tt.input)
-			assert.Equal(t, tt.expected, result)
+			query, args, err := buildStreamQuery("APPS", "OE_ORDER_LINES_ALL", tt.opts)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, query)
+			assert.Len(t, args, tt.expectArgs)
 		})
 	}
 }
+
+func TestBuildCountQuery(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	opts := domain.ExtractionOptions{
+		AsOfSCN:   4815162342,
+		Range:     &domain.TableRange{StartRowID: "AAAR3sAAEAAAACXAAA", EndRowID: "AAAR3sAAEAAAAD/H//"},
+		Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from},
+	}
+
+	// 스트리밍 쿼리와 같은 조건과 바인드 인자를 사용
+	query, args, err := buildCountQuery("APPS", "OE_ORDER_LINES_ALL", opts)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM APPS.OE_ORDER_LINES_ALL AS OF SCN :etl_scn WHERE ROWID BETWEEN CHARTOROWID(:etl_rowid_start) AND CHARTOROWID(:etl_rowid_end) AND LAST_UPDATE_DATE >= :etl_watermark_from", query)
+
+	_, streamArgs, err := buildStreamQuery("APPS", "OE_ORDER_LINES_ALL", opts)
+	require.NoError(t, err)
+	assert.Equal(t, streamArgs, args)
+}
+
+func TestSourceBinds(t *testing.T) {
+	src := &domain.Source{
+		Name:  "AR_INVOICES",
+		SQL:   "SELECT * FROM RA_CUSTOMER_TRX_ALL WHERE ORG_ID = :org_id AND TRX_DATE >= TO_DATE(:from_date, 'YYYY-MM-DD')",
+		Binds: map[string]interface{}{"org_id": float64(204), "from_date": "2024-01-01"},
+	}
+
+	// 바인드 변수는 이름순으로 이름 있는 인자가 됨
+	assert.Equal(t, []interface{}{
+		sql.Named("from_date", "2024-01-01"),
+		sql.Named("org_id", float64(204)),
+	}, sourceBinds(src))
+	assert.Nil(t, sourceBinds(nil))
+}
+
+func TestChunkFull(t *testing.T) {
+	opts := domain.ExtractionOptions{ChunkSize: 100, ChunkMaxBytes: 1024}
+
+	assert.False(t, chunkFull(opts, 10, 512))
+	assert.True(t, chunkFull(opts, 100, 512), "row 수 한도")
+	assert.True(t, chunkFull(opts, 10, 1024), "바이트 한도")
+
+	// 바이트 한도가 없으면 row 수로만 제한
+	opts.ChunkMaxBytes = 0
+	assert.False(t, chunkFull(opts, 10, 1<<30))
+}
+
+// recordingLOBWriter는 기록된 LOB 내용을 보관하는 테스트용 LOBWriter입니다
+type recordingLOBWriter struct {
+	written map[string]string
+}
+
+func (w *recordingLOBWriter) WriteLOB(ctx context.Context, column string, row int64, r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("gs://bucket/%s/%d", column, row)
+	w.written[path] = string(data)
+	return path, nil
+}
+
+func TestRowDecoder_WriteLOB(t *testing.T) {
+	writer := &recordingLOBWriter{written: map[string]string{}}
+	dec := &rowDecoder{
+		schema: rowset.NewSchema([]rowset.Column{{Name: "FILE_DATA", Kind: rowset.KindString}}),
+		writer: writer,
+		raw:    []interface{}{strings.NewReader("attachment")},
+		rows:   7,
+	}
+
+	var v rowset.Value
+	require.NoError(t, dec.writeLOB(context.Background(), 0, &v))
+	assert.Equal(t, "gs://bucket/FILE_DATA/7", v.Str)
+	assert.Equal(t, "attachment", writer.written[v.Str])
+
+	// NULL LOB은 객체를 만들지 않음
+	dec.raw[0] = nil
+	require.NoError(t, dec.writeLOB(context.Background(), 0, &v))
+	assert.False(t, v.Valid)
+	assert.Len(t, writer.written, 1)
+}
@@ -20,11 +20,32 @@ type Repository interface {
 	GetTableColumns(ctx context.Context, owner, tableName string) ([]domain.ColumnInfo, error)
 
 	// GetSampleData는 테이블의 샘플 데이터를 반환합니다
-	GetSampleData(ctx context.Context, owner, tableName string, limit int) (*domain.SampleData, error)
+	// filter가 비어있지 않으면 추출과 같은 컬럼 필터를 적용한 컬럼만 조회합니다
+	GetSampleData(ctx context.Context, owner, tableName string, limit int, filter domain.ColumnFilter) (*domain.SampleData, error)
 
 	// StreamTableData는 테이블 데이터를 청크 단위로 스트리밍합니다
 	StreamTableData(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, chunkHandler func(chunk *domain.ChunkResult) error) error
 
+	// CountRows는 StreamTableData와 같은 조건(분할 범위, AS OF SCN, watermark 하한)의 row 수를 조회합니다
+	// 추출 후 원본과 대상의 row 수 대사(reconciliation)에 사용됩니다
+	CountRows(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions) (int64, error)
+
+	// ValidateSource는 추출 원본의 쿼리를 실행하지 않고 구문과 객체 존재 여부를 검증합니다 (DBMS_SQL.PARSE)
+	ValidateSource(ctx context.Context, owner string, source domain.Source) error
+
+	// GetSourceColumns는 추출 원본(컬럼 지정 테이블 또는 SQL)의 출력 컬럼 정보를 반환합니다
+	GetSourceColumns(ctx context.Context, owner string, source domain.Source) ([]domain.ColumnInfo, error)
+
+	// SplitTable은 대용량 테이블을 병렬 추출용 범위(파티션 또는 ROWID 범위)로 나눕니다
+	// 분할 대상이 아니면 nil을 반환합니다
+	SplitTable(ctx context.Context, owner, tableName string, opts domain.SplitOptions) ([]domain.TableRange, error)
+
+	// GetCurrentSCN은 데이터베이스의 현재 SCN을 반환합니다 (일관된 스냅샷 추출용)
+	GetCurrentSCN(ctx context.Context) (uint64, error)
+
+	// GetLoadMetrics는 데이터베이스의 부하 지표(호스트 CPU, 평균 활성 세션)를 반환합니다 (추출 부하 조절용)
+	GetLoadMetrics(ctx context.Context) (*domain.LoadMetrics, error)
+
 	// Ping은 Oracle 연결을 테스트합니다
 	Ping(ctx context.Context) error
 
@@ -0,0 +1,159 @@
+// Package oracle은 Oracle 데이터베이스 연결 및 데이터 추출 기능을 제공합니다.
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"oracle-etl/internal/domain"
+)
+
+// parseSourceBlock은 SQL을 실행하지 않고 구문, 객체 존재 여부, 권한만 검증하는 PL/SQL 블록입니다
+// DBMS_SQL.PARSE는 DDL을 즉시 실행하므로 호출 전에 단일 조회문인지 확인해야 합니다
+const parseSourceBlock = `
+	DECLARE
+		c INTEGER := DBMS_SQL.OPEN_CURSOR;
+	BEGIN
+		DBMS_SQL.PARSE(c, :1, DBMS_SQL.NATIVE);
+		DBMS_SQL.CLOSE_CURSOR(c);
+	EXCEPTION
+		WHEN OTHERS THEN
+			DBMS_SQL.CLOSE_CURSOR(c);
+			RAISE;
+	END;`
+
+// queryer는 추출 쿼리를 실행하는 *sql.DB 또는 *sql.Conn입니다
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// ValidateSource는 추출 원본의 스트리밍 쿼리를 DBMS_SQL.PARSE로 구문 검증합니다 (dry parse)
+// 바인드 변수 값 없이 구문, 테이블/컬럼 존재 여부, 조회 권한을 확인합니다
+func (p *Pool) ValidateSource(ctx context.Context, owner string, source domain.Source) error {
+	if err := source.Validate(); err != nil {
+		return err
+	}
+
+	if source.Kind() == domain.SourceKindTable && source.Where != "" {
+		if _, err := p.db.ExecContext(ctx, parseSourceBlock, buildWhereCheckQuery(owner, source)); err != nil {
+			return fmt.Errorf("원본 %s where 조건 검증 실패: %w", source.Name, err)
+		}
+	}
+
+	query, _, err := buildStreamQuery(owner, source.Name, domain.ExtractionOptions{Source: &source})
+	if err != nil {
+		return err
+	}
+	if _, err := p.db.ExecContext(ctx, parseSourceBlock, query); err != nil {
+		return fmt.Errorf("원본 %s 구문 검증 실패: %w", source.Name, err)
+	}
+	return nil
+}
+
+// buildWhereCheckQuery는 table 원본의 WHERE 조건만 조건식 위치(CASE WHEN)에 넣은 검증용 쿼리를 생성합니다
+// 추출 쿼리 전체의 구문 검증과 달리 조건이 단일 조건식이 아니면(괄호를 닫고 다른 절을 잇는 경우 등) 구문 오류가 됩니다
+func buildWhereCheckQuery(owner string, source domain.Source) string {
+	// #nosec G201 -- owner와 table은 검증된 식별자이고 where는 괄호/주석 검사를 통과한 뒤 실행 없이 구문 검증만 합니다
+	return fmt.Sprintf("SELECT CASE WHEN (%s) THEN 1 END FROM %s.%s", source.Where, owner, source.Table)
+}
+
+// GetSourceColumns는 추출 원본의 출력 컬럼 정보를 반환합니다
+// table 원본은 all_tab_columns에서 조회하여 컬럼 목록 순서로 추리고,
+// SQL 원본은 결과가 없는 조회(WHERE 1 = 0)의 컬럼 메타데이터를 사용합니다
+func (p *Pool) GetSourceColumns(ctx context.Context, owner string, source domain.Source) ([]domain.ColumnInfo, error) {
+	if source.Kind() == domain.SourceKindTable {
+		columns, err := p.GetTableColumns(ctx, owner, source.Table)
+		if err != nil {
+			return nil, err
+		}
+		return selectColumns(columns, source.Columns, owner, source.Table)
+	}
+
+	// #nosec G201 -- SQL 원본은 단일 조회문 검증과 DBMS_SQL.PARSE 구문 검증을 통과한 값입니다
+	query := fmt.Sprintf("SELECT * FROM (%s) src WHERE 1 = 0", source.SQL)
+	rows, err := p.db.QueryContext(ctx, query, sourceBinds(&source)...)
+	if err != nil {
+		return nil, fmt.Errorf("원본 %s 컬럼 조회 실패: %w", source.Name, err)
+	}
+	defer rows.Close()
+
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("컬럼 타입 조회 실패: %w", err)
+	}
+
+	columns := make([]domain.ColumnInfo, len(colTypes))
+	for i, ct := range colTypes {
+		col := domain.ColumnInfo{
+			Name:     ct.Name(),
+			DataType: ct.DatabaseTypeName(),
+			Position: i + 1,
+		}
+		if nullable, ok := ct.Nullable(); ok {
+			col.Nullable = nullable
+		} else {
+			col.Nullable = true
+		}
+		// 정밀도가 지정되지 않은 NUMBER는 precision 0으로 보고됨
+		if precision, scale, ok := ct.DecimalSize(); ok && precision > 0 {
+			p, s := int(precision), int(scale)
+			col.Precision, col.Scale = &p, &s
+		}
+		columns[i] = col
+	}
+	return columns, rows.Err()
+}
+
+// selectColumns는 테이블 컬럼 정보를 names 순서로 추립니다 (names가 비어있으면 전체)
+// 위치(Position)는 출력 순서로 다시 매깁니다
+func selectColumns(columns []domain.ColumnInfo, names []string, owner, tableName string) ([]domain.ColumnInfo, error) {
+	if len(names) == 0 {
+		return columns, nil
+	}
+
+	byName := make(map[string]domain.ColumnInfo, len(columns))
+	for _, col := range columns {
+		byName[strings.ToUpper(col.Name)] = col
+	}
+
+	selected := make([]domain.ColumnInfo, len(names))
+	for i, name := range names {
+		col, ok := byName[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("컬럼 %s을(를) %s.%s에서 찾을 수 없습니다", name, owner, tableName)
+		}
+		col.Position = i + 1
+		selected[i] = col
+	}
+	return selected, nil
+}
+
+// sourceQueryer는 추출 쿼리를 실행할 연결을 반환합니다
+// SQL 원본을 SCN 시점으로 조회하는 경우 DBMS_FLASHBACK으로 세션 시점을 고정한 전용 연결을 사용하고,
+// release에서 flashback을 해제합니다 (해제에 실패한 연결은 풀에 반환하지 않고 폐기)
+func (p *Pool) sourceQueryer(ctx context.Context, opts domain.ExtractionOptions) (queryer, func(), error) {
+	if opts.Source == nil || opts.Source.Kind() != domain.SourceKindQuery || opts.AsOfSCN == 0 {
+		return p.db, func() {}, nil
+	}
+
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("flashback 세션 연결 실패: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "BEGIN DBMS_FLASHBACK.ENABLE_AT_SYSTEM_CHANGE_NUMBER(:1); END;", opts.AsOfSCN); err != nil {
+		_ = conn.Close()
+		return nil, nil, fmt.Errorf("flashback 세션 설정 실패: %w", classifyError(err, opts.AsOfSCN))
+	}
+
+	release := func() {
+		if _, err := conn.ExecContext(context.Background(), "BEGIN DBMS_FLASHBACK.DISABLE; END;"); err != nil {
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		_ = conn.Close()
+	}
+	return conn, release, nil
+}
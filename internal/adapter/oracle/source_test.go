@@ -0,0 +1,38 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+)
+
+func TestSelectColumns(t *testing.T) {
+	columns := []domain.ColumnInfo{
+		{Name: "HEADER_ID", DataType: "NUMBER", Position: 1},
+		{Name: "LINE_ID", DataType: "NUMBER", Position: 2},
+		{Name: "ORG_ID", DataType: "NUMBER", Position: 3},
+	}
+
+	selected, err := selectColumns(columns, []string{"org_id", "LINE_ID"}, "APPS", "OE_ORDER_LINES_ALL")
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "ORG_ID", selected[0].Name)
+	assert.Equal(t, 1, selected[0].Position)
+	assert.Equal(t, "LINE_ID", selected[1].Name)
+	assert.Equal(t, 2, selected[1].Position)
+
+	all, err := selectColumns(columns, nil, "APPS", "OE_ORDER_LINES_ALL")
+	require.NoError(t, err)
+	assert.Equal(t, columns, all)
+
+	_, err = selectColumns(columns, []string{"MISSING"}, "APPS", "OE_ORDER_LINES_ALL")
+	assert.Error(t, err)
+}
+
+func TestBuildWhereCheckQuery(t *testing.T) {
+	query := buildWhereCheckQuery("APPS", domain.Source{Name: "OPEN_INVOICES", Table: "AP_INVOICES_ALL", Where: "org_id = :org_id"})
+	assert.Equal(t, "SELECT CASE WHEN (org_id = :org_id) THEN 1 END FROM APPS.AP_INVOICES_ALL", query)
+}
@@ -0,0 +1,154 @@
+// Package oracle은 Oracle 데이터베이스 연결 및 데이터 추출 기능을 제공합니다.
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"oracle-etl/internal/domain"
+)
+
+// oraTableNotFound는 테이블/뷰가 없거나 권한이 없을 때의 Oracle 에러 코드입니다
+const oraTableNotFound = 942
+
+// rowidRangeQuery는 테이블 세그먼트의 extent를 블록 수 기준으로 n개 그룹으로 나누고
+// 그룹별 시작/종료 ROWID를 계산합니다 (DBMS_PARALLEL_EXECUTE.CREATE_CHUNKS_BY_ROWID와 같은 방식)
+// 바인드 변수는 SQL 내 등장 순서대로 번호를 매깁니다
+const rowidRangeQuery = `
+	SELECT
+		DBMS_ROWID.ROWID_CREATE(1, o.data_object_id, e.lo_fno, e.lo_block, 0) AS start_rowid,
+		DBMS_ROWID.ROWID_CREATE(1, o.data_object_id, e.hi_fno, e.hi_block, 32767) AS end_rowid
+	FROM (
+		SELECT
+			grp,
+			MIN(relative_fno) KEEP (DENSE_RANK FIRST ORDER BY relative_fno, block_id) AS lo_fno,
+			MIN(block_id) KEEP (DENSE_RANK FIRST ORDER BY relative_fno, block_id) AS lo_block,
+			MAX(relative_fno) KEEP (DENSE_RANK LAST ORDER BY relative_fno, block_id) AS hi_fno,
+			MAX(block_id + blocks - 1) KEEP (DENSE_RANK LAST ORDER BY relative_fno, block_id) AS hi_block
+		FROM (
+			SELECT
+				relative_fno,
+				block_id,
+				blocks,
+				TRUNC((SUM(blocks) OVER (ORDER BY relative_fno, block_id) - 0.01) / (SUM(blocks) OVER () / :1)) AS grp
+			FROM dba_extents
+			WHERE owner = :2 AND segment_name = :3 AND segment_type = 'TABLE'
+		)
+		GROUP BY grp
+	) e,
+	(
+		SELECT data_object_id
+		FROM all_objects
+		WHERE owner = :4 AND object_name = :5 AND object_type = 'TABLE'
+	) o
+	ORDER BY e.grp
+`
+
+// SplitTable은 대용량 테이블을 병렬 추출용 범위로 나눕니다
+// 통계상 row 수(all_tables.num_rows)가 opts.MinRows 이상인 테이블만 분할하며,
+// 파티션 테이블은 파티션 단위로, 그 외는 dba_extents 기반 ROWID 범위로 나눕니다
+// dba_extents 조회 권한이 없으면 분할하지 않습니다
+func (p *Pool) SplitTable(ctx context.Context, owner, tableName string, opts domain.SplitOptions) ([]domain.TableRange, error) {
+	if !opts.Enabled() {
+		return nil, nil
+	}
+
+	var (
+		numRows     int64
+		partitioned string
+	)
+	err := p.db.QueryRowContext(ctx,
+		"SELECT NVL(num_rows, 0), partitioned FROM all_tables WHERE owner = :1 AND table_name = :2",
+		owner, tableName,
+	).Scan(&numRows, &partitioned)
+	if errors.Is(err, sql.ErrNoRows) {
+		// 테이블이 없으면 분할하지 않고 추출 단계에서 에러 처리
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("테이블 통계 조회 실패: %w", err)
+	}
+
+	n := opts.RangeCount(numRows)
+	if n <= 1 {
+		return nil, nil
+	}
+
+	if partitioned == "YES" {
+		ranges, err := p.partitionRanges(ctx, owner, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if len(ranges) > 1 {
+			return ranges, nil
+		}
+	}
+
+	return p.rowidRanges(ctx, owner, tableName, n)
+}
+
+// partitionRanges는 파티션별 범위를 반환합니다
+func (p *Pool) partitionRanges(ctx context.Context, owner, tableName string) ([]domain.TableRange, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT partition_name
+		FROM all_tab_partitions
+		WHERE table_owner = :1 AND table_name = :2
+		ORDER BY partition_position
+	`, owner, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("파티션 목록 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var ranges []domain.TableRange
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("파티션 정보 스캔 실패: %w", err)
+		}
+		// 쿼리에 직접 삽입되므로 일반 식별자 형식이 아닌 파티션이 있으면 파티션 분할을 사용하지 않음
+		if domain.ValidateIdentifier(name) != nil {
+			return nil, nil
+		}
+		ranges = append(ranges, domain.TableRange{Index: len(ranges), Partition: name})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("파티션 목록 순회 실패: %w", err)
+	}
+
+	return ranges, nil
+}
+
+// rowidRanges는 extent 기반으로 n개 이하의 ROWID 범위를 반환합니다
+func (p *Pool) rowidRanges(ctx context.Context, owner, tableName string, n int) ([]domain.TableRange, error) {
+	rows, err := p.db.QueryContext(ctx, rowidRangeQuery, n, owner, tableName, owner, tableName)
+	if err != nil {
+		if oraCode(err) == oraTableNotFound {
+			// dba_extents 권한 없음: 분할하지 않음
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ROWID 범위 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var ranges []domain.TableRange
+	for rows.Next() {
+		r := domain.TableRange{Index: len(ranges)}
+		if err := rows.Scan(&r.StartRowID, &r.EndRowID); err != nil {
+			return nil, fmt.Errorf("ROWID 범위 스캔 실패: %w", err)
+		}
+		ranges = append(ranges, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ROWID 범위 순회 실패: %w", err)
+	}
+
+	if len(ranges) <= 1 {
+		return nil, nil
+	}
+	return ranges, nil
+}
@@ -169,6 +169,22 @@ func (b *Broadcaster) BroadcastComplete(event CompleteEvent) {
 	})
 }
 
+// BroadcastRetry는 Retry 이벤트를 브로드캐스트합니다
+func (b *Broadcaster) BroadcastRetry(event RetryEvent) {
+	b.Broadcast(event.TransportID, SSEEvent{
+		Event: EventTypeRetry,
+		Data:  event,
+	})
+}
+
+// BroadcastCircuit은 Circuit 이벤트를 브로드캐스트합니다
+func (b *Broadcaster) BroadcastCircuit(event CircuitEvent) {
+	b.Broadcast(event.TransportID, SSEEvent{
+		Event: EventTypeCircuit,
+		Data:  event,
+	})
+}
+
 // ClientCount는 현재 연결된 클라이언트 수를 반환합니다
 func (b *Broadcaster) ClientCount() int {
 	return int(atomic.LoadInt32(&b.clientCount))
@@ -17,6 +17,10 @@ const (
 	EventTypeError = "error"
 	// EventTypeComplete는 완료 이벤트 타입입니다
 	EventTypeComplete = "complete"
+	// EventTypeRetry는 테이블 재시도 이벤트 타입입니다
+	EventTypeRetry = "retry"
+	// EventTypeCircuit은 Circuit Breaker 상태 변경 이벤트 타입입니다
+	EventTypeCircuit = "circuit"
 )
 
 // 상태 상수
@@ -27,6 +31,12 @@ const (
 	StatusCompleted = "completed"
 	// StatusFailed는 실패 상태입니다
 	StatusFailed = "failed"
+	// StatusCancelled는 취소 상태입니다
+	StatusCancelled = "cancelled"
+	// StatusDeferred는 유지보수 시간대 밖이어서 실행을 미룬 상태입니다
+	StatusDeferred = "deferred"
+	// StatusPaused는 유지보수 시간대가 닫혀 실행을 멈춘 상태입니다
+	StatusPaused = "paused"
 )
 
 // SSEEvent는 SSE 이벤트의 기본 구조입니다
@@ -47,14 +57,26 @@ func (e *SSEEvent) Format() (string, error) {
 
 // ProgressEvent는 진행률 이벤트입니다
 type ProgressEvent struct {
-	TransportID     string  `json:"transport_id"`     // Transport ID
-	JobID           string  `json:"job_id"`           // Job ID
-	Table           string  `json:"table"`            // 현재 처리 중인 테이블
-	RowsProcessed   int64   `json:"rows_processed"`   // 처리된 row 수
-	RowsTotal       int64   `json:"rows_total"`       // 총 row 수 (-1 if unknown)
-	RowsPerSecond   float64 `json:"rows_per_second"`  // 초당 처리 row 수
-	BytesWritten    int64   `json:"bytes_written"`    // 작성된 바이트 수
-	ProgressPercent float64 `json:"progress_percent"` // 진행률 (0-100)
+	TransportID     string        `json:"transport_id"`       // Transport ID
+	JobID           string        `json:"job_id"`             // Job ID
+	Table           string        `json:"table"`              // 현재 처리 중인 테이블
+	RowsProcessed   int64         `json:"rows_processed"`     // 처리된 row 수
+	RowsTotal       int64         `json:"rows_total"`         // 총 row 수 (-1 if unknown)
+	RowsPerSecond   float64       `json:"rows_per_second"`    // 초당 처리 row 수
+	BytesWritten    int64         `json:"bytes_written"`      // 작성된 바이트 수
+	ProgressPercent float64       `json:"progress_percent"`   // 진행률 (0-100)
+	Throttle        *ThrottleInfo `json:"throttle,omitempty"` // 추출 부하 조절 상태 (부하 조절 미사용 시 생략)
+}
+
+// ThrottleInfo는 진행률 이벤트에 포함되는 추출 부하 조절 상태입니다
+type ThrottleInfo struct {
+	State              string  `json:"state"`                           // 상태: normal, slow, paused
+	Reason             string  `json:"reason,omitempty"`                // slow/paused 원인
+	HostCPUPercent     float64 `json:"host_cpu_percent,omitempty"`      // 마지막으로 조회한 호스트 CPU 사용률 (%)
+	ActiveSessions     float64 `json:"active_sessions,omitempty"`       // 마지막으로 조회한 평균 활성 세션 수
+	ActiveCursors      int     `json:"active_cursors"`                  // 모든 Job의 열린 추출 커서 수
+	MaxCursors         int     `json:"max_cursors,omitempty"`           // 현재 적용 중인 동시 커서 수 상한
+	RowsPerSecondLimit float64 `json:"rows_per_second_limit,omitempty"` // 현재 적용 중인 Job별 초당 row 수 상한
 }
 
 // CalculateProgressPercent는 진행률을 계산합니다
@@ -148,3 +170,51 @@ func NewCompleteEvent(transportID, jobID string, totalRows, totalBytes, duration
 		RowsPerSecond: rowsPerSecond,
 	}
 }
+
+// RetryEvent는 일시적 장애로 테이블 추출을 재시도할 때의 이벤트입니다
+type RetryEvent struct {
+	TransportID string `json:"transport_id"` // Transport ID
+	JobID       string `json:"job_id"`       // Job ID
+	Table       string `json:"table"`        // 재시도 테이블
+	Attempt     int    `json:"attempt"`      // 실패한 시도 번호 (1부터 시작)
+	MaxAttempts int    `json:"max_attempts"` // 최대 시도 횟수
+	DelayMs     int64  `json:"delay_ms"`     // 다음 시도까지 대기 시간 (밀리초)
+	Message     string `json:"message"`      // 실패 원인
+	Timestamp   string `json:"timestamp"`    // 발생 시간 (ISO8601)
+}
+
+// NewRetryEvent는 새로운 RetryEvent를 생성합니다
+func NewRetryEvent(transportID, jobID, table string, attempt, maxAttempts int, delay time.Duration, message string) *RetryEvent {
+	return &RetryEvent{
+		TransportID: transportID,
+		JobID:       jobID,
+		Table:       table,
+		Attempt:     attempt,
+		MaxAttempts: maxAttempts,
+		DelayMs:     delay.Milliseconds(),
+		Message:     message,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// CircuitEvent는 의존성(oracle, gcs) Circuit Breaker 상태 변경 이벤트입니다
+type CircuitEvent struct {
+	TransportID string `json:"transport_id"` // Transport ID
+	JobID       string `json:"job_id"`       // 영향받는 Job ID
+	Name        string `json:"name"`         // 의존성 이름: oracle, gcs
+	State       string `json:"state"`        // 변경된 상태: closed, open, half-open
+	Message     string `json:"message"`      // 상태 메시지
+	Timestamp   string `json:"timestamp"`    // 발생 시간 (ISO8601)
+}
+
+// NewCircuitEvent는 새로운 CircuitEvent를 생성합니다
+func NewCircuitEvent(transportID, jobID, name, state, message string) *CircuitEvent {
+	return &CircuitEvent{
+		TransportID: transportID,
+		JobID:       jobID,
+		Name:        name,
+		State:       state,
+		Message:     message,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+	}
+}
@@ -235,3 +235,32 @@ func TestNewCompleteEvent(t *testing.T) {
 	// RowsPerSecond는 계산되어야 함: 100000 rows / 8 seconds = 12500
 	assert.Equal(t, 12500.0, event.RowsPerSecond)
 }
+
+func TestNewRetryEvent(t *testing.T) {
+	// NewRetryEvent 헬퍼 함수 및 JSON 직렬화 테스트
+	event := NewRetryEvent("TRPID-12345678", "JOB-001", "VBRP", 1, 3, 1500*time.Millisecond, "ORA-03113")
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, "VBRP", decoded["table"])
+	assert.Equal(t, float64(1), decoded["attempt"])
+	assert.Equal(t, float64(3), decoded["max_attempts"])
+	assert.Equal(t, float64(1500), decoded["delay_ms"])
+	assert.Equal(t, "ORA-03113", decoded["message"])
+	assert.NotEmpty(t, decoded["timestamp"])
+}
+
+func TestNewCircuitEvent(t *testing.T) {
+	// NewCircuitEvent 헬퍼 함수 테스트
+	event := NewCircuitEvent("TRPID-12345678", "JOB-001", "oracle", "open", "Oracle 호출 차단")
+
+	assert.Equal(t, "TRPID-12345678", event.TransportID)
+	assert.Equal(t, "JOB-001", event.JobID)
+	assert.Equal(t, "oracle", event.Name)
+	assert.Equal(t, "open", event.State)
+	assert.NotEmpty(t, event.Timestamp)
+}
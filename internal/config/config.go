@@ -12,14 +12,19 @@ import (
 
 // Config는 애플리케이션 전체 설정 구조체입니다
 type Config struct {
-	Server    ServerConfig    `mapstructure:"server"`
-	App       AppConfig       `mapstructure:"app"`
-	Oracle    OracleConfig    `mapstructure:"oracle"`
-	GCS       GCSConfig       `mapstructure:"gcs"`
-	ETL       ETLConfig       `mapstructure:"etl"`
-	Auth      AuthConfig      `mapstructure:"auth"`
-	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
-	CORS      CORSConfig      `mapstructure:"cors"`
+	Server      ServerConfig      `mapstructure:"server"`
+	App         AppConfig         `mapstructure:"app"`
+	Oracle      OracleConfig      `mapstructure:"oracle"`
+	GCS         GCSConfig         `mapstructure:"gcs"`
+	ETL         ETLConfig         `mapstructure:"etl"`
+	Auth        AuthConfig        `mapstructure:"auth"`
+	RateLimit   RateLimitConfig   `mapstructure:"rate_limit"`
+	CORS        CORSConfig        `mapstructure:"cors"`
+	Scheduler   SchedulerConfig   `mapstructure:"scheduler"`
+	Storage     StorageConfig     `mapstructure:"storage"`
+	Metrics     MetricsConfig     `mapstructure:"metrics"`
+	Tracing     TracingConfig     `mapstructure:"tracing"`
+	Maintenance MaintenanceConfig `mapstructure:"maintenance"`
 }
 
 // ServerConfig는 HTTP 서버 관련 설정입니다
@@ -34,6 +39,7 @@ type AppConfig struct {
 	Name        string `mapstructure:"name"`
 	Version     string `mapstructure:"version"`
 	Environment string `mapstructure:"environment"`
+	DemoMode    bool   `mapstructure:"demo_mode"` // Oracle 설정이 없을 때 Mock 저장소로 테이블 조회 API 제공
 }
 
 // OracleConfig는 Oracle 데이터베이스 연결 설정입니다
@@ -60,17 +66,69 @@ type GCSConfig struct {
 
 // ETLConfig는 ETL 작업 관련 설정입니다
 type ETLConfig struct {
-	ChunkSize      int    `mapstructure:"chunk_size"`      // 청크당 row 수
-	ParallelTables int    `mapstructure:"parallel_tables"` // 병렬 처리 테이블 수
-	RetryAttempts  int    `mapstructure:"retry_attempts"`  // 재시도 횟수
-	RetryBackoff   string `mapstructure:"retry_backoff"`   // 재시도 간격
+	ChunkSize       int                  `mapstructure:"chunk_size"`        // 청크당 row 수
+	ChunkMaxBytes   int                  `mapstructure:"chunk_max_bytes"`   // 청크당 최대 row 데이터 크기 (바이트, 큰 LOB 테이블의 메모리 제한)
+	LOBMaxInline    int                  `mapstructure:"lob_max_inline"`    // row에 포함(text/base64)하는 LOB 값 하나의 최대 크기 (바이트, 넘으면 테이블 실패)
+	ParallelTables  int                  `mapstructure:"parallel_tables"`   // 병렬 처리 테이블 수 (분할 범위 포함 동시 추출 수)
+	RetryAttempts   int                  `mapstructure:"retry_attempts"`    // 일시적 장애 시 테이블당 최대 시도 횟수 (첫 시도 포함)
+	RetryBackoff    string               `mapstructure:"retry_backoff"`     // 첫 재시도 대기 시간 (이후 2배씩 증가)
+	RetryMaxBackoff string               `mapstructure:"retry_max_backoff"` // 재시도 대기 시간 상한
+	RetryJitter     float64              `mapstructure:"retry_jitter"`      // 재시도 대기 시간의 무작위 편차 비율 (0~1)
+	Split           SplitConfig          `mapstructure:"split"`             // 대용량 테이블 분할 추출 설정
+	CircuitBreaker  CircuitBreakerConfig `mapstructure:"circuit_breaker"`   // Oracle/GCS 의존성별 Circuit Breaker 설정
+	LoadGovernor    LoadGovernorConfig   `mapstructure:"load_governor"`     // 운영 DB 보호를 위한 추출 부하 조절 설정
+}
+
+// LoadGovernorConfig는 운영 Oracle 데이터베이스를 보호하기 위한 추출 부하 조절 설정입니다
+// 모든 Job의 동시 추출 커서 수와 Job별 초당 row 수를 제한하고, V$SYSMETRIC 부하 지표가 기준을 넘으면
+// 추출을 늦추거나(slow: 커서 수/row 속도 상한에 slow_factor 적용) 멈춥니다(paused)
+type LoadGovernorConfig struct {
+	Enabled             bool    `mapstructure:"enabled"`               // 부하 조절 활성화 여부
+	MaxCursors          int     `mapstructure:"max_cursors"`           // 모든 Job을 합산한 동시 추출 커서 수 상한 (0이면 무제한)
+	RowsPerSecond       float64 `mapstructure:"rows_per_second"`       // Job별 초당 추출 row 수 상한 (0이면 무제한)
+	SampleInterval      string  `mapstructure:"sample_interval"`       // 부하 지표 조회 주기
+	HostCPUSlow         float64 `mapstructure:"host_cpu_slow"`         // 호스트 CPU 사용률(%)이 이 값 이상이면 slow (0이면 사용하지 않음)
+	HostCPUPause        float64 `mapstructure:"host_cpu_pause"`        // 호스트 CPU 사용률(%)이 이 값 이상이면 paused (0이면 사용하지 않음)
+	ActiveSessionsSlow  float64 `mapstructure:"active_sessions_slow"`  // 평균 활성 세션 수가 이 값 이상이면 slow (0이면 사용하지 않음)
+	ActiveSessionsPause float64 `mapstructure:"active_sessions_pause"` // 평균 활성 세션 수가 이 값 이상이면 paused (0이면 사용하지 않음)
+	SlowFactor          float64 `mapstructure:"slow_factor"`           // slow 상태에서 커서 수/row 속도 상한에 곱하는 비율 (0 초과 1 이하)
+}
+
+// HasLoadThresholds는 부하 지표(V$SYSMETRIC) 기준이 하나라도 설정되었는지 확인합니다
+func (c LoadGovernorConfig) HasLoadThresholds() bool {
+	return c.HostCPUSlow > 0 || c.HostCPUPause > 0 || c.ActiveSessionsSlow > 0 || c.ActiveSessionsPause > 0
+}
+
+// CircuitBreakerConfig는 Oracle/GCS 호출을 보호하는 Circuit Breaker 설정입니다
+// 일시적 장애가 연속으로 failure_threshold회 발생하면 open_timeout 동안 호출을 즉시 거부합니다
+type CircuitBreakerConfig struct {
+	Enabled          bool   `mapstructure:"enabled"`           // Circuit Breaker 활성화 여부
+	FailureThreshold int    `mapstructure:"failure_threshold"` // Open으로 전환되는 연속 실패 수
+	SuccessThreshold int    `mapstructure:"success_threshold"` // Half-Open에서 Closed로 복귀하는 연속 성공 수
+	OpenTimeout      string `mapstructure:"open_timeout"`      // Open 상태 유지 시간 (이후 Half-Open에서 시험 호출)
+}
+
+// SplitConfig는 대용량 테이블을 ROWID 범위/파티션 단위로 나누어 병렬 추출하는 설정입니다
+type SplitConfig struct {
+	MinRows      int64 `mapstructure:"min_rows"`       // 분할 대상 최소 row 수 (통계 기준, 0이면 비활성화)
+	RowsPerRange int64 `mapstructure:"rows_per_range"` // 범위당 목표 row 수
+	MaxRanges    int   `mapstructure:"max_ranges"`     // 테이블당 최대 범위 수
 }
 
 // AuthConfig는 API 인증 관련 설정입니다
 type AuthConfig struct {
-	Enabled      bool     `mapstructure:"enabled"`       // 인증 활성화 여부
-	APIKeys      []string `mapstructure:"api_keys"`      // 유효한 API Key 목록
-	BearerSecret string   `mapstructure:"bearer_secret"` // JWT 서명용 비밀키
+	Enabled      bool           `mapstructure:"enabled"`       // 인증 활성화 여부
+	APIKeys      []string       `mapstructure:"api_keys"`      // 기본 역할(default_role)로 인증되는 API Key 목록
+	Keys         []APIKeyConfig `mapstructure:"keys"`          // 역할이 지정된 API Key 목록
+	BearerSecret string         `mapstructure:"bearer_secret"` // JWT 서명용 비밀키
+	DefaultRole  string         `mapstructure:"default_role"`  // 역할 정보가 없는 API Key/JWT의 역할 (viewer, operator, admin)
+}
+
+// APIKeyConfig는 역할이 지정된 API Key 설정입니다
+type APIKeyConfig struct {
+	Name string `mapstructure:"name"` // 키 이름 (로그 및 감사용 식별자)
+	Key  string `mapstructure:"key"`  // API Key 값
+	Role string `mapstructure:"role"` // 역할 (viewer, operator, admin)
 }
 
 // RateLimitConfig는 요청 제한 관련 설정입니다
@@ -91,6 +149,65 @@ type CORSConfig struct {
 	MaxAge           int      `mapstructure:"max_age"`           // preflight 캐시 시간 (초)
 }
 
+// SchedulerConfig는 Transport cron 스케줄러 설정입니다
+type SchedulerConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`        // 스케줄러 활성화 여부
+	Timezone      string `mapstructure:"timezone"`       // 시간대 미지정 스케줄의 기본 시간대
+	OverlapPolicy string `mapstructure:"overlap_policy"` // 실행 중일 때 처리 방식 (skip, queue)
+	TickInterval  string `mapstructure:"tick_interval"`  // 스케줄 평가 주기
+	MisfireGrace  string `mapstructure:"misfire_grace"`  // 재시작 시 놓친 실행 허용 범위
+}
+
+// MaintenanceConfig는 Transport 실행을 허용하거나 금지하는 유지보수 시간대 설정입니다
+// Transport는 maintenance.windows로 시간대를 지정하며, global 시간대는 모든 Transport에 적용됩니다
+type MaintenanceConfig struct {
+	OutsideWindow string                    `mapstructure:"outside_window"` // 시간대 밖 실행 요청 처리 기본값 (reject, defer)
+	OnClose       string                    `mapstructure:"on_close"`       // 실행 중 시간대가 닫힐 때 처리 기본값 (continue, pause, abort)
+	Windows       []MaintenanceWindowConfig `mapstructure:"windows"`        // 유지보수 시간대 목록
+}
+
+// MaintenanceWindowConfig는 이름이 있는 유지보수 시간대입니다
+// cron 시작 시각부터 duration 동안 유지되는 반복 시간대 또는 ranges의 달력 구간으로 지정합니다
+type MaintenanceWindowConfig struct {
+	Name     string                   `mapstructure:"name"`     // 시간대 이름 (Transport에서 참조)
+	Type     string                   `mapstructure:"type"`     // allow: 이 시간대에만 실행, blackout: 이 기간에는 실행 금지
+	Cron     string                   `mapstructure:"cron"`     // 시간대 시작 시각 cron 표현식
+	Duration string                   `mapstructure:"duration"` // cron 시작 시각부터 유지되는 시간
+	Ranges   []MaintenanceRangeConfig `mapstructure:"ranges"`   // 달력 구간 목록
+	Timezone string                   `mapstructure:"timezone"` // cron과 구간 시각을 해석할 시간대 (빈 값이면 scheduler.timezone)
+	Global   bool                     `mapstructure:"global"`   // 모든 Transport에 적용
+}
+
+// MaintenanceRangeConfig는 [start, end) 달력 구간입니다
+// 시각은 "2026-10-29 00:00" 또는 RFC3339 형식이며, 날짜만 지정한 end는 그날 전체를 포함합니다
+type MaintenanceRangeConfig struct {
+	Start string `mapstructure:"start"`
+	End   string `mapstructure:"end"`
+}
+
+// StorageConfig는 Transport/Job 저장소 설정입니다
+type StorageConfig struct {
+	Driver string `mapstructure:"driver"` // 저장소 종류 (memory: 재시작 시 초기화, bolt: 파일 기반 영구 저장)
+	Path   string `mapstructure:"path"`   // bolt 데이터베이스 파일 경로
+}
+
+// MetricsConfig는 Prometheus 메트릭 엔드포인트 설정입니다
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"` // 메트릭 엔드포인트 활성화 여부
+	Path    string `mapstructure:"path"`    // 메트릭 엔드포인트 경로
+}
+
+// TracingConfig는 OpenTelemetry 분산 추적 설정입니다
+type TracingConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`      // 추적 활성화 여부
+	Exporter    string            `mapstructure:"exporter"`     // 내보내기 방식 (otlp: OTLP/HTTP 수집기, file: 로컬 JSON 파일)
+	Endpoint    string            `mapstructure:"endpoint"`     // OTLP/HTTP 수집기 주소 (host:port, 비어 있으면 OTEL_EXPORTER_OTLP_ENDPOINT 또는 localhost:4318)
+	Insecure    bool              `mapstructure:"insecure"`     // OTLP 수집기에 TLS 없이 연결
+	Headers     map[string]string `mapstructure:"headers"`      // OTLP 요청 헤더 (인증 토큰 등)
+	FilePath    string            `mapstructure:"file_path"`    // file 내보내기 경로 (span당 JSON 한 줄)
+	SampleRatio float64           `mapstructure:"sample_ratio"` // 샘플링 비율 (0~1, 상위 span의 샘플링 결정을 우선)
+}
+
 // Load는 지정된 경로의 설정 파일과 환경 변수에서 설정을 로드합니다
 func Load(configPath string) (*Config, error) {
 	v := viper.New()
@@ -126,6 +243,9 @@ func bindEnvVars(v *viper.Viper) {
 	// 서버 설정
 	_ = v.BindEnv("server.port", "SERVER_PORT")
 
+	// 앱 설정
+	_ = v.BindEnv("app.demo_mode", "APP_DEMO_MODE")
+
 	// Oracle 설정
 	_ = v.BindEnv("oracle.wallet_path", "ORACLE_WALLET_PATH")
 	_ = v.BindEnv("oracle.tns_name", "ORACLE_TNS_NAME")
@@ -143,10 +263,29 @@ func bindEnvVars(v *viper.Viper) {
 	_ = v.BindEnv("gcs.chunk_size", "GCS_CHUNK_SIZE")
 	_ = v.BindEnv("gcs.timeout_seconds", "GCS_TIMEOUT_SECONDS")
 
+	// ETL 재시도/Circuit Breaker 설정
+	_ = v.BindEnv("etl.retry_attempts", "ETL_RETRY_ATTEMPTS")
+	_ = v.BindEnv("etl.retry_backoff", "ETL_RETRY_BACKOFF")
+	_ = v.BindEnv("etl.retry_max_backoff", "ETL_RETRY_MAX_BACKOFF")
+	_ = v.BindEnv("etl.retry_jitter", "ETL_RETRY_JITTER")
+	_ = v.BindEnv("etl.circuit_breaker.enabled", "ETL_CIRCUIT_BREAKER_ENABLED")
+	_ = v.BindEnv("etl.circuit_breaker.failure_threshold", "ETL_CIRCUIT_BREAKER_FAILURE_THRESHOLD")
+	_ = v.BindEnv("etl.circuit_breaker.open_timeout", "ETL_CIRCUIT_BREAKER_OPEN_TIMEOUT")
+
+	// ETL 부하 조절 설정
+	_ = v.BindEnv("etl.load_governor.enabled", "ETL_LOAD_GOVERNOR_ENABLED")
+	_ = v.BindEnv("etl.load_governor.max_cursors", "ETL_LOAD_GOVERNOR_MAX_CURSORS")
+	_ = v.BindEnv("etl.load_governor.rows_per_second", "ETL_LOAD_GOVERNOR_ROWS_PER_SECOND")
+	_ = v.BindEnv("etl.load_governor.host_cpu_slow", "ETL_LOAD_GOVERNOR_HOST_CPU_SLOW")
+	_ = v.BindEnv("etl.load_governor.host_cpu_pause", "ETL_LOAD_GOVERNOR_HOST_CPU_PAUSE")
+	_ = v.BindEnv("etl.load_governor.active_sessions_slow", "ETL_LOAD_GOVERNOR_ACTIVE_SESSIONS_SLOW")
+	_ = v.BindEnv("etl.load_governor.active_sessions_pause", "ETL_LOAD_GOVERNOR_ACTIVE_SESSIONS_PAUSE")
+
 	// Auth 설정
 	_ = v.BindEnv("auth.enabled", "AUTH_ENABLED")
 	_ = v.BindEnv("auth.api_keys", "AUTH_API_KEYS")
 	_ = v.BindEnv("auth.bearer_secret", "AUTH_BEARER_SECRET")
+	_ = v.BindEnv("auth.default_role", "AUTH_DEFAULT_ROLE")
 
 	// Rate Limit 설정
 	_ = v.BindEnv("rate_limit.enabled", "RATE_LIMIT_ENABLED")
@@ -156,6 +295,31 @@ func bindEnvVars(v *viper.Viper) {
 	// CORS 설정
 	_ = v.BindEnv("cors.enabled", "CORS_ENABLED")
 	_ = v.BindEnv("cors.allow_origins", "CORS_ALLOW_ORIGINS")
+
+	// Scheduler 설정
+	_ = v.BindEnv("scheduler.enabled", "SCHEDULER_ENABLED")
+	_ = v.BindEnv("scheduler.timezone", "SCHEDULER_TIMEZONE")
+	_ = v.BindEnv("scheduler.overlap_policy", "SCHEDULER_OVERLAP_POLICY")
+
+	// Maintenance 설정
+	_ = v.BindEnv("maintenance.outside_window", "MAINTENANCE_OUTSIDE_WINDOW")
+	_ = v.BindEnv("maintenance.on_close", "MAINTENANCE_ON_CLOSE")
+
+	// Storage 설정
+	_ = v.BindEnv("storage.driver", "STORAGE_DRIVER")
+	_ = v.BindEnv("storage.path", "STORAGE_PATH")
+
+	// Metrics 설정
+	_ = v.BindEnv("metrics.enabled", "METRICS_ENABLED")
+	_ = v.BindEnv("metrics.path", "METRICS_PATH")
+
+	// Tracing 설정
+	_ = v.BindEnv("tracing.enabled", "TRACING_ENABLED")
+	_ = v.BindEnv("tracing.exporter", "TRACING_EXPORTER")
+	_ = v.BindEnv("tracing.endpoint", "TRACING_ENDPOINT")
+	_ = v.BindEnv("tracing.insecure", "TRACING_INSECURE")
+	_ = v.BindEnv("tracing.file_path", "TRACING_FILE_PATH")
+	_ = v.BindEnv("tracing.sample_ratio", "TRACING_SAMPLE_RATIO")
 }
 
 // setDefaults는 Viper에 기본값을 설정합니다
@@ -169,6 +333,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("app.name", "oracle-etl")
 	v.SetDefault("app.version", "1.0.0")
 	v.SetDefault("app.environment", "production")
+	v.SetDefault("app.demo_mode", false)
 
 	// Oracle 기본값
 	v.SetDefault("oracle.pool_min", 2)
@@ -182,14 +347,35 @@ func setDefaults(v *viper.Viper) {
 
 	// ETL 기본값
 	v.SetDefault("etl.chunk_size", 10000)
+	v.SetDefault("etl.chunk_max_bytes", 64*1024*1024) // 64MB
+	v.SetDefault("etl.lob_max_inline", 16*1024*1024)  // 16MB
 	v.SetDefault("etl.parallel_tables", 4)
 	v.SetDefault("etl.retry_attempts", 3)
 	v.SetDefault("etl.retry_backoff", "1s")
+	v.SetDefault("etl.retry_max_backoff", "30s")
+	v.SetDefault("etl.retry_jitter", 0.2)
+	v.SetDefault("etl.split.min_rows", 50_000_000)
+	v.SetDefault("etl.split.rows_per_range", 10_000_000)
+	v.SetDefault("etl.split.max_ranges", 32)
+	v.SetDefault("etl.circuit_breaker.enabled", true)
+	v.SetDefault("etl.circuit_breaker.failure_threshold", 5)
+	v.SetDefault("etl.circuit_breaker.success_threshold", 2)
+	v.SetDefault("etl.circuit_breaker.open_timeout", "30s")
+	v.SetDefault("etl.load_governor.enabled", false)
+	v.SetDefault("etl.load_governor.max_cursors", 0)
+	v.SetDefault("etl.load_governor.rows_per_second", 0)
+	v.SetDefault("etl.load_governor.sample_interval", "15s")
+	v.SetDefault("etl.load_governor.host_cpu_slow", 70)
+	v.SetDefault("etl.load_governor.host_cpu_pause", 85)
+	v.SetDefault("etl.load_governor.active_sessions_slow", 0)
+	v.SetDefault("etl.load_governor.active_sessions_pause", 0)
+	v.SetDefault("etl.load_governor.slow_factor", 0.5)
 
 	// Auth 기본값
 	v.SetDefault("auth.enabled", false)
 	v.SetDefault("auth.api_keys", []string{})
 	v.SetDefault("auth.bearer_secret", "")
+	v.SetDefault("auth.default_role", "viewer")
 
 	// Rate Limit 기본값
 	v.SetDefault("rate_limit.enabled", false)
@@ -199,11 +385,38 @@ func setDefaults(v *viper.Viper) {
 	// CORS 기본값
 	v.SetDefault("cors.enabled", false)
 	v.SetDefault("cors.allow_origins", []string{"*"})
-	v.SetDefault("cors.allow_methods", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"})
-	v.SetDefault("cors.allow_headers", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key"})
+	v.SetDefault("cors.allow_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"})
+	v.SetDefault("cors.allow_headers", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "If-Match"})
 	v.SetDefault("cors.allow_credentials", false)
-	v.SetDefault("cors.expose_headers", []string{})
+	v.SetDefault("cors.expose_headers", []string{"ETag"})
 	v.SetDefault("cors.max_age", 86400) // 24시간
+
+	// Scheduler 기본값
+	v.SetDefault("scheduler.enabled", true)
+	v.SetDefault("scheduler.timezone", "Asia/Seoul")
+	v.SetDefault("scheduler.overlap_policy", "skip")
+	v.SetDefault("scheduler.tick_interval", "10s")
+	v.SetDefault("scheduler.misfire_grace", "1m")
+
+	// Maintenance 기본값
+	v.SetDefault("maintenance.outside_window", "reject")
+	v.SetDefault("maintenance.on_close", "continue")
+
+	// Storage 기본값
+	v.SetDefault("storage.driver", "memory")
+	v.SetDefault("storage.path", "data/oracle-etl.db")
+
+	// Metrics 기본값
+	v.SetDefault("metrics.enabled", true)
+	v.SetDefault("metrics.path", "/metrics")
+
+	// Tracing 기본값
+	v.SetDefault("tracing.enabled", false)
+	v.SetDefault("tracing.exporter", "otlp")
+	v.SetDefault("tracing.endpoint", "")
+	v.SetDefault("tracing.insecure", false)
+	v.SetDefault("tracing.file_path", "./data/traces.jsonl")
+	v.SetDefault("tracing.sample_ratio", 1.0)
 }
 
 // Validate는 설정의 유효성을 검사합니다
@@ -235,9 +448,20 @@ func (c *Config) Validate() error {
 
 	// Auth 설정 유효성 검사
 	if c.Auth.Enabled {
-		if len(c.Auth.APIKeys) == 0 && c.Auth.BearerSecret == "" {
+		if len(c.Auth.APIKeys) == 0 && len(c.Auth.Keys) == 0 && c.Auth.BearerSecret == "" {
 			return fmt.Errorf("Auth가 활성화되었지만 API 키 또는 Bearer 비밀키가 설정되지 않음")
 		}
+		if c.Auth.DefaultRole != "" && !isValidRole(c.Auth.DefaultRole) {
+			return fmt.Errorf("잘못된 auth.default_role: %s (viewer, operator, admin 중 하나여야 함)", c.Auth.DefaultRole)
+		}
+		for i, key := range c.Auth.Keys {
+			if key.Key == "" {
+				return fmt.Errorf("auth.keys[%d]의 key가 설정되지 않음", i)
+			}
+			if !isValidRole(key.Role) {
+				return fmt.Errorf("잘못된 auth.keys[%d].role: %s (viewer, operator, admin 중 하나여야 함)", i, key.Role)
+			}
+		}
 	}
 
 	// Rate Limit 설정 유효성 검사
@@ -247,14 +471,164 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// 분할 추출 설정 유효성 검사
+	if c.ETL.Split.MinRows > 0 {
+		if c.ETL.Split.RowsPerRange <= 0 {
+			return fmt.Errorf("etl.split.rows_per_range는 0보다 커야 함")
+		}
+		if c.ETL.Split.MaxRanges < 2 {
+			return fmt.Errorf("etl.split.max_ranges는 2 이상이어야 함")
+		}
+	}
+
+	// 재시도/Circuit Breaker 설정 유효성 검사
+	if c.ETL.RetryJitter < 0 || c.ETL.RetryJitter > 1 {
+		return fmt.Errorf("잘못된 etl.retry_jitter: %v (0~1 사이여야 함)", c.ETL.RetryJitter)
+	}
+	for key, value := range map[string]string{
+		"etl.retry_backoff":     c.ETL.RetryBackoff,
+		"etl.retry_max_backoff": c.ETL.RetryMaxBackoff,
+	} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("잘못된 %s: %s", key, value)
+		}
+	}
+	if cb := c.ETL.CircuitBreaker; cb.Enabled {
+		if cb.FailureThreshold <= 0 {
+			return fmt.Errorf("etl.circuit_breaker.failure_threshold는 0보다 커야 함")
+		}
+		if cb.SuccessThreshold <= 0 {
+			return fmt.Errorf("etl.circuit_breaker.success_threshold는 0보다 커야 함")
+		}
+		if cb.OpenTimeout != "" {
+			if d, err := time.ParseDuration(cb.OpenTimeout); err != nil || d <= 0 {
+				return fmt.Errorf("잘못된 etl.circuit_breaker.open_timeout: %s", cb.OpenTimeout)
+			}
+		}
+	}
+
+	// 부하 조절 설정 유효성 검사
+	if lg := c.ETL.LoadGovernor; lg.Enabled {
+		if lg.MaxCursors < 0 {
+			return fmt.Errorf("etl.load_governor.max_cursors는 0 이상이어야 함")
+		}
+		if lg.RowsPerSecond < 0 {
+			return fmt.Errorf("etl.load_governor.rows_per_second는 0 이상이어야 함")
+		}
+		if lg.HostCPUSlow < 0 || lg.HostCPUSlow > 100 || lg.HostCPUPause < 0 || lg.HostCPUPause > 100 {
+			return fmt.Errorf("etl.load_governor.host_cpu_slow/host_cpu_pause는 0~100 사이여야 함")
+		}
+		if lg.ActiveSessionsSlow < 0 || lg.ActiveSessionsPause < 0 {
+			return fmt.Errorf("etl.load_governor.active_sessions_slow/active_sessions_pause는 0 이상이어야 함")
+		}
+		if lg.HostCPUSlow > 0 && lg.HostCPUPause > 0 && lg.HostCPUSlow > lg.HostCPUPause {
+			return fmt.Errorf("etl.load_governor.host_cpu_slow는 host_cpu_pause 이하여야 함")
+		}
+		if lg.ActiveSessionsSlow > 0 && lg.ActiveSessionsPause > 0 && lg.ActiveSessionsSlow > lg.ActiveSessionsPause {
+			return fmt.Errorf("etl.load_governor.active_sessions_slow는 active_sessions_pause 이하여야 함")
+		}
+		if lg.SlowFactor <= 0 || lg.SlowFactor > 1 {
+			return fmt.Errorf("잘못된 etl.load_governor.slow_factor: %v (0 초과 1 이하여야 함)", lg.SlowFactor)
+		}
+		if lg.SampleInterval != "" {
+			if d, err := time.ParseDuration(lg.SampleInterval); err != nil || d <= 0 {
+				return fmt.Errorf("잘못된 etl.load_governor.sample_interval: %s", lg.SampleInterval)
+			}
+		}
+	}
+
+	// Scheduler 설정 유효성 검사
+	if c.Scheduler.Enabled {
+		if c.Scheduler.Timezone != "" {
+			if _, err := time.LoadLocation(c.Scheduler.Timezone); err != nil {
+				return fmt.Errorf("잘못된 스케줄러 시간대: %s", c.Scheduler.Timezone)
+			}
+		}
+		switch c.Scheduler.OverlapPolicy {
+		case "", "skip", "queue":
+		default:
+			return fmt.Errorf("잘못된 스케줄러 overlap_policy: %s (skip, queue 중 하나여야 함)", c.Scheduler.OverlapPolicy)
+		}
+	}
+
+	// Maintenance 설정 유효성 검사 (시간대 정의는 서버 시작 시 파싱하여 검사)
+	switch c.Maintenance.OutsideWindow {
+	case "", "reject", "defer":
+	default:
+		return fmt.Errorf("잘못된 maintenance.outside_window: %s (reject, defer 중 하나여야 함)", c.Maintenance.OutsideWindow)
+	}
+	switch c.Maintenance.OnClose {
+	case "", "continue", "pause", "abort":
+	default:
+		return fmt.Errorf("잘못된 maintenance.on_close: %s (continue, pause, abort 중 하나여야 함)", c.Maintenance.OnClose)
+	}
+	for i, w := range c.Maintenance.Windows {
+		if w.Duration != "" {
+			if d, err := time.ParseDuration(w.Duration); err != nil || d <= 0 {
+				return fmt.Errorf("잘못된 maintenance.windows[%d].duration: %s", i, w.Duration)
+			}
+		}
+	}
+
+	// Storage 설정 유효성 검사
+	switch c.Storage.Driver {
+	case "", "memory":
+	case "bolt":
+		if c.Storage.Path == "" {
+			return fmt.Errorf("storage.driver가 bolt이지만 storage.path가 설정되지 않음")
+		}
+	default:
+		return fmt.Errorf("잘못된 storage.driver: %s (memory, bolt 중 하나여야 함)", c.Storage.Driver)
+	}
+
+	// Metrics 설정 유효성 검사
+	if c.Metrics.Enabled && !strings.HasPrefix(c.Metrics.Path, "/") {
+		return fmt.Errorf("잘못된 metrics.path: %q (/로 시작해야 함)", c.Metrics.Path)
+	}
+
+	// Tracing 설정 유효성 검사
+	if c.Tracing.Enabled {
+		switch c.Tracing.Exporter {
+		case "otlp":
+		case "file":
+			if c.Tracing.FilePath == "" {
+				return fmt.Errorf("tracing.exporter가 file이지만 tracing.file_path가 설정되지 않음")
+			}
+		default:
+			return fmt.Errorf("잘못된 tracing.exporter: %s (otlp, file 중 하나여야 함)", c.Tracing.Exporter)
+		}
+		if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+			return fmt.Errorf("잘못된 tracing.sample_ratio: %v (0~1 사이여야 함)", c.Tracing.SampleRatio)
+		}
+	}
+
 	return nil
 }
 
+// isValidRole은 API 권한 역할 이름이 유효한지 확인합니다
+func isValidRole(role string) bool {
+	switch role {
+	case "viewer", "operator", "admin":
+		return true
+	default:
+		return false
+	}
+}
+
 // HasOracleConfig는 Oracle 설정이 있는지 확인합니다
 func (c *Config) HasOracleConfig() bool {
 	return c.Oracle.TNSName != "" && c.Oracle.Username != ""
 }
 
+// IsDemoMode는 Mock Oracle 저장소를 사용하는 데모 모드인지 확인합니다
+// demo_mode가 켜져 있어도 Oracle 설정이 있으면 실제 Oracle에 연결합니다
+func (c *Config) IsDemoMode() bool {
+	return c.App.DemoMode && !c.HasOracleConfig()
+}
+
 // HasGCSConfig는 GCS 설정이 있는지 확인합니다
 func (c *Config) HasGCSConfig() bool {
 	return c.GCS.ProjectID != "" && c.GCS.BucketName != ""
@@ -267,3 +641,57 @@ func (c *Config) GetGCSTimeout() time.Duration {
 	}
 	return time.Duration(c.GCS.TimeoutSeconds) * time.Second
 }
+
+// GetRetryBackoff는 첫 재시도 대기 시간을 time.Duration으로 반환합니다
+func (c *Config) GetRetryBackoff() time.Duration {
+	d, err := time.ParseDuration(c.ETL.RetryBackoff)
+	if err != nil || d <= 0 {
+		return time.Second // 기본값
+	}
+	return d
+}
+
+// GetRetryMaxBackoff는 재시도 대기 시간 상한을 time.Duration으로 반환합니다
+func (c *Config) GetRetryMaxBackoff() time.Duration {
+	d, err := time.ParseDuration(c.ETL.RetryMaxBackoff)
+	if err != nil || d <= 0 {
+		return 30 * time.Second // 기본값
+	}
+	return d
+}
+
+// GetCircuitOpenTimeout은 Circuit Breaker의 Open 유지 시간을 time.Duration으로 반환합니다
+func (c *Config) GetCircuitOpenTimeout() time.Duration {
+	d, err := time.ParseDuration(c.ETL.CircuitBreaker.OpenTimeout)
+	if err != nil || d <= 0 {
+		return 30 * time.Second // 기본값
+	}
+	return d
+}
+
+// GetLoadGovernorSampleInterval은 부하 지표 조회 주기를 time.Duration으로 반환합니다
+func (c *Config) GetLoadGovernorSampleInterval() time.Duration {
+	d, err := time.ParseDuration(c.ETL.LoadGovernor.SampleInterval)
+	if err != nil || d <= 0 {
+		return 15 * time.Second // 기본값
+	}
+	return d
+}
+
+// GetSchedulerTickInterval은 스케줄 평가 주기를 time.Duration으로 반환합니다
+func (c *Config) GetSchedulerTickInterval() time.Duration {
+	d, err := time.ParseDuration(c.Scheduler.TickInterval)
+	if err != nil || d <= 0 {
+		return 10 * time.Second // 기본값
+	}
+	return d
+}
+
+// GetSchedulerMisfireGrace는 놓친 실행 허용 범위를 time.Duration으로 반환합니다
+func (c *Config) GetSchedulerMisfireGrace() time.Duration {
+	d, err := time.ParseDuration(c.Scheduler.MisfireGrace)
+	if err != nil || d < 0 {
+		return time.Minute // 기본값
+	}
+	return d
+}
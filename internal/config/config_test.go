@@ -108,8 +108,12 @@ func TestLoadConfig_OracleDefaults(t *testing.T) {
 
 	// ETL 기본값 검증
 	assert.Equal(t, 10000, cfg.ETL.ChunkSize)
+	assert.Equal(t, 16*1024*1024, cfg.ETL.LOBMaxInline)
 	assert.Equal(t, 4, cfg.ETL.ParallelTables)
 	assert.Equal(t, 3, cfg.ETL.RetryAttempts)
+	assert.Equal(t, int64(50_000_000), cfg.ETL.Split.MinRows)
+	assert.Equal(t, int64(10_000_000), cfg.ETL.Split.RowsPerRange)
+	assert.Equal(t, 32, cfg.ETL.Split.MaxRanges)
 }
 
 // TestLoadConfig_OracleFromEnv는 Oracle 환경 변수 바인딩을 테스트합니다
@@ -261,6 +265,69 @@ func TestConfig_HasOracleConfig(t *testing.T) {
 	}
 }
 
+// TestConfig_AuthRoleValidation은 API Key 역할 설정 검증을 테스트합니다
+func TestConfig_AuthRoleValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		auth    AuthConfig
+		wantErr string
+	}{
+		{
+			name: "역할 지정 키만 설정",
+			auth: AuthConfig{Enabled: true, Keys: []APIKeyConfig{{Name: "ops", Key: "k1", Role: "operator"}}},
+		},
+		{
+			name:    "알 수 없는 키 역할",
+			auth:    AuthConfig{Enabled: true, Keys: []APIKeyConfig{{Name: "ops", Key: "k1", Role: "root"}}},
+			wantErr: "auth.keys[0].role",
+		},
+		{
+			name:    "키 값 없음",
+			auth:    AuthConfig{Enabled: true, Keys: []APIKeyConfig{{Name: "ops", Role: "viewer"}}},
+			wantErr: "auth.keys[0]의 key",
+		},
+		{
+			name:    "알 수 없는 기본 역할",
+			auth:    AuthConfig{Enabled: true, APIKeys: []string{"k1"}, DefaultRole: "superuser"},
+			wantErr: "auth.default_role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Server: ServerConfig{Port: 8080}, Auth: tt.auth}
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestConfig_IsDemoMode는 Oracle 설정이 없을 때만 데모 모드가 적용되는지 테스트합니다
+func TestConfig_IsDemoMode(t *testing.T) {
+	cfg := Config{App: AppConfig{DemoMode: true}}
+	assert.True(t, cfg.IsDemoMode())
+
+	cfg.Oracle = OracleConfig{TNSName: "test_tns", Username: "test_user"}
+	assert.False(t, cfg.IsDemoMode())
+
+	cfg = Config{}
+	assert.False(t, cfg.IsDemoMode())
+}
+
+// TestLoadConfig_DemoModeFromEnv는 APP_DEMO_MODE 환경 변수를 테스트합니다
+func TestLoadConfig_DemoModeFromEnv(t *testing.T) {
+	t.Setenv("APP_DEMO_MODE", "true")
+
+	cfg, err := Load(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.True(t, cfg.App.DemoMode)
+}
+
 // TestLoadConfig_GCSDefaults는 GCS 기본 설정을 테스트합니다
 func TestLoadConfig_GCSDefaults(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -447,3 +514,449 @@ func TestConfig_GetGCSTimeout(t *testing.T) {
 		})
 	}
 }
+
+// TestLoadConfig_SchedulerDefaults는 Scheduler 기본 설정을 테스트합니다
+func TestLoadConfig_SchedulerDefaults(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	err := os.WriteFile(configPath, []byte(""), 0644)
+	require.NoError(t, err)
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Scheduler.Enabled)
+	assert.Equal(t, "Asia/Seoul", cfg.Scheduler.Timezone)
+	assert.Equal(t, "skip", cfg.Scheduler.OverlapPolicy)
+	assert.Equal(t, 10*time.Second, cfg.GetSchedulerTickInterval())
+	assert.Equal(t, time.Minute, cfg.GetSchedulerMisfireGrace())
+	assert.NoError(t, cfg.Validate())
+}
+
+// TestConfig_SchedulerValidation은 Scheduler 설정 유효성 검사를 테스트합니다
+func TestConfig_SchedulerValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		scheduler   SchedulerConfig
+		expectError bool
+	}{
+		{
+			name:      "유효한 설정",
+			scheduler: SchedulerConfig{Enabled: true, Timezone: "Asia/Seoul", OverlapPolicy: "queue"},
+		},
+		{
+			name:        "잘못된 시간대",
+			scheduler:   SchedulerConfig{Enabled: true, Timezone: "Mars/Olympus"},
+			expectError: true,
+		},
+		{
+			name:        "잘못된 overlap 정책",
+			scheduler:   SchedulerConfig{Enabled: true, OverlapPolicy: "parallel"},
+			expectError: true,
+		},
+		{
+			name:      "비활성화 시 검사 생략",
+			scheduler: SchedulerConfig{Enabled: false, OverlapPolicy: "parallel"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server:    ServerConfig{Port: 8080},
+				Scheduler: tt.scheduler,
+			}
+			err := cfg.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestConfig_SplitValidation은 분할 추출 설정 유효성 검사를 테스트합니다
+func TestConfig_SplitValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		split       SplitConfig
+		expectError bool
+	}{
+		{
+			name:  "유효한 설정",
+			split: SplitConfig{MinRows: 1000, RowsPerRange: 100, MaxRanges: 8},
+		},
+		{
+			name:        "범위당 row 수 누락",
+			split:       SplitConfig{MinRows: 1000, MaxRanges: 8},
+			expectError: true,
+		},
+		{
+			name:        "최대 범위 수 부족",
+			split:       SplitConfig{MinRows: 1000, RowsPerRange: 100, MaxRanges: 1},
+			expectError: true,
+		},
+		{
+			name:  "비활성화 시 검사 생략",
+			split: SplitConfig{MinRows: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: ServerConfig{Port: 8080},
+				ETL:    ETLConfig{Split: tt.split},
+			}
+			err := cfg.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestLoadConfig_StorageEnv는 저장소 기본값과 환경 변수 오버라이드를 테스트합니다
+func TestLoadConfig_StorageEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "memory", cfg.Storage.Driver)
+	assert.Equal(t, "data/oracle-etl.db", cfg.Storage.Path)
+
+	t.Setenv("STORAGE_DRIVER", "bolt")
+	t.Setenv("STORAGE_PATH", "/var/lib/oracle-etl/etl.db")
+
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "bolt", cfg.Storage.Driver)
+	assert.Equal(t, "/var/lib/oracle-etl/etl.db", cfg.Storage.Path)
+}
+
+// TestConfig_StorageValidation은 저장소 설정 유효성 검사를 테스트합니다
+func TestConfig_StorageValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		storage     StorageConfig
+		expectError bool
+	}{
+		{
+			name:    "기본값 (memory)",
+			storage: StorageConfig{},
+		},
+		{
+			name:    "bolt 파일 저장소",
+			storage: StorageConfig{Driver: "bolt", Path: "/var/lib/oracle-etl/etl.db"},
+		},
+		{
+			name:        "bolt 경로 누락",
+			storage:     StorageConfig{Driver: "bolt"},
+			expectError: true,
+		},
+		{
+			name:        "지원하지 않는 저장소",
+			storage:     StorageConfig{Driver: "postgres"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server:  ServerConfig{Port: 8080},
+				Storage: tt.storage,
+			}
+			err := cfg.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestLoadConfig_MetricsEnv는 메트릭 기본값과 환경 변수 오버라이드를 테스트합니다
+func TestLoadConfig_MetricsEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.True(t, cfg.Metrics.Enabled)
+	assert.Equal(t, "/metrics", cfg.Metrics.Path)
+
+	t.Setenv("METRICS_ENABLED", "false")
+	t.Setenv("METRICS_PATH", "/internal/metrics")
+
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	assert.False(t, cfg.Metrics.Enabled)
+	assert.Equal(t, "/internal/metrics", cfg.Metrics.Path)
+}
+
+// TestConfig_MetricsValidation은 메트릭 경로 유효성 검사를 테스트합니다
+func TestConfig_MetricsValidation(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Metrics: MetricsConfig{Enabled: true, Path: "/metrics"}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Metrics.Path = "metrics"
+	assert.Error(t, cfg.Validate())
+
+	// 비활성화 상태에서는 경로를 검사하지 않음
+	cfg.Metrics.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
+
+// TestLoadConfig_TracingEnv는 추적 기본값과 환경 변수 오버라이드를 테스트합니다
+func TestLoadConfig_TracingEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.False(t, cfg.Tracing.Enabled)
+	assert.Equal(t, "otlp", cfg.Tracing.Exporter)
+	assert.Equal(t, 1.0, cfg.Tracing.SampleRatio)
+
+	t.Setenv("TRACING_ENABLED", "true")
+	t.Setenv("TRACING_EXPORTER", "file")
+	t.Setenv("TRACING_FILE_PATH", "/tmp/traces.jsonl")
+	t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
+
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	assert.True(t, cfg.Tracing.Enabled)
+	assert.Equal(t, "file", cfg.Tracing.Exporter)
+	assert.Equal(t, "/tmp/traces.jsonl", cfg.Tracing.FilePath)
+	assert.Equal(t, 0.25, cfg.Tracing.SampleRatio)
+}
+
+// TestConfig_TracingValidation은 추적 설정 유효성 검사를 테스트합니다
+func TestConfig_TracingValidation(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Tracing: TracingConfig{Enabled: true, Exporter: "otlp", SampleRatio: 1}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Tracing.Exporter = "jaeger"
+	assert.Error(t, cfg.Validate())
+
+	cfg.Tracing.Exporter = "file"
+	assert.Error(t, cfg.Validate(), "file_path 없음")
+
+	cfg.Tracing.FilePath = "traces.jsonl"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Tracing.SampleRatio = 1.5
+	assert.Error(t, cfg.Validate())
+
+	// 비활성화 상태에서는 검사하지 않음
+	cfg.Tracing.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
+
+// TestLoadConfig_ResilienceEnv는 재시도/Circuit Breaker 기본값과 환경 변수 오버라이드를 테스트합니다
+func TestLoadConfig_ResilienceEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.ETL.RetryAttempts)
+	assert.Equal(t, time.Second, cfg.GetRetryBackoff())
+	assert.Equal(t, 30*time.Second, cfg.GetRetryMaxBackoff())
+	assert.Equal(t, 0.2, cfg.ETL.RetryJitter)
+	assert.True(t, cfg.ETL.CircuitBreaker.Enabled)
+	assert.Equal(t, 5, cfg.ETL.CircuitBreaker.FailureThreshold)
+	assert.Equal(t, 2, cfg.ETL.CircuitBreaker.SuccessThreshold)
+	assert.Equal(t, 30*time.Second, cfg.GetCircuitOpenTimeout())
+
+	t.Setenv("ETL_RETRY_ATTEMPTS", "5")
+	t.Setenv("ETL_RETRY_BACKOFF", "500ms")
+	t.Setenv("ETL_RETRY_JITTER", "0")
+	t.Setenv("ETL_CIRCUIT_BREAKER_ENABLED", "false")
+	t.Setenv("ETL_CIRCUIT_BREAKER_OPEN_TIMEOUT", "1m")
+
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.ETL.RetryAttempts)
+	assert.Equal(t, 500*time.Millisecond, cfg.GetRetryBackoff())
+	assert.Equal(t, 0.0, cfg.ETL.RetryJitter)
+	assert.False(t, cfg.ETL.CircuitBreaker.Enabled)
+	assert.Equal(t, time.Minute, cfg.GetCircuitOpenTimeout())
+}
+
+// TestConfig_ResilienceValidation은 재시도/Circuit Breaker 설정 유효성 검사를 테스트합니다
+func TestConfig_ResilienceValidation(t *testing.T) {
+	cfg := &Config{
+		Server: ServerConfig{Port: 8080},
+		ETL: ETLConfig{
+			RetryBackoff: "1s",
+			RetryJitter:  0.2,
+			CircuitBreaker: CircuitBreakerConfig{
+				Enabled: true, FailureThreshold: 5, SuccessThreshold: 2, OpenTimeout: "30s",
+			},
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.ETL.RetryJitter = 1.5
+	assert.Error(t, cfg.Validate())
+	cfg.ETL.RetryJitter = 0.2
+
+	cfg.ETL.RetryMaxBackoff = "soon"
+	assert.Error(t, cfg.Validate())
+	cfg.ETL.RetryMaxBackoff = "30s"
+
+	cfg.ETL.CircuitBreaker.FailureThreshold = 0
+	assert.Error(t, cfg.Validate())
+
+	// 비활성화 상태에서는 Circuit Breaker 설정을 검사하지 않음
+	cfg.ETL.CircuitBreaker.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
+
+// TestLoadConfig_LoadGovernorEnv는 부하 조절 기본값과 환경 변수 오버라이드를 테스트합니다
+func TestLoadConfig_LoadGovernorEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	lg := cfg.ETL.LoadGovernor
+	assert.False(t, lg.Enabled)
+	assert.Zero(t, lg.MaxCursors)
+	assert.Zero(t, lg.RowsPerSecond)
+	assert.Equal(t, 70.0, lg.HostCPUSlow)
+	assert.Equal(t, 85.0, lg.HostCPUPause)
+	assert.Equal(t, 0.5, lg.SlowFactor)
+	assert.True(t, lg.HasLoadThresholds())
+	assert.Equal(t, 15*time.Second, cfg.GetLoadGovernorSampleInterval())
+
+	t.Setenv("ETL_LOAD_GOVERNOR_ENABLED", "true")
+	t.Setenv("ETL_LOAD_GOVERNOR_MAX_CURSORS", "6")
+	t.Setenv("ETL_LOAD_GOVERNOR_ROWS_PER_SECOND", "50000")
+	t.Setenv("ETL_LOAD_GOVERNOR_HOST_CPU_SLOW", "0")
+	t.Setenv("ETL_LOAD_GOVERNOR_HOST_CPU_PAUSE", "0")
+	t.Setenv("ETL_LOAD_GOVERNOR_ACTIVE_SESSIONS_PAUSE", "48")
+
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	lg = cfg.ETL.LoadGovernor
+	assert.True(t, lg.Enabled)
+	assert.Equal(t, 6, lg.MaxCursors)
+	assert.Equal(t, 50000.0, lg.RowsPerSecond)
+	assert.Zero(t, lg.HostCPUSlow)
+	assert.Equal(t, 48.0, lg.ActiveSessionsPause)
+	assert.True(t, lg.HasLoadThresholds())
+}
+
+// TestConfig_LoadGovernorValidation은 부하 조절 설정 유효성 검사를 테스트합니다
+func TestConfig_LoadGovernorValidation(t *testing.T) {
+	valid := LoadGovernorConfig{
+		Enabled:        true,
+		MaxCursors:     8,
+		SampleInterval: "15s",
+		HostCPUSlow:    70,
+		HostCPUPause:   85,
+		SlowFactor:     0.5,
+	}
+	cfg := &Config{Server: ServerConfig{Port: 8080}, ETL: ETLConfig{LoadGovernor: valid}}
+	assert.NoError(t, cfg.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*LoadGovernorConfig)
+	}{
+		{"음수 커서 수", func(c *LoadGovernorConfig) { c.MaxCursors = -1 }},
+		{"음수 row 속도", func(c *LoadGovernorConfig) { c.RowsPerSecond = -10 }},
+		{"100 초과 CPU 기준", func(c *LoadGovernorConfig) { c.HostCPUPause = 120 }},
+		{"slow 기준이 pause 기준보다 큼", func(c *LoadGovernorConfig) { c.HostCPUSlow = 90 }},
+		{"세션 slow 기준이 pause 기준보다 큼", func(c *LoadGovernorConfig) { c.ActiveSessionsSlow = 50; c.ActiveSessionsPause = 40 }},
+		{"slow_factor 0", func(c *LoadGovernorConfig) { c.SlowFactor = 0 }},
+		{"slow_factor 1 초과", func(c *LoadGovernorConfig) { c.SlowFactor = 1.5 }},
+		{"잘못된 조회 주기", func(c *LoadGovernorConfig) { c.SampleInterval = "often" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lg := valid
+			tt.modify(&lg)
+			cfg.ETL.LoadGovernor = lg
+			assert.Error(t, cfg.Validate())
+
+			// 비활성화 상태에서는 검사하지 않음
+			cfg.ETL.LoadGovernor.Enabled = false
+			assert.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+// TestLoadConfig_Maintenance는 유지보수 시간대 설정 로드를 테스트합니다
+func TestLoadConfig_Maintenance(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	yaml := `
+maintenance:
+  on_close: pause
+  windows:
+    - name: night
+      type: allow
+      cron: "0 22 * * *"
+      duration: 8h
+    - name: month_end_close
+      type: blackout
+      global: true
+      ranges:
+        - start: "2026-10-29"
+          end: "2026-11-02"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yaml), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "reject", cfg.Maintenance.OutsideWindow)
+	assert.Equal(t, "pause", cfg.Maintenance.OnClose)
+	require.Len(t, cfg.Maintenance.Windows, 2)
+	assert.Equal(t, "8h", cfg.Maintenance.Windows[0].Duration)
+	assert.True(t, cfg.Maintenance.Windows[1].Global)
+	assert.Equal(t, []MaintenanceRangeConfig{{Start: "2026-10-29", End: "2026-11-02"}}, cfg.Maintenance.Windows[1].Ranges)
+
+	t.Setenv("MAINTENANCE_OUTSIDE_WINDOW", "defer")
+	t.Setenv("MAINTENANCE_ON_CLOSE", "abort")
+	cfg, err = Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "defer", cfg.Maintenance.OutsideWindow)
+	assert.Equal(t, "abort", cfg.Maintenance.OnClose)
+}
+
+// TestConfig_MaintenanceValidation은 유지보수 시간대 설정 유효성 검사를 테스트합니다
+func TestConfig_MaintenanceValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*MaintenanceConfig)
+	}{
+		{"잘못된 outside_window", func(c *MaintenanceConfig) { c.OutsideWindow = "queue" }},
+		{"잘못된 on_close", func(c *MaintenanceConfig) { c.OnClose = "stop" }},
+		{"잘못된 duration", func(c *MaintenanceConfig) {
+			c.Windows = []MaintenanceWindowConfig{{Name: "night", Cron: "0 22 * * *", Duration: "all night"}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Server: ServerConfig{Port: 8080}, Maintenance: MaintenanceConfig{OutsideWindow: "defer", OnClose: "pause"}}
+			require.NoError(t, cfg.Validate())
+			tt.modify(&cfg.Maintenance)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// columnPatternPattern은 컬럼 glob 패턴의 허용 형식입니다 (식별자 문자와 *, ?, [...])
+var columnPatternPattern = regexp.MustCompile(`^[A-Za-z0-9_$#*?\[\]^-]{1,128}$`)
+
+// ColumnFilter는 추출할 컬럼의 포함/제외 목록입니다
+// 패턴은 대소문자를 구분하지 않는 glob(*, ?, [...])이며, 포함 목록이 비어있으면 전체 컬럼에서 제외 목록을 뺍니다
+type ColumnFilter struct {
+	IncludeColumns []string `json:"include_columns,omitempty"` // 추출할 컬럼 패턴 (예: INVOICE_*)
+	ExcludeColumns []string `json:"exclude_columns,omitempty"` // 제외할 컬럼 패턴 (예: ATTRIBUTE*, GLOBAL_ATTRIBUTE*)
+}
+
+// Empty는 포함/제외 목록이 모두 비어있는지 반환합니다
+func (f ColumnFilter) Empty() bool {
+	return len(f.IncludeColumns) == 0 && len(f.ExcludeColumns) == 0
+}
+
+// Validate는 패턴 형식을 검사합니다
+func (f ColumnFilter) Validate() error {
+	for _, pattern := range f.IncludeColumns {
+		if err := validateColumnPattern(pattern); err != nil {
+			return fmt.Errorf("include_columns: %w", err)
+		}
+	}
+	for _, pattern := range f.ExcludeColumns {
+		if err := validateColumnPattern(pattern); err != nil {
+			return fmt.Errorf("exclude_columns: %w", err)
+		}
+	}
+	return nil
+}
+
+// Apply는 필터를 적용한 컬럼 목록을 반환합니다
+// 테이블의 컬럼 순서를 유지하며 위치(Position)는 출력 순서로 다시 매깁니다
+func (f ColumnFilter) Apply(columns []ColumnInfo) []ColumnInfo {
+	if f.Empty() {
+		return columns
+	}
+
+	selected := make([]ColumnInfo, 0, len(columns))
+	for _, col := range columns {
+		if len(f.IncludeColumns) > 0 && !matchAnyColumn(f.IncludeColumns, col.Name) {
+			continue
+		}
+		if matchAnyColumn(f.ExcludeColumns, col.Name) {
+			continue
+		}
+		col.Position = len(selected) + 1
+		selected = append(selected, col)
+	}
+	return selected
+}
+
+// ValidateColumns는 필터를 테이블 컬럼 정보와 대조하여 검사합니다
+// 어떤 컬럼과도 일치하지 않는 패턴(오타로 개인정보 컬럼이 제외되지 않는 경우 등)과 모든 컬럼이 제외되는 경우를 거부합니다
+func (f ColumnFilter) ValidateColumns(columns []ColumnInfo) error {
+	for _, pattern := range f.IncludeColumns {
+		if !matchAnyName(pattern, columns) {
+			return fmt.Errorf("include_columns의 %s와(과) 일치하는 컬럼이 없습니다", pattern)
+		}
+	}
+	for _, pattern := range f.ExcludeColumns {
+		if !matchAnyName(pattern, columns) {
+			return fmt.Errorf("exclude_columns의 %s와(과) 일치하는 컬럼이 없습니다", pattern)
+		}
+	}
+	if len(f.Apply(columns)) == 0 {
+		return fmt.Errorf("추출할 컬럼이 없습니다 (모든 컬럼이 제외됨)")
+	}
+	return nil
+}
+
+// Clone은 패턴 목록을 복사한 필터를 반환합니다
+func (f ColumnFilter) Clone() ColumnFilter {
+	copied := ColumnFilter{}
+	if f.IncludeColumns != nil {
+		copied.IncludeColumns = append([]string(nil), f.IncludeColumns...)
+	}
+	if f.ExcludeColumns != nil {
+		copied.ExcludeColumns = append([]string(nil), f.ExcludeColumns...)
+	}
+	return copied
+}
+
+// ColumnNames는 컬럼 이름 목록을 반환합니다
+func ColumnNames(columns []ColumnInfo) []string {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.Name
+	}
+	return names
+}
+
+// validateColumnPattern은 컬럼 glob 패턴 형식을 검사합니다
+func validateColumnPattern(pattern string) error {
+	if !columnPatternPattern.MatchString(pattern) {
+		return fmt.Errorf("잘못된 컬럼 패턴: %q", pattern)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("잘못된 컬럼 패턴: %q", pattern)
+	}
+	return nil
+}
+
+// matchColumn은 컬럼 이름이 glob 패턴과 일치하는지 대소문자 구분 없이 검사합니다
+func matchColumn(pattern, name string) bool {
+	matched, err := path.Match(strings.ToUpper(pattern), strings.ToUpper(name))
+	return err == nil && matched
+}
+
+// matchAnyColumn은 컬럼 이름이 패턴 중 하나와 일치하는지 검사합니다
+func matchAnyColumn(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchColumn(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAnyName은 패턴과 일치하는 컬럼이 있는지 검사합니다
+func matchAnyName(pattern string, columns []ColumnInfo) bool {
+	for _, col := range columns {
+		if matchColumn(pattern, col.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,7 +1,13 @@
 // Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
 package domain
 
-import "time"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"oracle-etl/pkg/rowset"
+)
 
 // TableInfo는 Oracle 테이블 메타데이터를 나타냅니다
 type TableInfo struct {
@@ -13,10 +19,12 @@ type TableInfo struct {
 
 // ColumnInfo는 테이블 컬럼 메타데이터를 나타냅니다
 type ColumnInfo struct {
-	Name     string `json:"name"`      // 컬럼 이름
-	DataType string `json:"data_type"` // 데이터 타입 (VARCHAR2, NUMBER 등)
-	Nullable bool   `json:"nullable"`  // NULL 허용 여부
-	Position int    `json:"position"`  // 컬럼 위치
+	Name      string `json:"name"`                // 컬럼 이름
+	DataType  string `json:"data_type"`           // 데이터 타입 (VARCHAR2, NUMBER 등)
+	Nullable  bool   `json:"nullable"`            // NULL 허용 여부
+	Position  int    `json:"position"`            // 컬럼 위치
+	Precision *int   `json:"precision,omitempty"` // NUMBER 전체 자릿수 (지정되지 않으면 nil)
+	Scale     *int   `json:"scale,omitempty"`     // NUMBER 소수점 이하 자릿수 (지정되지 않으면 nil)
 }
 
 // SampleData는 테이블 샘플 데이터 조회 결과를 나타냅니다
@@ -29,12 +37,12 @@ type SampleData struct {
 
 // OracleStatus는 Oracle 연결 상태 정보를 나타냅니다
 type OracleStatus struct {
-	Connected       bool      `json:"connected"`       // 연결 상태
+	Connected       bool      `json:"connected"`        // 연결 상태
 	DatabaseVersion string    `json:"database_version"` // 데이터베이스 버전
-	InstanceName    string    `json:"instance_name"`   // 인스턴스 이름
-	PoolStats       PoolStats `json:"pool_stats"`      // 커넥션 풀 통계
-	CheckedAt       time.Time `json:"checked_at"`      // 상태 확인 시간
-	Error           string    `json:"error,omitempty"` // 에러 메시지 (있는 경우)
+	InstanceName    string    `json:"instance_name"`    // 인스턴스 이름
+	PoolStats       PoolStats `json:"pool_stats"`       // 커넥션 풀 통계
+	CheckedAt       time.Time `json:"checked_at"`       // 상태 확인 시간
+	Error           string    `json:"error,omitempty"`  // 에러 메시지 (있는 경우)
 }
 
 // PoolStats는 커넥션 풀 통계를 나타냅니다
@@ -52,19 +60,29 @@ type TableListResponse struct {
 
 // ChunkResult는 청크 단위 데이터 추출 결과를 나타냅니다
 type ChunkResult struct {
-	TableName     string                   `json:"table_name"`      // 테이블 이름
-	ChunkNumber   int                      `json:"chunk_number"`    // 청크 번호
-	Rows          []map[string]interface{} `json:"rows"`            // 데이터 행
-	RowCount      int                      `json:"row_count"`       // 이 청크의 row 수
-	IsLastChunk   bool                     `json:"is_last_chunk"`   // 마지막 청크 여부
-	TotalRowsSent int64                    `json:"total_rows_sent"` // 지금까지 전송된 총 row 수
+	TableName      string       `json:"table_name"`                // 테이블 이름
+	ChunkNumber    int          `json:"chunk_number"`              // 청크 번호
+	Rows           []rowset.Row `json:"rows"`                      // 데이터 행 (청크 슬랩을 공유하는 typed row)
+	RowCount       int          `json:"row_count"`                 // 이 청크의 row 수
+	IsLastChunk    bool         `json:"is_last_chunk"`             // 마지막 청크 여부
+	TotalRowsSent  int64        `json:"total_rows_sent"`           // 지금까지 전송된 총 row 수
+	WatermarkValue *time.Time   `json:"watermark_value,omitempty"` // 지금까지 추출된 기준 컬럼 최대값 (증분 추출 시)
 }
 
 // ExtractionOptions는 데이터 추출 옵션을 나타냅니다
 type ExtractionOptions struct {
-	ChunkSize      int  `json:"chunk_size"`       // 청크당 row 수 (기본값: 10000)
-	FetchArraySize int  `json:"fetch_array_size"` // 배치 페치 크기 (기본값: 1000)
-	IncludeColumns bool `json:"include_columns"`  // 컬럼 정보 포함 여부
+	ChunkSize      int             `json:"chunk_size"`                // 청크당 row 수 (기본값: 10000)
+	FetchArraySize int             `json:"fetch_array_size"`          // 배치 페치 크기 (기본값: 1000)
+	IncludeColumns bool            `json:"include_columns"`           // 컬럼 정보 포함 여부
+	Watermark      *WatermarkRange `json:"watermark,omitempty"`       // 증분 추출 기준 (nil이면 전체 추출)
+	AsOfSCN        uint64          `json:"as_of_scn,omitempty"`       // flashback 조회 기준 SCN (0이면 현재 시점)
+	Range          *TableRange     `json:"range,omitempty"`           // 분할 추출 범위 (nil이면 테이블 전체)
+	Source         *Source         `json:"source,omitempty"`          // 추출 원본 정의 (nil이면 테이블 전체)
+	Columns        []string        `json:"columns,omitempty"`         // 조회할 컬럼 목록 (비어있으면 전체 컬럼)
+	ChunkMaxBytes  int64           `json:"chunk_max_bytes,omitempty"` // 청크당 최대 row 데이터 바이트 수 (0이면 row 수로만 제한)
+	LOBs           LOBPolicies     `json:"lobs,omitempty"`            // LOB 컬럼별 출력 방식 (없으면 CLOB은 text, BLOB은 base64)
+	LOBWriter      LOBWriter       `json:"-"`                         // object 정책 LOB을 기록할 대상
+	LOBMaxInline   int64           `json:"lob_max_inline,omitempty"`  // row에 포함하는 LOB 값 하나의 최대 바이트 수 (0이면 제한 없음)
 }
 
 // DefaultExtractionOptions는 기본 추출 옵션을 반환합니다
@@ -88,20 +106,37 @@ const (
 	ExtractionStatusCompleted ExtractionStatus = "completed"
 	// ExtractionStatusFailed는 실패 상태입니다
 	ExtractionStatusFailed ExtractionStatus = "failed"
+	// ExtractionStatusCancelled는 Job 취소로 중단된 상태입니다
+	ExtractionStatusCancelled ExtractionStatus = "cancelled"
 )
 
 // Extraction은 단일 테이블 추출 결과를 나타냅니다
 type Extraction struct {
-	ID          string           `json:"id"`                     // 추출 ID
-	JobID       string           `json:"job_id"`                 // 연결된 Job ID
-	TableName   string           `json:"table_name"`             // 테이블 이름
-	Status      ExtractionStatus `json:"status"`                 // 상태
-	RowCount    int64            `json:"row_count"`              // 처리된 row 수
-	ByteCount   int64            `json:"byte_count"`             // 전송된 바이트 수
-	GCSPath     string           `json:"gcs_path,omitempty"`     // GCS 객체 경로
-	StartedAt   *time.Time       `json:"started_at,omitempty"`   // 시작 시간
-	CompletedAt *time.Time       `json:"completed_at,omitempty"` // 완료 시간
-	Error       *string          `json:"error,omitempty"`        // 에러 메시지
+	ID             string           `json:"id"`                       // 추출 ID
+	JobID          string           `json:"job_id"`                   // 연결된 Job ID
+	TableName      string           `json:"table_name"`               // 테이블 이름
+	Status         ExtractionStatus `json:"status"`                   // 상태
+	RowCount       int64            `json:"row_count"`                // 처리된 row 수
+	ByteCount      int64            `json:"byte_count"`               // 전송된 바이트 수
+	GCSPath        string           `json:"gcs_path,omitempty"`       // GCS 객체 경로 (분할 추출 시 part 객체 prefix)
+	Parts          int              `json:"parts,omitempty"`          // 분할 추출된 범위 수 (분할하지 않으면 생략)
+	Objects        []ObjectInfo     `json:"objects,omitempty"`        // 업로드된 객체 목록 (GCS 미설정 시 생략)
+	LOBObjects     []ObjectInfo     `json:"lob_objects,omitempty"`    // object 정책으로 업로드된 LOB 객체 목록
+	Watermark      *WatermarkRange  `json:"watermark,omitempty"`      // 증분 추출 범위
+	Attempts       int              `json:"attempts,omitempty"`       // 이 테이블을 추출한 시도 횟수 (Job 재시도 포함)
+	Reconciliation *Reconciliation  `json:"reconciliation,omitempty"` // 원본/대상 row 수 대사 결과 (대사하지 않으면 생략)
+	StartedAt      *time.Time       `json:"started_at,omitempty"`     // 시작 시간
+	CompletedAt    *time.Time       `json:"completed_at,omitempty"`   // 완료 시간
+	Error          *string          `json:"error,omitempty"`          // 에러 메시지
+	ErrorDetail    *ErrorDetail     `json:"error_detail,omitempty"`   // 분류된 에러 정보 (Oracle 에러로 실패한 경우)
+}
+
+// ErrorDetail은 실패 원인의 분류 결과입니다
+type ErrorDetail struct {
+	Code      string `json:"code"`               // 에러 코드 (예: ORACLE_TABLE_NOT_FOUND)
+	OraCode   string `json:"ora_code,omitempty"` // Oracle 에러 코드 (예: ORA-00942)
+	Retryable bool   `json:"retryable"`          // Job 재시도로 해결될 수 있는지 여부
+	Hint      string `json:"hint,omitempty"`     // 조치 방법
 }
 
 // NewExtraction은 새로운 Extraction을 생성합니다
@@ -141,3 +176,15 @@ func (e *Extraction) Fail(err error) {
 		e.Error = &errStr
 	}
 }
+
+// Cancel은 Extraction을 취소 상태로 변경합니다
+// err가 취소 자체가 아닌 다른 원인이면 메시지를 함께 기록합니다
+func (e *Extraction) Cancel(err error) {
+	now := time.Now().UTC()
+	e.Status = ExtractionStatusCancelled
+	e.CompletedAt = &now
+	if err != nil && !errors.Is(err, context.Canceled) {
+		errStr := err.Error()
+		e.Error = &errStr
+	}
+}
@@ -27,12 +27,18 @@ func (s JobStatus) IsTerminal() bool {
 	return s == JobStatusCompleted || s == JobStatusFailed || s == JobStatusCancelled
 }
 
+// IsRetryable은 Job을 재시도할 수 있는 상태(실패/취소)인지 확인합니다
+func (s JobStatus) IsRetryable() bool {
+	return s == JobStatusFailed || s == JobStatusCancelled
+}
+
 // JobMetrics는 Job 실행 메트릭을 나타냅니다
 type JobMetrics struct {
-	TotalRows     int64         `json:"total_rows"`      // 총 처리 row 수
-	TotalBytes    int64         `json:"total_bytes"`     // 총 바이트 수
-	Duration      time.Duration `json:"duration"`        // 실행 시간
-	RowsPerSecond float64       `json:"rows_per_second"` // 초당 처리 row 수
+	TotalRows           int64         `json:"total_rows"`                     // 총 처리 row 수
+	TotalBytes          int64         `json:"total_bytes"`                    // 총 바이트 수
+	Duration            time.Duration `json:"duration"`                       // 실행 시간
+	RowsPerSecond       float64       `json:"rows_per_second"`                // 초당 처리 row 수
+	ReconcileMismatches int           `json:"reconcile_mismatches,omitempty"` // 원본/대상 row 수가 일치하지 않은 테이블 수
 }
 
 // CalculateRowsPerSecond는 초당 처리 row 수를 계산합니다
@@ -44,16 +50,21 @@ func (m *JobMetrics) CalculateRowsPerSecond() {
 
 // Job은 Transport의 단일 실행을 나타냅니다
 type Job struct {
-	ID          string       `json:"id"`                     // JOB-{timestamp}-{random}
-	TransportID string       `json:"transport_id"`           // 연결된 Transport ID
-	Version     int          `json:"version"`                // JOBVER: Transport별 증가
-	Status      JobStatus    `json:"status"`                 // 현재 상태
-	StartedAt   *time.Time   `json:"started_at,omitempty"`   // 시작 시간
-	CompletedAt *time.Time   `json:"completed_at,omitempty"` // 완료 시간
-	Extractions []Extraction `json:"extractions,omitempty"`  // 테이블별 추출 결과
-	Error       *string      `json:"error,omitempty"`        // 에러 메시지
-	Metrics     JobMetrics   `json:"metrics"`                // 실행 메트릭
-	CreatedAt   time.Time    `json:"created_at"`             // 생성 시간
+	ID                string       `json:"id"`                           // JOB-{timestamp}-{random}
+	TransportID       string       `json:"transport_id"`                 // 연결된 Transport ID
+	Version           int          `json:"version"`                      // JOBVER: Transport별 증가
+	Status            JobStatus    `json:"status"`                       // 현재 상태
+	StartedAt         *time.Time   `json:"started_at,omitempty"`         // 시작 시간
+	CompletedAt       *time.Time   `json:"completed_at,omitempty"`       // 완료 시간
+	Extractions       []Extraction `json:"extractions,omitempty"`        // 테이블별 추출 결과
+	Error             *string      `json:"error,omitempty"`              // 에러 메시지
+	Metrics           JobMetrics   `json:"metrics"`                      // 실행 메트릭
+	SnapshotSCN       uint64       `json:"snapshot_scn,omitempty"`       // 일관된 스냅샷 조회 기준 SCN (0이면 미사용)
+	Attempt           int          `json:"attempt"`                      // 실행 시도 번호 (최초 실행은 1, 재시도마다 증가)
+	FullReload        bool         `json:"full_reload,omitempty"`        // watermark를 무시한 전체 추출 여부 (재시도 시 동일하게 적용)
+	TransportRevision int          `json:"transport_revision,omitempty"` // 실행한 Transport 정의의 리비전 (재시도도 같은 리비전의 정의로 실행)
+	DeferredUntil     *time.Time   `json:"deferred_until,omitempty"`     // 유지보수 시간대 밖이어서 실행을 미룬 경우 실행 예정 시각
+	CreatedAt         time.Time    `json:"created_at"`                   // 생성 시간
 }
 
 // GenerateJobID는 새로운 Job ID를 생성합니다
@@ -75,12 +86,18 @@ func NewJob(id, transportID string, version int) *Job {
 		TransportID: transportID,
 		Version:     version,
 		Status:      JobStatusPending,
+		Attempt:     1,
 		Extractions: make([]Extraction, 0),
 		Metrics:     JobMetrics{},
 		CreatedAt:   now,
 	}
 }
 
+// IsDeferred는 유지보수 시간대가 열릴 때까지 실행을 미루고 대기 중인 Job인지 확인합니다
+func (j *Job) IsDeferred() bool {
+	return j.Status == JobStatusPending && j.DeferredUntil != nil
+}
+
 // Start는 Job을 시작 상태로 변경합니다
 func (j *Job) Start() {
 	now := time.Now().UTC()
@@ -124,6 +141,36 @@ func (j *Job) Cancel() {
 	}
 }
 
+// Retry는 실패/취소된 Job을 다음 시도의 대기 상태로 되돌립니다
+// 완료된 Extraction은 유지되며 시도 번호가 증가합니다
+func (j *Job) Retry() {
+	if j.Attempt < 1 {
+		j.Attempt = 1
+	}
+	j.Attempt++
+	j.Status = JobStatusPending
+	j.Error = nil
+	j.CompletedAt = nil
+}
+
+// PendingTables는 tables 중 완료된 Extraction이 없는 테이블을 순서대로 반환합니다
+func (j *Job) PendingTables(tables []string) []string {
+	completed := make(map[string]bool, len(j.Extractions))
+	for _, ext := range j.Extractions {
+		if ext.Status == ExtractionStatusCompleted {
+			completed[ext.TableName] = true
+		}
+	}
+
+	pending := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if !completed[table] {
+			pending = append(pending, table)
+		}
+	}
+	return pending
+}
+
 // AddExtraction은 Job에 Extraction을 추가합니다
 func (j *Job) AddExtraction(ext Extraction) {
 	j.Extractions = append(j.Extractions, ext)
@@ -132,21 +179,28 @@ func (j *Job) AddExtraction(ext Extraction) {
 // UpdateMetrics는 Extractions 기반으로 메트릭을 업데이트합니다
 func (j *Job) UpdateMetrics() {
 	var totalRows, totalBytes int64
+	mismatches := 0
 	for _, ext := range j.Extractions {
 		totalRows += ext.RowCount
 		totalBytes += ext.ByteCount
+		if ext.Reconciliation != nil && !ext.Reconciliation.Matched {
+			mismatches++
+		}
 	}
 	j.Metrics.TotalRows = totalRows
 	j.Metrics.TotalBytes = totalBytes
+	j.Metrics.ReconcileMismatches = mismatches
 	j.Metrics.CalculateRowsPerSecond()
 }
 
 // ExecuteJobResponse는 Job 실행 응답입니다
 type ExecuteJobResponse struct {
-	JobID       string    `json:"job_id"`
-	TransportID string    `json:"transport_id"`
-	Version     int       `json:"version"`
-	Status      JobStatus `json:"status"`
+	JobID         string     `json:"job_id"`
+	TransportID   string     `json:"transport_id"`
+	Version       int        `json:"version"`
+	Status        JobStatus  `json:"status"`
+	Attempt       int        `json:"attempt,omitempty"`
+	DeferredUntil *time.Time `json:"deferred_until,omitempty"` // 유지보수 시간대 밖이어서 실행이 미뤄진 경우 실행 예정 시각
 }
 
 // JobListResponse는 Job 목록 응답입니다
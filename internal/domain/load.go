@@ -0,0 +1,11 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import "time"
+
+// LoadMetrics는 Oracle 데이터베이스의 부하 지표입니다 (V$SYSMETRIC 최근 60초 구간)
+type LoadMetrics struct {
+	HostCPUPercent float64   `json:"host_cpu_percent"` // 호스트 CPU 사용률 (Host CPU Utilization (%))
+	ActiveSessions float64   `json:"active_sessions"`  // 평균 활성 세션 수 (Average Active Sessions)
+	SampledAt      time.Time `json:"sampled_at"`       // 조회 시간
+}
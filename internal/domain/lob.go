@@ -0,0 +1,131 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LOBPolicy는 LOB 컬럼 값의 출력 방식입니다
+type LOBPolicy string
+
+const (
+	// LOBPolicyText는 CLOB/NCLOB 값을 row에 문자열로 포함합니다 (CLOB 기본값)
+	LOBPolicyText LOBPolicy = "text"
+	// LOBPolicyBase64는 BLOB/BFILE 값을 row에 base64 문자열로 포함합니다 (BLOB 기본값)
+	LOBPolicyBase64 LOBPolicy = "base64"
+	// LOBPolicySkip은 LOB 내용을 읽지 않고 NULL로 출력합니다
+	LOBPolicySkip LOBPolicy = "skip"
+	// LOBPolicyObject는 LOB 값을 별도 GCS 객체로 스트리밍하고 row에는 객체 경로(gs://...)를 기록합니다
+	LOBPolicyObject LOBPolicy = "object"
+)
+
+// Validate는 LOB 정책 값을 검사합니다
+func (p LOBPolicy) Validate() error {
+	switch p {
+	case LOBPolicyText, LOBPolicyBase64, LOBPolicySkip, LOBPolicyObject:
+		return nil
+	default:
+		return fmt.Errorf("지원하지 않는 LOB 정책: %q (text, base64, skip, object 중 하나)", p)
+	}
+}
+
+// IsLOBType은 Oracle 데이터 타입이 LOB 타입(CLOB, NCLOB, BLOB, BFILE)인지 반환합니다
+func IsLOBType(dataType string) bool {
+	switch strings.ToUpper(dataType) {
+	case "CLOB", "NCLOB", "BLOB", "BFILE":
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultLOBPolicy는 LOB 타입의 기본 정책을 반환합니다 (문자 LOB은 text, 바이너리 LOB은 base64)
+func DefaultLOBPolicy(dataType string) LOBPolicy {
+	switch strings.ToUpper(dataType) {
+	case "BLOB", "BFILE":
+		return LOBPolicyBase64
+	default:
+		return LOBPolicyText
+	}
+}
+
+// LOBPolicies는 컬럼별 LOB 정책입니다 (키는 컬럼 이름, 대소문자 구분 없음)
+type LOBPolicies map[string]LOBPolicy
+
+// Validate는 컬럼 이름과 정책 값을 검사합니다
+func (p LOBPolicies) Validate() error {
+	for column, policy := range p {
+		if err := ValidateIdentifier(column); err != nil {
+			return fmt.Errorf("lob_columns: %w", err)
+		}
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("lob_columns.%s: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// ValidateColumns는 정책을 추출 컬럼 정보와 대조하여 검사합니다
+// 정책 컬럼은 추출 컬럼에 포함된 LOB 컬럼이어야 하며, text는 문자 LOB에만, base64는 바이너리 LOB에만 지정할 수 있습니다
+func (p LOBPolicies) ValidateColumns(columns []ColumnInfo) error {
+	for column, policy := range p {
+		col, ok := findColumn(columns, column)
+		if !ok {
+			return fmt.Errorf("lob_columns의 %s이(가) 추출 컬럼에 없습니다", column)
+		}
+		if !IsLOBType(col.DataType) {
+			return fmt.Errorf("lob_columns의 %s은(는) LOB 컬럼이 아닙니다 (%s)", column, col.DataType)
+		}
+		switch {
+		case policy == LOBPolicyText && DefaultLOBPolicy(col.DataType) != LOBPolicyText:
+			return fmt.Errorf("lob_columns의 %s: text 정책은 CLOB/NCLOB에만 지정할 수 있습니다", column)
+		case policy == LOBPolicyBase64 && DefaultLOBPolicy(col.DataType) != LOBPolicyBase64:
+			return fmt.Errorf("lob_columns의 %s: base64 정책은 BLOB/BFILE에만 지정할 수 있습니다", column)
+		}
+	}
+	return nil
+}
+
+// Policy는 컬럼의 정책을 반환합니다 (지정되지 않았으면 빈 값)
+func (p LOBPolicies) Policy(column string) LOBPolicy {
+	if policy, ok := p[column]; ok {
+		return policy
+	}
+	for name, policy := range p {
+		if strings.EqualFold(name, column) {
+			return policy
+		}
+	}
+	return ""
+}
+
+// Clone은 정책 map을 복사합니다
+func (p LOBPolicies) Clone() LOBPolicies {
+	if p == nil {
+		return nil
+	}
+	copied := make(LOBPolicies, len(p))
+	for column, policy := range p {
+		copied[column] = policy
+	}
+	return copied
+}
+
+// LOBWriter는 LOB 값을 별도 객체로 스트리밍 기록하는 인터페이스입니다 (LOBPolicyObject)
+type LOBWriter interface {
+	// WriteLOB은 r의 내용을 column 컬럼의 row번째(범위 내 1부터) 값으로 기록하고 참조 경로를 반환합니다
+	WriteLOB(ctx context.Context, column string, row int64, r io.Reader) (string, error)
+}
+
+// findColumn은 이름이 같은 컬럼을 대소문자 구분 없이 찾습니다
+func findColumn(columns []ColumnInfo, name string) (ColumnInfo, bool) {
+	for _, col := range columns {
+		if strings.EqualFold(col.Name, name) {
+			return col, true
+		}
+	}
+	return ColumnInfo{}, false
+}
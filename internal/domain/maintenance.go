@@ -0,0 +1,97 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutsideWindowAction은 유지보수 시간대 밖에서 실행을 요청했을 때의 처리 방식입니다
+type OutsideWindowAction string
+
+const (
+	// OutsideWindowReject는 실행 요청을 거부합니다 (스케줄 실행은 건너뜀)
+	OutsideWindowReject OutsideWindowAction = "reject"
+	// OutsideWindowDefer는 Job을 생성하고 시간대가 열릴 때까지 실행을 미룹니다
+	OutsideWindowDefer OutsideWindowAction = "defer"
+)
+
+// Validate는 지원하는 처리 방식인지 검사합니다 (빈 값은 전역 설정)
+func (a OutsideWindowAction) Validate() error {
+	switch a {
+	case "", OutsideWindowReject, OutsideWindowDefer:
+		return nil
+	}
+	return fmt.Errorf("지원하지 않는 outside_window '%s' (reject, defer)", a)
+}
+
+// WindowCloseAction은 실행 중에 유지보수 시간대가 닫혔을 때의 처리 방식입니다
+type WindowCloseAction string
+
+const (
+	// WindowCloseContinue는 실행 중인 Job을 끝까지 실행합니다
+	WindowCloseContinue WindowCloseAction = "continue"
+	// WindowClosePause는 진행 중인 테이블을 끝낸 뒤 다음 테이블 시작 전에 멈추고 시간대가 다시 열리면 이어서 추출합니다
+	WindowClosePause WindowCloseAction = "pause"
+	// WindowCloseAbort는 실행 중인 Job을 중단하고 실패로 기록합니다 (재시도 가능)
+	WindowCloseAbort WindowCloseAction = "abort"
+)
+
+// Validate는 지원하는 처리 방식인지 검사합니다 (빈 값은 전역 설정)
+func (a WindowCloseAction) Validate() error {
+	switch a {
+	case "", WindowCloseContinue, WindowClosePause, WindowCloseAbort:
+		return nil
+	}
+	return fmt.Errorf("지원하지 않는 on_close '%s' (continue, pause, abort)", a)
+}
+
+// MaintenancePolicy는 Transport에 적용할 유지보수 시간대와 처리 방식입니다
+// 전역(global) 시간대는 지정하지 않아도 항상 적용됩니다
+type MaintenancePolicy struct {
+	Windows       []string            `json:"windows,omitempty"`        // 적용할 유지보수 시간대 이름
+	OutsideWindow OutsideWindowAction `json:"outside_window,omitempty"` // 시간대 밖 실행 요청 처리 (빈 값이면 전역 설정)
+	OnClose       WindowCloseAction   `json:"on_close,omitempty"`       // 실행 중 시간대가 닫힐 때 처리 (빈 값이면 전역 설정)
+}
+
+// Validate는 처리 방식의 유효성을 검사합니다 (시간대 이름은 설정된 시간대와 대조해야 함)
+func (p *MaintenancePolicy) Validate() error {
+	seen := make(map[string]bool, len(p.Windows))
+	for _, name := range p.Windows {
+		if name == "" {
+			return fmt.Errorf("maintenance.windows에 빈 이름이 있습니다")
+		}
+		if seen[name] {
+			return fmt.Errorf("maintenance.windows에 '%s'이(가) 중복되었습니다", name)
+		}
+		seen[name] = true
+	}
+	if err := p.OutsideWindow.Validate(); err != nil {
+		return err
+	}
+	return p.OnClose.Validate()
+}
+
+// Clone은 Windows를 포함한 깊은 복사본을 반환합니다
+func (p *MaintenancePolicy) Clone() *MaintenancePolicy {
+	if p == nil {
+		return nil
+	}
+	copied := *p
+	if p.Windows != nil {
+		copied.Windows = append([]string(nil), p.Windows...)
+	}
+	return &copied
+}
+
+// ExecutionWindow는 조회 시점의 유지보수 시간대 기준 실행 가능 상태입니다 (조회 시 계산)
+type ExecutionWindow struct {
+	Allowed            bool       `json:"allowed"`                         // 지금 실행할 수 있는지 여부
+	Reason             string     `json:"reason,omitempty"`                // 실행할 수 없는 이유
+	Windows            []string   `json:"windows"`                         // 적용된 유지보수 시간대 (전역 포함)
+	OutsideWindow      string     `json:"outside_window"`                  // 시간대 밖 실행 요청 처리 (reject, defer)
+	OnClose            string     `json:"on_close"`                        // 실행 중 시간대가 닫힐 때 처리 (continue, pause, abort)
+	NextAllowedAt      *time.Time `json:"next_allowed_at,omitempty"`       // 다음 실행 가능 시각 (지금 실행할 수 있으면 조회 시각)
+	ClosesAt           *time.Time `json:"closes_at,omitempty"`             // 실행 가능 상태가 끝나는 시각
+	NextScheduledRunAt *time.Time `json:"next_scheduled_run_at,omitempty"` // 시간대를 반영한 다음 스케줄 실행 시각
+}
@@ -0,0 +1,57 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import "time"
+
+// Job 버전 prefix({transport_id}/{job_version}/)에 기록되는 완료 표시 객체 이름
+const (
+	// ManifestObjectName은 버전의 모든 객체 목록과 메타데이터를 담은 manifest 객체 이름입니다
+	ManifestObjectName = "manifest.json"
+	// SuccessMarkerName은 모든 테이블이 성공했을 때 manifest 다음에 기록되는 marker 이름입니다
+	SuccessMarkerName = "_SUCCESS"
+	// FailedMarkerName은 실패/취소 시 에러 요약과 함께 기록되는 marker 이름입니다
+	FailedMarkerName = "_FAILED"
+)
+
+// ObjectInfo는 GCS에 업로드된 단일 객체 정보를 나타냅니다
+type ObjectInfo struct {
+	Path              string `json:"path"`               // GCS URI (gs://bucket/...)
+	RowCount          int64  `json:"row_count"`          // 객체에 기록된 row 수
+	ByteCount         int64  `json:"byte_count"`         // 객체 크기 (압축 후)
+	UncompressedBytes int64  `json:"uncompressed_bytes"` // 압축 전 바이트 수
+	CRC32C            string `json:"crc32c"`             // CRC32C 체크섬 (GCS 메타데이터와 같은 base64 형식)
+}
+
+// Manifest는 Job 버전 prefix에 기록되는 추출 결과 요약입니다
+// 다운스트림 로더는 _SUCCESS marker를 확인한 뒤 manifest의 객체 목록으로 적재합니다
+type Manifest struct {
+	TransportID string          `json:"transport_id"`           // Transport ID
+	JobID       string          `json:"job_id"`                 // Job ID
+	JobVersion  string          `json:"job_version"`            // Job 버전 (v001, v002, ...)
+	Attempt     int             `json:"attempt"`                // 실행 시도 번호
+	Owner       string          `json:"owner"`                  // 추출 대상 스키마 소유자
+	Format      OutputFormat    `json:"format"`                 // 객체 출력 형식
+	SnapshotSCN uint64          `json:"snapshot_scn,omitempty"` // 일관된 스냅샷 조회 기준 SCN (0이면 미사용)
+	Success     bool            `json:"success"`                // 모든 테이블 추출 성공 여부
+	Error       string          `json:"error,omitempty"`        // 실패 요약
+	StartedAt   time.Time       `json:"started_at"`             // 실행 시작 시간
+	CompletedAt time.Time       `json:"completed_at"`           // 실행 종료 시간
+	TotalRows   int64           `json:"total_rows"`             // 총 row 수
+	TotalBytes  int64           `json:"total_bytes"`            // 총 바이트 수 (압축 후)
+	Tables      []ManifestTable `json:"tables"`                 // 테이블별 결과 (테이블 이름순)
+}
+
+// ManifestTable은 manifest의 테이블별 추출 결과입니다
+type ManifestTable struct {
+	TableName         string           `json:"table_name"`               // 테이블 이름
+	Status            ExtractionStatus `json:"status"`                   // 추출 상태 (completed/failed/cancelled)
+	RowCount          int64            `json:"row_count"`                // 총 row 수
+	ByteCount         int64            `json:"byte_count"`               // 총 바이트 수 (압축 후)
+	UncompressedBytes int64            `json:"uncompressed_bytes"`       // 압축 전 바이트 수
+	Objects           []ObjectInfo     `json:"objects"`                  // 업로드된 객체 (분할 추출 시 part 순서)
+	LOBObjects        []ObjectInfo     `json:"lob_objects,omitempty"`    // object 정책으로 업로드된 LOB 객체 (byte_count에 포함)
+	Columns           []ColumnInfo     `json:"columns,omitempty"`        // 컬럼 스키마
+	Watermark         *WatermarkRange  `json:"watermark,omitempty"`      // 증분 추출 범위
+	Reconciliation    *Reconciliation  `json:"reconciliation,omitempty"` // 원본/대상 row 수 대사 결과
+	Error             string           `json:"error,omitempty"`          // 에러 메시지
+}
@@ -0,0 +1,71 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import "fmt"
+
+// ReconcilePolicy는 추출 후 원본/대상 row 수 대사(reconciliation) 불일치 처리 정책입니다
+type ReconcilePolicy string
+
+const (
+	// ReconcilePolicyWarn은 불일치를 기록하고 경고 이벤트만 발송합니다 (기본값)
+	ReconcilePolicyWarn ReconcilePolicy = "warn"
+	// ReconcilePolicyFail은 불일치한 테이블을 실패로 처리합니다
+	ReconcilePolicyFail ReconcilePolicy = "fail"
+	// ReconcilePolicyOff는 대사를 수행하지 않습니다
+	ReconcilePolicyOff ReconcilePolicy = "off"
+)
+
+// Validate는 지원하는 대사 정책인지 검사합니다 (빈 값은 warn)
+func (p ReconcilePolicy) Validate() error {
+	switch p {
+	case "", ReconcilePolicyWarn, ReconcilePolicyFail, ReconcilePolicyOff:
+		return nil
+	}
+	return fmt.Errorf("지원하지 않는 reconcile_policy '%s' (warn, fail, off)", p)
+}
+
+// Enabled는 대사를 수행하는 정책인지 반환합니다
+func (p ReconcilePolicy) Enabled() bool {
+	return p != ReconcilePolicyOff
+}
+
+// Reconciliation은 테이블 추출 후 원본과 대상의 row 수 대사 결과입니다
+// 원본 row 수는 추출과 같은 SCN, watermark 하한, 분할 범위 조건의 SELECT COUNT(*)로 조회합니다
+type Reconciliation struct {
+	SourceRows   int64  `json:"source_rows"`     // Oracle SELECT COUNT(*) 결과
+	StreamedRows int64  `json:"streamed_rows"`   // Oracle에서 스트리밍된 row 수 (ChunkResult.TotalRowsSent)
+	EncodedRows  int64  `json:"encoded_rows"`    // 출력 형식으로 인코딩되어 GCS에 기록된 row 수
+	SCN          uint64 `json:"scn,omitempty"`   // 추출과 COUNT(*)의 조회 기준 SCN
+	Matched      bool   `json:"matched"`         // 세 row 수가 모두 일치하는지 여부
+	Error        string `json:"error,omitempty"` // 원본 row 수를 조회하지 못한 경우 에러 메시지
+}
+
+// NewReconciliation은 세 row 수를 비교한 대사 결과를 생성합니다
+func NewReconciliation(sourceRows, streamedRows, encodedRows int64, scn uint64) *Reconciliation {
+	return &Reconciliation{
+		SourceRows:   sourceRows,
+		StreamedRows: streamedRows,
+		EncodedRows:  encodedRows,
+		SCN:          scn,
+		Matched:      sourceRows == streamedRows && streamedRows == encodedRows,
+	}
+}
+
+// Merge는 분할 추출된 다른 범위의 대사 결과를 합산합니다
+func (r *Reconciliation) Merge(other *Reconciliation) {
+	r.SourceRows += other.SourceRows
+	r.StreamedRows += other.StreamedRows
+	r.EncodedRows += other.EncodedRows
+	r.Matched = r.Matched && other.Matched
+	if r.Error == "" {
+		r.Error = other.Error
+	}
+}
+
+// String은 대사 결과 요약을 반환합니다
+func (r *Reconciliation) String() string {
+	if r.Error != "" {
+		return fmt.Sprintf("원본 row 수 조회 실패 (streamed=%d, encoded=%d): %s", r.StreamedRows, r.EncodedRows, r.Error)
+	}
+	return fmt.Sprintf("source=%d, streamed=%d, encoded=%d", r.SourceRows, r.StreamedRows, r.EncodedRows)
+}
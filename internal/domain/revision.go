@@ -0,0 +1,321 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RevisionAction은 Transport 리비전이 생성된 원인입니다
+type RevisionAction string
+
+const (
+	// RevisionActionCreate는 Transport 생성 시의 최초 리비전입니다
+	RevisionActionCreate RevisionAction = "create"
+	// RevisionActionUpdate는 PUT/PATCH로 정의를 수정한 리비전입니다
+	RevisionActionUpdate RevisionAction = "update"
+	// RevisionActionRollback은 이전 리비전의 정의로 되돌린 리비전입니다
+	RevisionActionRollback RevisionAction = "rollback"
+)
+
+// TransportDefinition은 사용자가 정의하는 Transport 구성입니다
+// 상태, 스케줄 실행 기록 같은 실행 시점 정보는 포함하지 않으며 정의가 바뀔 때마다 리비전으로 기록됩니다
+type TransportDefinition struct {
+	Name               string                  `json:"name"`
+	Description        string                  `json:"description,omitempty"`
+	Tables             []string                `json:"tables"`                        // 전체 추출할 테이블 목록 (sources 이름 제외)
+	Sources            []Source                `json:"sources,omitempty"`             // 컬럼/조건 지정 테이블 또는 SQL 추출 원본
+	Enabled            bool                    `json:"enabled"`                       // 활성화 여부
+	Schedule           *CronSchedule           `json:"schedule,omitempty"`            // cron 스케줄 (expression, timezone)
+	TableOptions       map[string]TableOptions `json:"table_options,omitempty"`       // 테이블별 추출 설정
+	ConsistentSnapshot bool                    `json:"consistent_snapshot,omitempty"` // Job의 모든 테이블을 같은 SCN 시점으로 추출
+	OutputFormat       OutputFormat            `json:"output_format,omitempty"`       // GCS 객체 형식
+	ReconcilePolicy    ReconcilePolicy         `json:"reconcile_policy,omitempty"`    // row 수 대사 불일치 처리 정책
+	Maintenance        *MaintenancePolicy      `json:"maintenance,omitempty"`         // 유지보수 시간대 정책
+}
+
+// Clone은 슬라이스와 맵을 포함한 깊은 복사본을 반환합니다
+func (d TransportDefinition) Clone() TransportDefinition {
+	copied := d
+	if d.Tables != nil {
+		copied.Tables = append([]string(nil), d.Tables...)
+	}
+	if d.Sources != nil {
+		copied.Sources = make([]Source, len(d.Sources))
+		for i, src := range d.Sources {
+			copied.Sources[i] = src.Clone()
+		}
+	}
+	if d.Schedule != nil {
+		schedule := *d.Schedule
+		copied.Schedule = &schedule
+	}
+	if d.TableOptions != nil {
+		copied.TableOptions = make(map[string]TableOptions, len(d.TableOptions))
+		for table, opts := range d.TableOptions {
+			copied.TableOptions[table] = opts.Clone()
+		}
+	}
+	copied.Maintenance = d.Maintenance.Clone()
+	return copied
+}
+
+// Definition은 Transport의 현재 정의를 깊은 복사하여 반환합니다
+func (t *Transport) Definition() TransportDefinition {
+	c := t.Clone()
+	sources := c.SourceMap()
+	tables := make([]string, 0, len(c.Tables))
+	for _, name := range c.Tables {
+		if _, ok := sources[name]; !ok {
+			tables = append(tables, name)
+		}
+	}
+
+	def := TransportDefinition{
+		Name:               c.Name,
+		Description:        c.Description,
+		Tables:             tables,
+		Sources:            c.Sources,
+		Enabled:            c.Enabled,
+		TableOptions:       c.TableOptions,
+		ConsistentSnapshot: c.ConsistentSnapshot,
+		OutputFormat:       c.OutputFormat,
+		ReconcilePolicy:    c.ReconcilePolicy,
+		Maintenance:        c.Maintenance,
+	}
+	if c.Schedule != nil {
+		def.Schedule = &CronSchedule{Expression: c.Schedule.Expression, Timezone: c.Schedule.Timezone}
+	}
+	return def
+}
+
+// ApplyDefinition은 Transport의 정의를 def로 교체합니다
+// 상태와 스케줄의 마지막 실행 기록(LastFiredAt)은 유지합니다
+func (t *Transport) ApplyDefinition(def TransportDefinition) {
+	req := def.Request()
+	t.Name = def.Name
+	t.Description = def.Description
+	t.Tables = req.SourceNames()
+	t.Enabled = def.Enabled
+	t.ConsistentSnapshot = def.ConsistentSnapshot
+	t.OutputFormat = def.OutputFormat
+	t.ReconcilePolicy = def.ReconcilePolicy
+	t.Maintenance = def.Maintenance.Clone()
+
+	t.Sources = nil
+	if len(def.Sources) > 0 {
+		t.Sources = make([]Source, len(def.Sources))
+		for i, src := range def.Sources {
+			t.Sources[i] = src.Clone()
+		}
+	}
+	t.TableOptions = nil
+	if len(def.TableOptions) > 0 {
+		t.TableOptions = make(map[string]TableOptions, len(def.TableOptions))
+		for table, opts := range def.TableOptions {
+			t.TableOptions[table] = opts.Clone()
+		}
+	}
+
+	if def.Schedule == nil {
+		t.Schedule = nil
+		return
+	}
+	var lastFired *time.Time
+	if t.Schedule != nil {
+		lastFired = t.Schedule.LastFiredAt
+	}
+	t.Schedule = &CronSchedule{
+		Expression:  def.Schedule.Expression,
+		Timezone:    def.Schedule.Timezone,
+		LastFiredAt: lastFired,
+	}
+}
+
+// Request는 정의를 생성 요청 형식으로 변환합니다 (유효성 검사 재사용)
+func (d TransportDefinition) Request() CreateTransportRequest {
+	return CreateTransportRequest{
+		Name:               d.Name,
+		Description:        d.Description,
+		Tables:             d.Tables,
+		Sources:            d.Sources,
+		Schedule:           d.Schedule,
+		TableOptions:       d.TableOptions,
+		ConsistentSnapshot: d.ConsistentSnapshot,
+		OutputFormat:       d.OutputFormat,
+		ReconcilePolicy:    d.ReconcilePolicy,
+		Maintenance:        d.Maintenance,
+	}
+}
+
+// MergePatch는 정의에 JSON Merge Patch(RFC 7386)를 적용한 수정 요청을 반환합니다
+// 패치의 null은 해당 필드를 제거(기본값으로 변경)하며, 알 수 없는 필드는 에러입니다
+func (d TransportDefinition) MergePatch(patch []byte) (UpdateTransportRequest, error) {
+	var req UpdateTransportRequest
+
+	current, err := json.Marshal(d)
+	if err != nil {
+		return req, fmt.Errorf("transport 정의 인코딩 실패: %w", err)
+	}
+	var doc, p interface{}
+	if err := decodeJSONNumber(current, &doc); err != nil {
+		return req, err
+	}
+	if err := decodeJSONNumber(patch, &p); err != nil {
+		return req, fmt.Errorf("patch를 파싱할 수 없습니다: %w", err)
+	}
+	if _, ok := p.(map[string]interface{}); !ok {
+		return req, fmt.Errorf("patch는 JSON 객체여야 합니다")
+	}
+
+	merged, err := json.Marshal(mergePatch(doc, p))
+	if err != nil {
+		return req, fmt.Errorf("patch 적용 결과 인코딩 실패: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(merged))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		return req, fmt.Errorf("patch를 적용할 수 없습니다: %w", err)
+	}
+	return req, nil
+}
+
+// decodeJSONNumber는 숫자 정밀도를 유지하도록 json.Number로 디코딩합니다
+func decodeJSONNumber(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// mergePatch는 RFC 7386 병합 규칙으로 patch를 target에 적용합니다
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+	return targetObj
+}
+
+// UpdateTransportRequest는 Transport 정의 교체(PUT) 요청 DTO입니다
+// enabled를 생략하면 현재 활성화 여부를 유지합니다
+type UpdateTransportRequest struct {
+	CreateTransportRequest
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// Definition은 요청을 Transport 정의로 변환합니다 (enabled가 없으면 current 값 사용)
+func (r *UpdateTransportRequest) Definition(current bool) TransportDefinition {
+	enabled := current
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return TransportDefinition{
+		Name:               r.Name,
+		Description:        r.Description,
+		Tables:             r.Tables,
+		Sources:            r.Sources,
+		Enabled:            enabled,
+		Schedule:           r.Schedule,
+		TableOptions:       r.TableOptions,
+		ConsistentSnapshot: r.ConsistentSnapshot,
+		OutputFormat:       r.OutputFormat,
+		ReconcilePolicy:    r.ReconcilePolicy,
+		Maintenance:        r.Maintenance,
+	}
+}
+
+// TransportRevision은 Transport 정의의 변경 이력 한 건입니다
+type TransportRevision struct {
+	TransportID    string              `json:"transport_id"`              // Transport ID
+	Revision       int                 `json:"revision"`                  // 리비전 번호 (생성 시 1, 변경마다 증가)
+	Action         RevisionAction      `json:"action"`                    // 생성 원인 (create, update, rollback)
+	SourceRevision int                 `json:"source_revision,omitempty"` // rollback으로 복원한 리비전 번호
+	Definition     TransportDefinition `json:"definition"`                // 이 리비전의 정의
+	CreatedAt      time.Time           `json:"created_at"`                // 기록 시간
+}
+
+// Clone은 정의를 깊은 복사한 리비전을 반환합니다
+func (r TransportRevision) Clone() TransportRevision {
+	r.Definition = r.Definition.Clone()
+	return r
+}
+
+// NewTransportRevision은 Transport의 현재 정의로 리비전을 생성합니다
+func NewTransportRevision(t *Transport, action RevisionAction) *TransportRevision {
+	return &TransportRevision{
+		TransportID: t.ID,
+		Revision:    t.Revision,
+		Action:      action,
+		Definition:  t.Definition(),
+		CreatedAt:   t.UpdatedAt,
+	}
+}
+
+// DefinitionChange는 두 정의 사이에서 값이 달라진 필드입니다
+type DefinitionChange struct {
+	Field string          `json:"field"`          // 필드 이름 (JSON 키)
+	From  json.RawMessage `json:"from,omitempty"` // 이전 값 (없으면 생략)
+	To    json.RawMessage `json:"to,omitempty"`   // 이후 값 (없으면 생략)
+}
+
+// DiffDefinitions는 from에서 to로 바뀐 최상위 필드를 이름순으로 반환합니다
+func DiffDefinitions(from, to TransportDefinition) []DefinitionChange {
+	fromFields := definitionFields(from)
+	toFields := definitionFields(to)
+
+	keys := make([]string, 0, len(fromFields)+len(toFields))
+	for key := range fromFields {
+		keys = append(keys, key)
+	}
+	for key := range toFields {
+		if _, ok := fromFields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := make([]DefinitionChange, 0)
+	for _, key := range keys {
+		if !bytes.Equal(fromFields[key], toFields[key]) {
+			changes = append(changes, DefinitionChange{Field: key, From: fromFields[key], To: toFields[key]})
+		}
+	}
+	return changes
+}
+
+// definitionFields는 정의를 JSON 키별 값으로 분해합니다
+// map은 키 순서대로 인코딩되므로 같은 값이면 같은 바이트열이 됩니다
+func definitionFields(def TransportDefinition) map[string]json.RawMessage {
+	data, _ := json.Marshal(def)
+	fields := make(map[string]json.RawMessage)
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// TransportRevisionListResponse는 Transport 리비전 목록 응답입니다
+type TransportRevisionListResponse struct {
+	TransportID     string              `json:"transport_id"`
+	CurrentRevision int                 `json:"current_revision"`
+	Revisions       []TransportRevision `json:"revisions"` // 최신 리비전부터
+}
+
+// TransportRevisionDiff는 두 리비전 사이의 정의 변경 내역입니다
+type TransportRevisionDiff struct {
+	TransportID  string             `json:"transport_id"`
+	FromRevision int                `json:"from_revision"`
+	ToRevision   int                `json:"to_revision"`
+	Changes      []DefinitionChange `json:"changes"`
+}
@@ -0,0 +1,216 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SourceKind는 추출 원본의 종류입니다
+type SourceKind string
+
+const (
+	// SourceKindTable은 테이블(선택적으로 컬럼 목록과 WHERE 조건 포함)을 추출하는 원본입니다
+	SourceKindTable SourceKind = "table"
+	// SourceKindQuery는 바인드 변수를 사용하는 사용자 정의 SELECT 문을 추출하는 원본입니다
+	SourceKindQuery SourceKind = "query"
+)
+
+// selectStatementPattern은 사용자 정의 SQL로 허용하는 조회문의 시작 형식입니다
+var selectStatementPattern = regexp.MustCompile(`(?i)^\s*(SELECT|WITH)\b`)
+
+// ReservedBindPrefix는 추출 쿼리가 SCN, 분할 범위, watermark 조건에 사용하는 바인드 변수 이름 접두사입니다
+const ReservedBindPrefix = "etl_"
+
+// Source는 Transport의 추출 원본 정의입니다
+// Name은 출력 객체 이름({name}.jsonl.gz)과 Extraction/manifest의 table_name으로 사용되며,
+// Table과 SQL 중 하나만 지정합니다
+type Source struct {
+	Name    string                 `json:"name"`              // 원본 이름 (출력 객체 이름)
+	Table   string                 `json:"table,omitempty"`   // 원본 테이블 (table 원본)
+	Columns []string               `json:"columns,omitempty"` // 조회할 컬럼 목록 (table 원본, 비어있으면 전체)
+	Where   string                 `json:"where,omitempty"`   // WHERE 조건 (table 원본, 바인드 변수 사용 가능)
+	SQL     string                 `json:"sql,omitempty"`     // SELECT 문 (query 원본, 세미콜론 없이)
+	Binds   map[string]interface{} `json:"binds,omitempty"`   // 이름 있는 바인드 변수 값 (:name)
+}
+
+// Kind는 원본의 종류를 반환합니다
+func (s Source) Kind() SourceKind {
+	if s.SQL != "" {
+		return SourceKindQuery
+	}
+	return SourceKindTable
+}
+
+// Validate는 원본 정의의 유효성을 검사합니다
+// SQL 구문과 객체 존재 여부는 Oracle에서 별도로 검증합니다 (DBMS_SQL.PARSE)
+func (s Source) Validate() error {
+	if err := ValidateIdentifier(s.Name); err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+
+	switch {
+	case s.Table == "" && s.SQL == "":
+		return fmt.Errorf("table 또는 sql 중 하나는 필수입니다")
+	case s.Table != "" && s.SQL != "":
+		return fmt.Errorf("table과 sql은 함께 지정할 수 없습니다")
+	}
+
+	if s.Kind() == SourceKindQuery {
+		if len(s.Columns) > 0 || s.Where != "" {
+			return fmt.Errorf("columns와 where는 table 원본에만 지정할 수 있습니다")
+		}
+		if err := validateSelectStatement(s.SQL); err != nil {
+			return fmt.Errorf("sql: %w", err)
+		}
+	} else {
+		if err := ValidateIdentifier(s.Table); err != nil {
+			return fmt.Errorf("table: %w", err)
+		}
+		seen := make(map[string]bool, len(s.Columns))
+		for _, col := range s.Columns {
+			if err := ValidateIdentifier(col); err != nil {
+				return fmt.Errorf("columns: %w", err)
+			}
+			if seen[strings.ToUpper(col)] {
+				return fmt.Errorf("columns: 중복된 컬럼 %s", col)
+			}
+			seen[strings.ToUpper(col)] = true
+		}
+		if err := validateWhereCondition(s.Where); err != nil {
+			return fmt.Errorf("where: %w", err)
+		}
+		if len(s.Binds) > 0 && s.Where == "" {
+			return fmt.Errorf("binds는 where 또는 sql과 함께 사용해야 합니다")
+		}
+	}
+
+	for name, value := range s.Binds {
+		if err := ValidateIdentifier(name); err != nil {
+			return fmt.Errorf("binds: %w", err)
+		}
+		if strings.HasPrefix(strings.ToLower(name), ReservedBindPrefix) {
+			return fmt.Errorf("binds: %s 접두사는 예약된 바인드 변수 이름입니다 (%s)", ReservedBindPrefix, name)
+		}
+		switch value.(type) {
+		case nil, string, bool, float64, int, int64:
+		default:
+			return fmt.Errorf("binds: %s의 값은 문자열, 숫자, boolean 또는 null이어야 합니다", name)
+		}
+	}
+	return nil
+}
+
+// Clone은 Columns와 Binds를 포함한 깊은 복사본을 반환합니다
+func (s Source) Clone() Source {
+	copied := s
+	if s.Columns != nil {
+		copied.Columns = append([]string(nil), s.Columns...)
+	}
+	if s.Binds != nil {
+		copied.Binds = make(map[string]interface{}, len(s.Binds))
+		for name, value := range s.Binds {
+			copied.Binds[name] = value
+		}
+	}
+	return copied
+}
+
+// validateSelectStatement는 사용자 정의 SQL이 단일 SELECT 문인지 검사합니다
+// DBMS_SQL.PARSE는 DDL을 즉시 실행하므로 구문 검증 전에 반드시 확인합니다
+func validateSelectStatement(sql string) error {
+	if strings.Contains(sql, ";") {
+		return fmt.Errorf("세미콜론을 사용할 수 없습니다 (단일 SELECT 문만 허용)")
+	}
+	if !selectStatementPattern.MatchString(sql) {
+		return fmt.Errorf("SELECT 또는 WITH로 시작하는 조회문만 허용됩니다")
+	}
+	return nil
+}
+
+// validateWhereCondition은 table 원본의 WHERE 조건이 하나의 조건식으로 괄호 안에 머무는지 검사합니다
+// 추출 쿼리는 조건을 "(where)"로 감싸 다른 조건과 AND로 결합하므로, 문자열 리터럴 밖에서 괄호를 먼저 닫거나
+// 주석으로 뒷부분을 무시하게 하는 조건은 쿼리 구조를 바꿀 수 있어 구문 검증 전에 거부합니다
+func validateWhereCondition(where string) error {
+	if strings.Contains(where, ";") {
+		return fmt.Errorf("세미콜론을 사용할 수 없습니다")
+	}
+
+	depth := 0
+	for i := 0; i < len(where); i++ {
+		switch c := where[i]; c {
+		case '\'', '"':
+			if c == '\'' && isAlternativeQuote(where[:i]) {
+				return fmt.Errorf("q'...' 대체 인용 문자열은 사용할 수 없습니다")
+			}
+			// 인용 구간의 끝으로 이동 (같은 따옴표 두 개는 이스케이프)
+			end := -1
+			for j := i + 1; j < len(where); j++ {
+				if where[j] != c {
+					continue
+				}
+				if j+1 < len(where) && where[j+1] == c {
+					j++
+					continue
+				}
+				end = j
+				break
+			}
+			if end < 0 {
+				return fmt.Errorf("닫히지 않은 인용 부호가 있습니다")
+			}
+			i = end
+		case '-', '/':
+			if i+1 < len(where) && ((c == '-' && where[i+1] == '-') || (c == '/' && where[i+1] == '*')) {
+				return fmt.Errorf("주석을 사용할 수 없습니다")
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("짝이 맞지 않는 닫는 괄호가 있습니다")
+			}
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("닫히지 않은 괄호가 있습니다")
+	}
+	return nil
+}
+
+// isAlternativeQuote는 작은따옴표 바로 앞의 토큰이 대체 인용 접두사(q, nq)인지 확인합니다
+func isAlternativeQuote(prefix string) bool {
+	start := len(prefix)
+	for start > 0 && isIdentifierByte(prefix[start-1]) {
+		start--
+	}
+	token := strings.ToUpper(prefix[start:])
+	return token == "Q" || token == "NQ"
+}
+
+// isIdentifierByte는 인용하지 않은 Oracle 식별자에 쓰일 수 있는 문자인지 확인합니다
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || c == '#' ||
+		(c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// validateSources는 원본 정의와 이름 중복을 검사합니다
+// 원본 이름은 tables의 테이블 이름과도 겹칠 수 없습니다
+func validateSources(tables []string, sources []Source) error {
+	names := make(map[string]bool, len(tables)+len(sources))
+	for _, t := range tables {
+		names[t] = true
+	}
+	for i, src := range sources {
+		if err := src.Validate(); err != nil {
+			return fmt.Errorf("sources[%d]: %w", i, err)
+		}
+		if names[src.Name] {
+			return fmt.Errorf("sources[%d]: 이름 %s이(가) 다른 테이블 또는 원본과 중복됩니다", i, src.Name)
+		}
+		names[src.Name] = true
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import "fmt"
+
+// 테이블 분할 기본값
+const (
+	DefaultSplitRowsPerRange = 10_000_000 // 범위당 목표 row 수
+	DefaultSplitMaxRanges    = 32         // 테이블당 최대 범위 수
+)
+
+// SplitOptions는 대용량 테이블 분할 추출 설정입니다
+type SplitOptions struct {
+	MinRows      int64 // 분할 대상 최소 row 수 (통계 기준, 0 이하이면 분할하지 않음)
+	RowsPerRange int64 // 범위당 목표 row 수
+	MaxRanges    int   // 테이블당 최대 범위 수
+}
+
+// Enabled는 분할 추출이 활성화되었는지 반환합니다
+func (o SplitOptions) Enabled() bool {
+	return o.MinRows > 0
+}
+
+// RangeCount는 row 수에 따른 범위 수를 계산합니다 (1이면 분할하지 않음)
+func (o SplitOptions) RangeCount(numRows int64) int {
+	if !o.Enabled() || numRows < o.MinRows {
+		return 1
+	}
+
+	perRange := o.RowsPerRange
+	if perRange <= 0 {
+		perRange = DefaultSplitRowsPerRange
+	}
+	maxRanges := o.MaxRanges
+	if maxRanges <= 0 {
+		maxRanges = DefaultSplitMaxRanges
+	}
+
+	n := (numRows + perRange - 1) / perRange
+	if n > int64(maxRanges) {
+		return maxRanges
+	}
+	if n < 1 {
+		return 1
+	}
+	return int(n)
+}
+
+// TableRange는 테이블을 나누어 추출하기 위한 범위입니다
+// 파티션 단위(Partition) 또는 ROWID 범위(StartRowID~EndRowID) 중 하나로 지정됩니다
+type TableRange struct {
+	Index      int    `json:"index"`                 // 범위 순번 (0부터, GCS part 번호)
+	Partition  string `json:"partition,omitempty"`   // 파티션 이름 (파티션 단위 분할)
+	StartRowID string `json:"start_rowid,omitempty"` // 시작 ROWID (포함)
+	EndRowID   string `json:"end_rowid,omitempty"`   // 종료 ROWID (포함)
+}
+
+// String은 로그/에러 메시지용 범위 표현을 반환합니다
+func (r TableRange) String() string {
+	if r.Partition != "" {
+		return fmt.Sprintf("part %d (partition %s)", r.Index, r.Partition)
+	}
+	return fmt.Sprintf("part %d (rowid %s..%s)", r.Index, r.StartRowID, r.EndRowID)
+}
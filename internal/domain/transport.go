@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"oracle-etl/pkg/cron"
 )
 
 // TransportStatus는 Transport의 상태를 나타냅니다
@@ -19,23 +21,107 @@ const (
 	TransportStatusFailed TransportStatus = "failed"
 )
 
+// DefaultScheduleTimezone은 시간대가 지정되지 않은 스케줄의 기본 시간대입니다
+const DefaultScheduleTimezone = "Asia/Seoul"
+
+// OutputFormat은 추출된 테이블의 GCS 객체 형식입니다
+type OutputFormat string
+
+const (
+	// OutputFormatJSONL은 gzip 압축된 JSON Lines 형식입니다 (기본값)
+	OutputFormatJSONL OutputFormat = "jsonl"
+	// OutputFormatParquet은 Oracle 컬럼 타입을 보존하는 Parquet 형식입니다
+	OutputFormatParquet OutputFormat = "parquet"
+)
+
+// Validate는 지원하는 출력 형식인지 검사합니다 (빈 값은 JSONL)
+func (f OutputFormat) Validate() error {
+	switch f {
+	case "", OutputFormatJSONL, OutputFormatParquet:
+		return nil
+	}
+	return fmt.Errorf("지원하지 않는 output_format '%s' (jsonl, parquet)", f)
+}
+
+// Extension은 출력 형식의 객체 확장자를 반환합니다
+func (f OutputFormat) Extension() string {
+	if f == OutputFormatParquet {
+		return ".parquet"
+	}
+	return ".jsonl.gz"
+}
+
 // CronSchedule은 스케줄 설정을 나타냅니다
 type CronSchedule struct {
-	Expression string `json:"expression"` // cron 표현식
-	Timezone   string `json:"timezone"`   // 시간대 (예: "Asia/Seoul")
+	Expression  string     `json:"expression"`              // cron 표현식
+	Timezone    string     `json:"timezone"`                // 시간대 (예: "Asia/Seoul")
+	LastFiredAt *time.Time `json:"last_fired_at,omitempty"` // 스케줄러가 마지막으로 실행한 예정 시각
+	NextRunAt   *time.Time `json:"next_run_at,omitempty"`   // 다음 실행 예정 시각 (조회 시 계산)
+	PrevRunAt   *time.Time `json:"prev_run_at,omitempty"`   // 직전 실행 예정 시각 (조회 시 계산)
+}
+
+// Validate는 cron 표현식과 시간대의 유효성을 검사합니다
+func (s *CronSchedule) Validate() error {
+	_, _, err := s.Parse()
+	return err
+}
+
+// Parse는 cron 표현식과 시간대를 파싱합니다
+// 시간대가 비어있으면 DefaultScheduleTimezone을 사용합니다
+func (s *CronSchedule) Parse() (*cron.Schedule, *time.Location, error) {
+	schedule, err := cron.Parse(s.Expression)
+	if err != nil {
+		return nil, nil, fmt.Errorf("잘못된 cron 표현식: %w", err)
+	}
+
+	tz := s.Timezone
+	if tz == "" {
+		tz = DefaultScheduleTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, nil, fmt.Errorf("잘못된 시간대 '%s': %w", tz, err)
+	}
+
+	return schedule, loc, nil
+}
+
+// RefreshRunTimes는 now 기준 다음/직전 실행 예정 시각을 계산합니다
+func (s *CronSchedule) RefreshRunTimes(now time.Time) {
+	schedule, loc, err := s.Parse()
+	if err != nil {
+		s.NextRunAt, s.PrevRunAt = nil, nil
+		return
+	}
+
+	local := now.In(loc)
+	if next := schedule.Next(local); !next.IsZero() {
+		s.NextRunAt = &next
+	}
+	if prev := schedule.Prev(local); !prev.IsZero() {
+		s.PrevRunAt = &prev
+	}
 }
 
 // Transport는 ETL 전송 구성을 나타냅니다
 type Transport struct {
-	ID          string          `json:"id"`                    // TRPID-xxx 형식
-	Name        string          `json:"name"`                  // Transport 이름
-	Description string          `json:"description,omitempty"` // 설명
-	Tables      []string        `json:"tables"`                // 대상 테이블 목록
-	Enabled     bool            `json:"enabled"`               // 활성화 여부
-	Schedule    *CronSchedule   `json:"schedule,omitempty"`    // 선택적 cron 스케줄
-	Status      TransportStatus `json:"status"`                // 현재 상태
-	CreatedAt   time.Time       `json:"created_at"`            // 생성 시간
-	UpdatedAt   time.Time       `json:"updated_at"`            // 수정 시간
+	ID                 string                  `json:"id"`                            // TRPID-xxx 형식
+	Name               string                  `json:"name"`                          // Transport 이름
+	Description        string                  `json:"description,omitempty"`         // 설명
+	Tables             []string                `json:"tables"`                        // 대상 테이블 및 원본 이름 목록 (실행 순서)
+	Sources            []Source                `json:"sources,omitempty"`             // 테이블 전체가 아닌 추출 원본 정의 (이름은 tables에 포함)
+	Enabled            bool                    `json:"enabled"`                       // 활성화 여부
+	Schedule           *CronSchedule           `json:"schedule,omitempty"`            // 선택적 cron 스케줄
+	TableOptions       map[string]TableOptions `json:"table_options,omitempty"`       // 테이블별 추출 설정
+	ConsistentSnapshot bool                    `json:"consistent_snapshot,omitempty"` // Job 시작 시점 SCN으로 모든 테이블 조회 (AS OF SCN)
+	OutputFormat       OutputFormat            `json:"output_format,omitempty"`       // GCS 객체 형식 (빈 값이면 jsonl)
+	ReconcilePolicy    ReconcilePolicy         `json:"reconcile_policy,omitempty"`    // row 수 대사 불일치 처리 정책 (빈 값이면 warn)
+	Maintenance        *MaintenancePolicy      `json:"maintenance,omitempty"`         // 유지보수 시간대 정책 (없으면 전역 시간대와 전역 처리 방식)
+	ExecutionWindow    *ExecutionWindow        `json:"execution_window,omitempty"`    // 유지보수 시간대 기준 실행 가능 상태 (조회 시 계산, 적용된 시간대가 없으면 생략)
+	Status             TransportStatus         `json:"status"`                        // 현재 상태
+	Revision           int                     `json:"revision"`                      // 현재 정의의 리비전 번호 (생성 시 1)
+	CreatedAt          time.Time               `json:"created_at"`                    // 생성 시간
+	UpdatedAt          time.Time               `json:"updated_at"`                    // 수정 시간
 }
 
 // GenerateTransportID는 새로운 Transport ID를 생성합니다
@@ -59,6 +145,7 @@ func NewTransport(id, name, description string, tables []string) *Transport {
 		Tables:      tables,
 		Enabled:     true,
 		Status:      TransportStatusIdle,
+		Revision:    1,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
@@ -78,6 +165,84 @@ func (t *Transport) Validate() error {
 	return nil
 }
 
+// Clone은 Schedule과 Tables를 포함한 깊은 복사본을 반환합니다
+func (t *Transport) Clone() *Transport {
+	copied := *t
+	if t.Tables != nil {
+		copied.Tables = append([]string(nil), t.Tables...)
+	}
+	if t.Schedule != nil {
+		schedule := *t.Schedule
+		copied.Schedule = &schedule
+	}
+	if t.TableOptions != nil {
+		copied.TableOptions = make(map[string]TableOptions, len(t.TableOptions))
+		for table, opts := range t.TableOptions {
+			copied.TableOptions[table] = opts.Clone()
+		}
+	}
+	copied.Maintenance = t.Maintenance.Clone()
+	if t.ExecutionWindow != nil {
+		window := *t.ExecutionWindow
+		copied.ExecutionWindow = &window
+	}
+	if t.Sources != nil {
+		copied.Sources = make([]Source, len(t.Sources))
+		for i, src := range t.Sources {
+			copied.Sources[i] = src.Clone()
+		}
+	}
+	return &copied
+}
+
+// SourceMap은 원본 이름별 추출 원본 정의를 반환합니다
+// 정의가 없는 tables 항목은 같은 이름의 테이블 전체를 추출합니다
+func (t *Transport) SourceMap() map[string]Source {
+	if len(t.Sources) == 0 {
+		return nil
+	}
+	sources := make(map[string]Source, len(t.Sources))
+	for _, src := range t.Sources {
+		sources[src.Name] = src.Clone()
+	}
+	return sources
+}
+
+// WatermarkColumn은 테이블의 증분 추출 기준 컬럼을 반환합니다 (없으면 빈 문자열)
+func (t *Transport) WatermarkColumn(tableName string) string {
+	return t.TableOptions[tableName].WatermarkColumn
+}
+
+// ColumnFilters는 컬럼 필터가 설정된 테이블별 필터를 반환합니다 (없으면 nil)
+func (t *Transport) ColumnFilters() map[string]ColumnFilter {
+	var filters map[string]ColumnFilter
+	for table, opts := range t.TableOptions {
+		if opts.ColumnFilter.Empty() {
+			continue
+		}
+		if filters == nil {
+			filters = make(map[string]ColumnFilter)
+		}
+		filters[table] = opts.ColumnFilter.Clone()
+	}
+	return filters
+}
+
+// LOBPolicies는 LOB 정책이 설정된 테이블별 정책을 반환합니다 (없으면 nil)
+func (t *Transport) LOBPolicies() map[string]LOBPolicies {
+	var policies map[string]LOBPolicies
+	for table, opts := range t.TableOptions {
+		if len(opts.LOBColumns) == 0 {
+			continue
+		}
+		if policies == nil {
+			policies = make(map[string]LOBPolicies)
+		}
+		policies[table] = opts.LOBColumns.Clone()
+	}
+	return policies
+}
+
 // CanExecute는 Transport가 실행 가능한지 확인합니다
 func (t *Transport) CanExecute() bool {
 	return t.Enabled && t.Status != TransportStatusRunning
@@ -85,9 +250,16 @@ func (t *Transport) CanExecute() bool {
 
 // CreateTransportRequest는 Transport 생성 요청 DTO입니다
 type CreateTransportRequest struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description,omitempty"`
-	Tables      []string `json:"tables"`
+	Name               string                  `json:"name"`
+	Description        string                  `json:"description,omitempty"`
+	Tables             []string                `json:"tables"`                        // 전체 추출할 테이블 목록
+	Sources            []Source                `json:"sources,omitempty"`             // 컬럼/조건 지정 테이블 또는 SQL 추출 원본
+	Schedule           *CronSchedule           `json:"schedule,omitempty"`            // 선택적 cron 스케줄
+	TableOptions       map[string]TableOptions `json:"table_options,omitempty"`       // 테이블별 추출 설정
+	ConsistentSnapshot bool                    `json:"consistent_snapshot,omitempty"` // Job의 모든 테이블을 같은 SCN 시점으로 추출
+	OutputFormat       OutputFormat            `json:"output_format,omitempty"`       // GCS 객체 형식 (jsonl, parquet)
+	ReconcilePolicy    ReconcilePolicy         `json:"reconcile_policy,omitempty"`    // row 수 대사 불일치 처리 정책 (warn, fail, off)
+	Maintenance        *MaintenancePolicy      `json:"maintenance,omitempty"`         // 유지보수 시간대 정책 (windows, outside_window, on_close)
 }
 
 // Validate는 요청의 유효성을 검사합니다
@@ -95,12 +267,49 @@ func (r *CreateTransportRequest) Validate() error {
 	if r.Name == "" {
 		return fmt.Errorf("name은 필수입니다")
 	}
-	if len(r.Tables) == 0 {
-		return fmt.Errorf("tables는 최소 1개 이상이어야 합니다")
+	if len(r.Tables) == 0 && len(r.Sources) == 0 {
+		return fmt.Errorf("tables 또는 sources는 최소 1개 이상이어야 합니다")
+	}
+	if r.Schedule != nil {
+		if err := r.Schedule.Validate(); err != nil {
+			return fmt.Errorf("schedule이 유효하지 않습니다: %w", err)
+		}
+	}
+	if err := validateSources(r.Tables, r.Sources); err != nil {
+		return err
+	}
+	if err := validateTableOptions(r.SourceNames(), r.TableOptions); err != nil {
+		return err
+	}
+	if err := r.OutputFormat.Validate(); err != nil {
+		return err
+	}
+	if err := r.ReconcilePolicy.Validate(); err != nil {
+		return err
+	}
+	if r.Maintenance != nil {
+		if err := r.Maintenance.Validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// SourceNames는 Transport의 실행 목록(tables 다음에 sources 이름 순)을 반환합니다
+func (r *CreateTransportRequest) SourceNames() []string {
+	names := make([]string, 0, len(r.Tables)+len(r.Sources))
+	names = append(names, r.Tables...)
+	for _, src := range r.Sources {
+		names = append(names, src.Name)
+	}
+	return names
+}
+
+// ExecuteTransportRequest는 Transport 실행 요청 DTO입니다 (본문 생략 가능)
+type ExecuteTransportRequest struct {
+	FullReload bool `json:"full_reload,omitempty"` // true면 watermark를 무시하고 전체 추출
+}
+
 // TransportListResponse는 Transport 목록 응답입니다
 type TransportListResponse struct {
 	Transports []Transport `json:"transports"`
@@ -0,0 +1,138 @@
+// Package domain은 ETL 파이프라인의 핵심 도메인 모델을 정의합니다.
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// identifierPattern은 SQL에 직접 삽입되는 Oracle 식별자(컬럼명 등)의 허용 형식입니다
+var identifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]{0,127}$`)
+
+// ValidateIdentifier는 Oracle 식별자 형식을 검사합니다
+func ValidateIdentifier(name string) error {
+	if !identifierPattern.MatchString(name) {
+		return fmt.Errorf("잘못된 식별자: %q", name)
+	}
+	return nil
+}
+
+// TableOptions는 Transport의 테이블별 추출 설정입니다
+type TableOptions struct {
+	WatermarkColumn string      `json:"watermark_column,omitempty"` // 증분 추출 기준 DATE/TIMESTAMP 컬럼 (예: LAST_UPDATE_DATE)
+	LOBColumns      LOBPolicies `json:"lob_columns,omitempty"`      // LOB 컬럼별 출력 방식 (없으면 CLOB은 text, BLOB은 base64)
+	ColumnFilter                // 추출할 컬럼의 포함/제외 패턴
+}
+
+// Validate는 테이블 옵션의 유효성을 검사합니다
+func (o TableOptions) Validate() error {
+	if o.WatermarkColumn != "" {
+		if err := ValidateIdentifier(o.WatermarkColumn); err != nil {
+			return fmt.Errorf("watermark_column: %w", err)
+		}
+	}
+	if err := o.LOBColumns.Validate(); err != nil {
+		return err
+	}
+	return o.ColumnFilter.Validate()
+}
+
+// ValidateColumns는 컬럼 필터와 LOB 정책을 테이블 컬럼 정보와 대조하여 검사합니다
+// 증분 추출 기준 컬럼은 추출 결과에서 최대값을 관측하므로 제외할 수 없고, 시각으로 비교하므로 DATE/TIMESTAMP여야 합니다
+func (o TableOptions) ValidateColumns(columns []ColumnInfo) error {
+	if err := o.ColumnFilter.ValidateColumns(columns); err != nil {
+		return err
+	}
+	if err := o.LOBColumns.ValidateColumns(o.ColumnFilter.Apply(columns)); err != nil {
+		return err
+	}
+	if o.WatermarkColumn != "" {
+		col, ok := findColumn(o.ColumnFilter.Apply(columns), o.WatermarkColumn)
+		if !ok {
+			return fmt.Errorf("watermark_column %s이(가) 추출 컬럼에 포함되어야 합니다", o.WatermarkColumn)
+		}
+		if !IsWatermarkType(col.DataType) {
+			return fmt.Errorf("watermark_column %s은(는) DATE/TIMESTAMP 컬럼이어야 합니다 (%s)", o.WatermarkColumn, col.DataType)
+		}
+	}
+	return nil
+}
+
+// IsWatermarkType은 Oracle 데이터 타입이 증분 추출 기준으로 쓸 수 있는 DATE/TIMESTAMP 타입인지 반환합니다
+// TIMESTAMP(n) WITH [LOCAL] TIME ZONE을 포함합니다
+func IsWatermarkType(dataType string) bool {
+	dataType = strings.ToUpper(dataType)
+	return dataType == "DATE" || strings.HasPrefix(dataType, "TIMESTAMP")
+}
+
+// Clone은 컬럼 패턴 목록과 LOB 정책을 복사한 옵션을 반환합니다
+func (o TableOptions) Clone() TableOptions {
+	o.ColumnFilter = o.ColumnFilter.Clone()
+	o.LOBColumns = o.LOBColumns.Clone()
+	return o
+}
+
+// Watermark는 Transport/테이블별 증분 추출 기준값(high-water mark)입니다
+type Watermark struct {
+	TransportID string    `json:"transport_id"` // Transport ID
+	TableName   string    `json:"table_name"`   // 테이블 이름
+	Column      string    `json:"column"`       // 기준 컬럼
+	Value       time.Time `json:"value"`        // 마지막으로 추출된 최대값
+	JobID       string    `json:"job_id"`       // 기준값을 기록한 Job ID
+	UpdatedAt   time.Time `json:"updated_at"`   // 기록 시간
+}
+
+// WatermarkRange는 테이블 추출에 적용된 기준 컬럼 범위입니다
+// From이 nil이면 전체 추출, To는 추출된 row의 최대값입니다 (row가 없으면 From과 동일)
+//
+// 하한은 포함(>=)으로 조회합니다. 직전 추출의 최대값과 같은 시각에 나중에 커밋된 row를 놓치지 않기 위한 것으로,
+// 그 대가로 기준 컬럼 값이 From과 같은 row는 직전 버전과 중복될 수 있어 적재 시 키 기준 중복 제거가 필요합니다 (at-least-once)
+type WatermarkRange struct {
+	Column    string     `json:"column"`              // 기준 컬럼
+	From      *time.Time `json:"from,omitempty"`      // 하한 (포함, nil이면 전체 추출)
+	To        *time.Time `json:"to,omitempty"`        // 추출된 최대값
+	Inclusive bool       `json:"inclusive,omitempty"` // 하한 포함 여부 (true면 From과 같은 값의 row가 직전 추출과 중복될 수 있음)
+}
+
+// NewWatermarkRange는 추출 결과에 기록할 범위를 만듭니다 (하한이 있으면 포함 조회임을 표시)
+func NewWatermarkRange(column string, from *time.Time) *WatermarkRange {
+	return &WatermarkRange{Column: column, From: from, Inclusive: from != nil}
+}
+
+// IsFullLoad는 전체 추출인지 반환합니다
+func (r *WatermarkRange) IsFullLoad() bool {
+	return r.From == nil
+}
+
+// Observe는 추출된 기준 컬럼 값으로 상한을 갱신합니다
+func (r *WatermarkRange) Observe(value time.Time) {
+	if r.To == nil || value.After(*r.To) {
+		v := value
+		r.To = &v
+	}
+}
+
+// WatermarkListResponse는 Transport의 Watermark 목록 응답입니다
+type WatermarkListResponse struct {
+	TransportID string      `json:"transport_id"`
+	Watermarks  []Watermark `json:"watermarks"`
+}
+
+// validateTableOptions는 테이블 옵션 키가 Transport 테이블 목록에 있는지 검증합니다
+func validateTableOptions(tables []string, options map[string]TableOptions) error {
+	known := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		known[t] = true
+	}
+	for table, opts := range options {
+		if !known[table] {
+			return fmt.Errorf("table_options의 테이블 %s이(가) tables에 없습니다", table)
+		}
+		if err := opts.Validate(); err != nil {
+			return fmt.Errorf("table_options[%s]: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ const (
 	ErrCodeValidation = "VALIDATION_ERROR"
 	// ErrCodeAuth는 인증 오류를 나타냅니다
 	ErrCodeAuth = "AUTHENTICATION_ERROR"
+	// ErrCodeForbidden은 인증되었지만 권한이 없음을 나타냅니다
+	ErrCodeForbidden = "FORBIDDEN"
 	// ErrCodeRateLimit은 요청 제한 초과를 나타냅니다
 	ErrCodeRateLimit = "RATE_LIMIT_EXCEEDED"
 	// ErrCodeInternal은 내부 서버 오류를 나타냅니다
@@ -27,10 +29,12 @@ const (
 
 // ErrorResponse는 구조화된 에러 응답 구조체입니다
 type ErrorResponse struct {
-	Code    string `json:"code"`              // 에러 코드 (예: ORACLE_CONNECTION_ERROR)
-	Message string `json:"message"`           // 사용자 친화적 메시지
-	Details any    `json:"details,omitempty"` // 추가 상세 정보 (선택)
-	TraceID string `json:"trace_id,omitempty"` // 추적 ID (선택)
+	Code      string `json:"code"`                // 에러 코드 (예: ORACLE_CONNECTION_ERROR)
+	Message   string `json:"message"`             // 사용자 친화적 메시지
+	Details   any    `json:"details,omitempty"`   // 추가 상세 정보 (선택)
+	TraceID   string `json:"trace_id,omitempty"`  // 추적 ID (선택)
+	Retryable bool   `json:"retryable,omitempty"` // 같은 요청을 나중에 다시 시도하면 성공할 수 있는지 여부
+	Hint      string `json:"hint,omitempty"`      // 조치 방법 (선택)
 }
 
 // NewError는 새로운 ErrorResponse를 생성합니다
@@ -75,13 +79,17 @@ func (e *ErrorResponse) HTTPStatus() int {
 		return http.StatusBadRequest // 400
 	case ErrCodeAuth:
 		return http.StatusUnauthorized // 401
-	case ErrCodeTransportNotFound:
+	case ErrCodeForbidden, ErrCodeOraclePrivilege:
+		return http.StatusForbidden // 403
+	case ErrCodeTransportNotFound, ErrCodeOracleTableNotFound:
 		return http.StatusNotFound // 404
+	case ErrCodeOracleResourceBusy:
+		return http.StatusConflict // 409
 	case ErrCodeRateLimit:
 		return http.StatusTooManyRequests // 429
-	case ErrCodeOracleConnection:
+	case ErrCodeOracleConnection, ErrCodeOracleNetwork, ErrCodeOracleUnavailable, ErrCodeOracleSnapshotTooOld:
 		return http.StatusServiceUnavailable // 503
-	case ErrCodeGCSUpload:
+	case ErrCodeGCSUpload, ErrCodeOracleInvalidCredentials:
 		return http.StatusBadGateway // 502
 	case ErrCodeInternal:
 		return http.StatusInternalServerError // 500
@@ -142,6 +150,11 @@ func NewAuthError(message string) *ErrorResponse {
 	return NewError(ErrCodeAuth, message)
 }
 
+// NewForbiddenError는 권한 부족 에러를 생성합니다
+func NewForbiddenError(message string) *ErrorResponse {
+	return NewError(ErrCodeForbidden, message)
+}
+
 // NewRateLimitError는 요청 제한 에러를 생성합니다
 func NewRateLimitError() *ErrorResponse {
 	return NewError(ErrCodeRateLimit, "요청 제한 초과. 잠시 후 다시 시도해주세요.")
@@ -115,6 +115,7 @@ func TestErrorCodes(t *testing.T) {
 		ErrCodeTransportNotFound,
 		ErrCodeValidation,
 		ErrCodeAuth,
+		ErrCodeForbidden,
 		ErrCodeRateLimit,
 		ErrCodeInternal,
 	}
@@ -187,11 +188,20 @@ func TestHTTPStatus(t *testing.T) {
 	}{
 		{ErrCodeValidation, 400},
 		{ErrCodeAuth, 401},
+		{ErrCodeForbidden, 403},
 		{ErrCodeRateLimit, 429},
 		{ErrCodeTransportNotFound, 404},
 		{ErrCodeOracleConnection, 503},
 		{ErrCodeGCSUpload, 502},
 		{ErrCodeInternal, 500},
+		{ErrCodeOracleTableNotFound, 404},
+		{ErrCodeOraclePrivilege, 403},
+		{ErrCodeOracleSnapshotTooOld, 503},
+		{ErrCodeOracleNetwork, 503},
+		{ErrCodeOracleUnavailable, 503},
+		{ErrCodeOracleResourceBusy, 409},
+		{ErrCodeOracleInvalidCredentials, 502},
+		{ErrCodeOracle, 500},
 	}
 
 	for _, tc := range tests {
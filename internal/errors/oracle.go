@@ -0,0 +1,180 @@
+// Package errors는 구조화된 에러 처리를 제공합니다.
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/godror/godror"
+)
+
+// Oracle 에러 분류 코드
+const (
+	// ErrCodeOracleTableNotFound는 테이블 또는 뷰가 없음을 나타냅니다 (ORA-00942)
+	ErrCodeOracleTableNotFound = "ORACLE_TABLE_NOT_FOUND"
+	// ErrCodeOraclePrivilege는 Oracle 권한 부족을 나타냅니다 (ORA-01031)
+	ErrCodeOraclePrivilege = "ORACLE_INSUFFICIENT_PRIVILEGES"
+	// ErrCodeOracleSnapshotTooOld는 undo 데이터 만료로 일관된 읽기에 실패했음을 나타냅니다 (ORA-01555)
+	ErrCodeOracleSnapshotTooOld = "ORACLE_SNAPSHOT_TOO_OLD"
+	// ErrCodeOracleNetwork는 리스너/네트워크 연결 실패를 나타냅니다 (ORA-12541, ORA-12170 등)
+	ErrCodeOracleNetwork = "ORACLE_NETWORK_ERROR"
+	// ErrCodeOracleUnavailable은 인스턴스 기동/종료 중이거나 세션·프로세스 한도 초과로 연결할 수 없음을 나타냅니다 (ORA-01034, ORA-12516 등)
+	ErrCodeOracleUnavailable = "ORACLE_UNAVAILABLE"
+	// ErrCodeOracleResourceBusy는 잠금 경합으로 리소스를 사용할 수 없음을 나타냅니다 (ORA-00054 등)
+	ErrCodeOracleResourceBusy = "ORACLE_RESOURCE_BUSY"
+	// ErrCodeOracleInvalidCredentials는 Oracle 로그인 실패를 나타냅니다 (ORA-01017)
+	ErrCodeOracleInvalidCredentials = "ORACLE_INVALID_CREDENTIALS"
+	// ErrCodeOracle은 분류되지 않은 Oracle 에러를 나타냅니다
+	ErrCodeOracle = "ORACLE_ERROR"
+)
+
+// oracleErrorClass는 Oracle 에러 코드의 응답 분류입니다
+// retryable은 Job 재시도로 회복될 수 있는지, transient는 같은 호출을 곧바로 다시 실행해도 되는지(실행기의 자동 재시도 대상)를 나타내며
+// transient인 분류는 항상 retryable입니다
+type oracleErrorClass struct {
+	code      string
+	message   string
+	retryable bool
+	transient bool
+	hint      string
+}
+
+var (
+	networkClass = oracleErrorClass{
+		code:      ErrCodeOracleNetwork,
+		message:   "Oracle 네트워크 연결 실패",
+		retryable: true,
+		transient: true,
+		hint:      "리스너 상태, TNS 설정(tnsnames.ora, Wallet)과 방화벽을 확인하세요. 일시적인 장애라면 잠시 후 재시도하세요",
+	}
+	unavailableClass = oracleErrorClass{
+		code:      ErrCodeOracleUnavailable,
+		message:   "Oracle 인스턴스가 새 연결을 받을 수 없습니다",
+		retryable: true,
+		transient: true,
+		hint:      "인스턴스 기동/종료 상태와 세션·프로세스 한도(SESSIONS, PROCESSES)를 확인하세요. 일시적인 상태라면 잠시 후 재시도하세요",
+	}
+	busyClass = oracleErrorClass{
+		code:      ErrCodeOracleResourceBusy,
+		message:   "Oracle 리소스가 다른 세션에 의해 사용 중입니다",
+		retryable: true,
+		transient: true,
+		hint:      "다른 세션의 잠금이 해제된 후 재시도하세요",
+	}
+)
+
+// oracleErrorClasses는 ORA- 코드별 분류입니다 (없는 코드는 ErrCodeOracle)
+// HTTP 응답 분류, 실행기의 자동 재시도(resilience.IsTransient), 추출 에러 분류가 모두 이 표를 기준으로 합니다
+var oracleErrorClasses = map[int]oracleErrorClass{
+	942: {
+		code:    ErrCodeOracleTableNotFound,
+		message: "테이블 또는 뷰가 존재하지 않습니다",
+		hint:    "스키마 소유자(owner)와 테이블 이름을 확인하고, 추출 계정에 조회 권한이 있는지 확인하세요",
+	},
+	1031: {
+		code:    ErrCodeOraclePrivilege,
+		message: "Oracle 권한이 부족합니다",
+		hint:    "추출 계정에 대상 테이블의 SELECT 권한을 부여하세요. 분할 추출에는 DBA_EXTENTS 조회 권한이 필요합니다",
+	},
+	// 같은 SCN으로 곧바로 다시 조회하면 다시 실패하므로 자동 재시도 대상은 아닙니다
+	1555: {
+		code:      ErrCodeOracleSnapshotTooOld,
+		message:   "스냅샷이 너무 오래되었습니다 (undo 데이터 만료)",
+		retryable: true,
+		hint:      "UNDO_RETENTION을 늘리거나 분할 추출로 테이블당 조회 시간을 줄이세요. consistent_snapshot Job은 같은 SCN으로 재시도할 수 없으므로 새 Job으로 전체 재실행하세요",
+	},
+	1017: {
+		code:    ErrCodeOracleInvalidCredentials,
+		message: "Oracle 로그인 실패 (잘못된 사용자명 또는 비밀번호)",
+		hint:    "ORACLE_USERNAME/ORACLE_PASSWORD 또는 Wallet 자격 증명을 확인하세요",
+	},
+	18:    unavailableClass, // maximum number of sessions exceeded
+	20:    unavailableClass, // maximum number of processes exceeded
+	51:    busyClass,        // timeout occurred while waiting for a resource
+	54:    busyClass,        // resource busy and acquire with NOWAIT
+	60:    busyClass,        // deadlock detected
+	1033:  unavailableClass, // initialization or shutdown in progress
+	1034:  unavailableClass, // ORACLE not available
+	1089:  unavailableClass, // immediate shutdown in progress
+	3113:  networkClass,     // end-of-file on communication channel
+	3114:  networkClass,     // not connected to ORACLE
+	3135:  networkClass,     // connection lost contact
+	12170: networkClass,     // connect timeout
+	12514: networkClass,     // listener does not know of service
+	12516: unavailableClass, // listener could not find available handler
+	12519: unavailableClass, // no appropriate service handler found
+	12520: unavailableClass, // no handler for requested server type
+	12528: unavailableClass, // instance is blocking new connections
+	12537: networkClass,     // connection closed
+	12541: networkClass,     // no listener
+	12543: networkClass,     // destination host unreachable
+	12571: networkClass,     // packet writer failure
+	25408: networkClass,     // can not safely replay call
+}
+
+// OracleErrorCode는 ORA- 코드의 분류 코드(ErrCodeOracle*)를 반환합니다 (분류되지 않은 코드는 ErrCodeOracle)
+func OracleErrorCode(code int) string {
+	if class, ok := oracleErrorClasses[code]; ok {
+		return class.code
+	}
+	return ErrCodeOracle
+}
+
+// IsTransientOraCode는 ORA- 코드가 같은 호출을 곧바로 다시 실행하면 회복될 수 있는 일시적 장애인지 반환합니다
+// 세션 종료, 리스너/인스턴스 일시 불가, 락 경합, 네트워크 단절 등이 해당됩니다
+func IsTransientOraCode(code int) bool {
+	return oracleErrorClasses[code].transient
+}
+
+// oraCodePattern은 에러 메시지에서 ORA- 코드를 찾습니다
+var oraCodePattern = regexp.MustCompile(`ORA-(\d{5})`)
+
+// OraCode는 에러에서 Oracle 에러 코드(ORA-XXXXX의 숫자)를 추출합니다
+// godror OraErr를 우선 사용하고, 없으면 Code() 메서드, 에러 메시지 순으로 찾습니다
+func OraCode(err error) (int, bool) {
+	if err == nil {
+		return 0, false
+	}
+	if oraErr, ok := godror.AsOraErr(err); ok && oraErr.Code() > 0 {
+		return oraErr.Code(), true
+	}
+	var coder interface{ Code() int }
+	if errors.As(err, &coder) && coder.Code() > 0 {
+		return coder.Code(), true
+	}
+	if m := oraCodePattern.FindStringSubmatch(err.Error()); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return code, true
+	}
+	return 0, false
+}
+
+// FormatOraCode는 Oracle 에러 코드를 ORA-XXXXX 형식으로 변환합니다
+func FormatOraCode(code int) string {
+	return fmt.Sprintf("ORA-%05d", code)
+}
+
+// ClassifyOracle은 Oracle 에러를 에러 코드, 재시도 가능 여부, 조치 방법이 담긴 ErrorResponse로 변환합니다
+// ORA- 코드가 없는 에러는 nil을 반환합니다
+func ClassifyOracle(err error) *ErrorResponse {
+	code, ok := OraCode(err)
+	if !ok {
+		return nil
+	}
+	class, known := oracleErrorClasses[code]
+	if !known {
+		class = oracleErrorClass{code: ErrCodeOracle, message: "Oracle 오류"}
+	}
+
+	oraCode := FormatOraCode(code)
+	resp := NewError(class.code, fmt.Sprintf("%s (%s)", class.message, oraCode)).
+		WithDetails(map[string]any{
+			"ora_code": oraCode,
+			"cause":    err.Error(),
+		})
+	resp.Retryable = class.retryable
+	resp.Hint = class.hint
+	return resp
+}
@@ -0,0 +1,102 @@
+// Package errors는 구조화된 에러 처리를 제공합니다.
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// codedError는 드라이버 에러(Code() 메서드)를 흉내냅니다
+type codedError struct{ code int }
+
+func (e *codedError) Error() string { return fmt.Sprintf("ORA-%05d: driver error", e.code) }
+func (e *codedError) Code() int     { return e.code }
+
+// TestOraCode는 Oracle 에러 코드 추출을 테스트합니다
+func TestOraCode(t *testing.T) {
+	code, ok := OraCode(fmt.Errorf("데이터 스트리밍 시작 실패: %w", errors.New("ORA-00942: table or view does not exist")))
+	assert.True(t, ok)
+	assert.Equal(t, 942, code)
+
+	code, ok = OraCode(fmt.Errorf("쿼리 실패: %w", &codedError{code: 1031}))
+	assert.True(t, ok)
+	assert.Equal(t, 1031, code)
+
+	_, ok = OraCode(errors.New("boom"))
+	assert.False(t, ok)
+	_, ok = OraCode(nil)
+	assert.False(t, ok)
+}
+
+// TestClassifyOracle은 ORA- 코드별 분류를 테스트합니다
+func TestClassifyOracle(t *testing.T) {
+	tests := []struct {
+		err       error
+		code      string
+		status    int
+		retryable bool
+	}{
+		{errors.New("ORA-00942: table or view does not exist"), ErrCodeOracleTableNotFound, 404, false},
+		{errors.New("ORA-01031: insufficient privileges"), ErrCodeOraclePrivilege, 403, false},
+		{errors.New("ORA-01555: snapshot too old"), ErrCodeOracleSnapshotTooOld, 503, true},
+		{errors.New("ORA-12541: TNS:no listener"), ErrCodeOracleNetwork, 503, true},
+		{errors.New("ORA-12170: TNS:Connect timeout occurred"), ErrCodeOracleNetwork, 503, true},
+		{errors.New("ORA-00054: resource busy and acquire with NOWAIT specified"), ErrCodeOracleResourceBusy, 409, true},
+		{errors.New("ORA-01034: ORACLE not available"), ErrCodeOracleUnavailable, 503, true},
+		{errors.New("ORA-12516: TNS:listener could not find available handler"), ErrCodeOracleUnavailable, 503, true},
+		{&codedError{code: 1017}, ErrCodeOracleInvalidCredentials, 502, false},
+		{errors.New("ORA-00904: invalid identifier"), ErrCodeOracle, 500, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.code, func(t *testing.T) {
+			resp := ClassifyOracle(fmt.Errorf("조회 실패: %w", tc.err))
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.code, resp.Code)
+			assert.Equal(t, tc.status, resp.HTTPStatus())
+			assert.Equal(t, tc.retryable, resp.Retryable)
+			assert.Contains(t, resp.Message, "ORA-")
+		})
+	}
+
+	assert.Nil(t, ClassifyOracle(errors.New("boom")))
+	assert.Nil(t, ClassifyOracle(nil))
+}
+
+// TestIsTransientOraCode는 자동 재시도 대상 코드가 항상 재시도 가능으로 응답되는지 테스트합니다
+func TestIsTransientOraCode(t *testing.T) {
+	for code, class := range oracleErrorClasses {
+		if class.transient {
+			assert.True(t, class.retryable, FormatOraCode(code))
+		}
+	}
+
+	assert.True(t, IsTransientOraCode(3113))
+	assert.True(t, IsTransientOraCode(25408))
+	assert.False(t, IsTransientOraCode(1555), "같은 SCN으로 즉시 재시도하지 않음")
+	assert.False(t, IsTransientOraCode(942))
+	assert.False(t, IsTransientOraCode(904))
+	assert.Equal(t, ErrCodeOracleSnapshotTooOld, OracleErrorCode(1555))
+	assert.Equal(t, ErrCodeOracle, OracleErrorCode(904))
+}
+
+// TestClassifyOracle_JSON은 분류된 에러의 JSON 응답을 테스트합니다
+func TestClassifyOracle_JSON(t *testing.T) {
+	resp := ClassifyOracle(errors.New("ORA-00942: table or view does not exist"))
+
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, "ORACLE_TABLE_NOT_FOUND", parsed["code"])
+	assert.Equal(t, "테이블 또는 뷰가 존재하지 않습니다 (ORA-00942)", parsed["message"])
+	assert.NotEmpty(t, parsed["hint"])
+	assert.NotContains(t, parsed, "retryable", "재시도할 수 없는 에러는 retryable 생략")
+	assert.Equal(t, "ORA-00942", parsed["details"].(map[string]any)["ora_code"])
+}
@@ -0,0 +1,387 @@
+// Package governor는 운영 Oracle 데이터베이스를 보호하기 위한 추출 부하 조절 기능을 제공합니다.
+//
+// Governor는 모든 Job을 합산한 동시 Oracle 커서 수를 제한하고, Job별 초당 추출 row 수를 조절하며,
+// 데이터베이스 부하 지표(호스트 CPU, 평균 활성 세션)가 기준을 넘으면 추출을 늦추거나(slow) 멈춥니다(paused).
+package governor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"oracle-etl/internal/domain"
+)
+
+// Mode는 부하 조절 상태입니다
+type Mode string
+
+const (
+	// ModeNormal은 설정된 커서 수/row 속도 상한만 적용하는 상태입니다
+	ModeNormal Mode = "normal"
+	// ModeSlow는 커서 수와 row 속도 상한을 SlowFactor 비율로 줄인 상태입니다
+	ModeSlow Mode = "slow"
+	// ModePaused는 새 커서를 열지 않고, 진행 중인 추출도 다음 청크를 조회하기 전에 대기하는 상태입니다
+	ModePaused Mode = "paused"
+)
+
+// Level은 상태의 제한 강도를 반환합니다 (0: normal, 1: slow, 2: paused)
+func (m Mode) Level() int {
+	switch m {
+	case ModePaused:
+		return 2
+	case ModeSlow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// 기본값
+const (
+	defaultSampleInterval = 15 * time.Second
+	defaultSlowFactor     = 0.5
+
+	// resumeRatio는 상태를 완화하기 위해 지표가 내려가야 하는 기준 대비 비율입니다 (기준 부근에서 잦은 전환 방지)
+	resumeRatio = 0.9
+)
+
+// Thresholds는 부하 지표 하나의 상태 전환 기준입니다
+type Thresholds struct {
+	Slow  float64 // 이 값 이상이면 slow (0이면 사용하지 않음)
+	Pause float64 // 이 값 이상이면 paused (0이면 사용하지 않음)
+}
+
+// Enabled는 기준이 하나라도 설정되었는지 반환합니다
+func (t Thresholds) Enabled() bool {
+	return t.Slow > 0 || t.Pause > 0
+}
+
+// mode는 지표 값에 따른 상태를 판단합니다
+// 현재 상태(current)를 완화하려면 지표가 기준의 resumeRatio 미만으로 내려가야 합니다
+func (t Thresholds) mode(value float64, current Mode) Mode {
+	exceeds := func(threshold float64, held bool) bool {
+		if threshold <= 0 {
+			return false
+		}
+		if held {
+			return value >= threshold*resumeRatio
+		}
+		return value >= threshold
+	}
+
+	switch {
+	case exceeds(t.Pause, current == ModePaused):
+		return ModePaused
+	case exceeds(t.Slow, current != ModeNormal):
+		return ModeSlow
+	default:
+		return ModeNormal
+	}
+}
+
+// Sampler는 데이터베이스 부하 지표를 조회합니다
+type Sampler func(ctx context.Context) (*domain.LoadMetrics, error)
+
+// Config는 Governor 설정입니다
+type Config struct {
+	MaxCursors     int            // 모든 Job을 합산한 동시 Oracle 커서 수 상한 (0이면 무제한)
+	RowsPerSecond  float64        // Job별 초당 추출 row 수 상한 (0이면 무제한)
+	HostCPU        Thresholds     // 호스트 CPU 사용률(%) 기준
+	ActiveSessions Thresholds     // 평균 활성 세션 수 기준
+	SlowFactor     float64        // slow 상태에서 커서 수/row 속도 상한에 곱하는 비율 (기본값: 0.5)
+	SampleInterval time.Duration  // 부하 지표 조회 주기 (기본값: 15s)
+	Sampler        Sampler        // 부하 지표 조회 함수 (nil이면 부하 지표를 확인하지 않음)
+	Logger         zerolog.Logger // 상태 변경 로거
+}
+
+// Status는 Governor의 현재 상태입니다
+type Status struct {
+	Mode           Mode      // 부하 조절 상태
+	Reason         string    // slow/paused 원인 (기준을 넘은 지표)
+	HostCPU        float64   // 마지막으로 조회한 호스트 CPU 사용률 (%)
+	ActiveSessions float64   // 마지막으로 조회한 평균 활성 세션 수
+	SampledAt      time.Time // 마지막 부하 지표 조회 시간 (조회하지 않았으면 zero)
+	ActiveCursors  int       // 열려 있는 추출 커서 수
+	MaxCursors     int       // 현재 적용 중인 동시 커서 수 상한 (0이면 무제한)
+	RowsPerSecond  float64   // 현재 적용 중인 Job별 초당 row 수 상한 (0이면 무제한)
+	Error          string    // 마지막 부하 지표 조회 실패 메시지 (성공하면 빈 값)
+}
+
+// Governor는 Oracle 추출 부하를 조절합니다
+// nil Governor의 메서드는 아무것도 제한하지 않으므로 부하 조절 없이도 사용할 수 있습니다
+type Governor struct {
+	config Config
+
+	mu           sync.Mutex
+	mode         Mode
+	cpuMode      Mode // 호스트 CPU 기준에 따른 상태
+	sessionsMode Mode // 평균 활성 세션 기준에 따른 상태
+	load         domain.LoadMetrics
+	sampleErr    string
+	active       int                  // 열려 있는 커서 수
+	jobs         map[string]time.Time // Job별 다음 청크 조회 가능 시각 (row 속도 조절)
+	changed      chan struct{}        // 상태 변경 또는 커서 반환 시 닫히고 교체됨 (대기 중인 호출을 깨움)
+}
+
+// New는 새로운 Governor를 생성합니다
+func New(cfg Config) *Governor {
+	if cfg.SlowFactor <= 0 || cfg.SlowFactor > 1 {
+		cfg.SlowFactor = defaultSlowFactor
+	}
+	if cfg.SampleInterval <= 0 {
+		cfg.SampleInterval = defaultSampleInterval
+	}
+	return &Governor{
+		config:       cfg,
+		mode:         ModeNormal,
+		cpuMode:      ModeNormal,
+		sessionsMode: ModeNormal,
+		jobs:         make(map[string]time.Time),
+		changed:      make(chan struct{}),
+	}
+}
+
+// Run은 컨텍스트가 취소될 때까지 SampleInterval마다 부하 지표를 조회하여 상태를 갱신합니다
+// Sampler가 없으면 즉시 반환합니다. 조회에 실패하면 이전 상태를 유지합니다
+func (g *Governor) Run(ctx context.Context) {
+	if g == nil || g.config.Sampler == nil {
+		return
+	}
+
+	ticker := time.NewTicker(g.config.SampleInterval)
+	defer ticker.Stop()
+
+	for {
+		sampleCtx, cancel := context.WithTimeout(ctx, g.config.SampleInterval)
+		if err := g.Sample(sampleCtx); err != nil && ctx.Err() == nil {
+			g.config.Logger.Warn().Err(err).Msg("부하 지표 조회 실패, 이전 부하 조절 상태를 유지합니다")
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sample은 부하 지표를 한 번 조회하여 상태를 갱신합니다
+func (g *Governor) Sample(ctx context.Context) error {
+	if g == nil || g.config.Sampler == nil {
+		return nil
+	}
+
+	load, err := g.config.Sampler(ctx)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err != nil {
+		g.sampleErr = err.Error()
+		return err
+	}
+	g.sampleErr = ""
+	g.load = *load
+	g.cpuMode = g.config.HostCPU.mode(load.HostCPUPercent, g.cpuMode)
+	g.sessionsMode = g.config.ActiveSessions.mode(load.ActiveSessions, g.sessionsMode)
+
+	mode := g.cpuMode
+	if g.sessionsMode.Level() > mode.Level() {
+		mode = g.sessionsMode
+	}
+	if mode == g.mode {
+		return nil
+	}
+
+	from := g.mode
+	g.mode = mode
+	g.notifyLocked()
+
+	event := g.config.Logger.Warn()
+	if mode == ModeNormal {
+		event = g.config.Logger.Info()
+	}
+	event.
+		Str("from", string(from)).
+		Str("to", string(mode)).
+		Float64("host_cpu_percent", load.HostCPUPercent).
+		Float64("active_sessions", load.ActiveSessions).
+		Msg("추출 부하 조절 상태 변경")
+	return nil
+}
+
+// Acquire는 Oracle 커서 하나를 열 수 있을 때까지 대기합니다
+// paused 상태이거나 열린 커서 수가 상한에 도달하면 대기하며, 커서를 닫은 뒤 반환된 release를 호출해야 합니다
+func (g *Governor) Acquire(ctx context.Context) (release func(), err error) {
+	if g == nil {
+		return func() {}, nil
+	}
+
+	g.mu.Lock()
+	for !g.canAcquireLocked() {
+		changed := g.changed
+		g.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		}
+		g.mu.Lock()
+	}
+	g.active++
+	g.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			g.active--
+			g.notifyLocked()
+		})
+	}, nil
+}
+
+// Throttle은 Job이 청크(rows개 row)를 처리한 뒤 다음 청크를 조회하기 전에 호출합니다
+// paused 상태면 해제될 때까지 대기하고, Job별 초당 row 수 상한을 넘지 않도록 대기합니다
+func (g *Governor) Throttle(ctx context.Context, jobID string, rows int) error {
+	if g == nil {
+		return nil
+	}
+	if err := g.waitResume(ctx); err != nil {
+		return err
+	}
+
+	delay := g.reserve(jobID, rows)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// FinishJob은 종료된 Job의 row 속도 조절 상태를 제거합니다
+func (g *Governor) FinishJob(jobID string) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.jobs, jobID)
+}
+
+// Status는 현재 부하 조절 상태를 반환합니다
+func (g *Governor) Status() Status {
+	if g == nil {
+		return Status{Mode: ModeNormal}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return Status{
+		Mode:           g.mode,
+		Reason:         g.reasonLocked(),
+		HostCPU:        g.load.HostCPUPercent,
+		ActiveSessions: g.load.ActiveSessions,
+		SampledAt:      g.load.SampledAt,
+		ActiveCursors:  g.active,
+		MaxCursors:     g.cursorLimitLocked(),
+		RowsPerSecond:  g.rowsPerSecondLocked(),
+		Error:          g.sampleErr,
+	}
+}
+
+// waitResume은 paused 상태가 해제될 때까지 대기합니다
+func (g *Governor) waitResume(ctx context.Context) error {
+	g.mu.Lock()
+	for g.mode == ModePaused {
+		changed := g.changed
+		g.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+		g.mu.Lock()
+	}
+	g.mu.Unlock()
+	return nil
+}
+
+// reserve는 Job의 row 속도 상한에 rows개 row를 반영하고, 다음 청크를 조회하기 전까지 대기할 시간을 반환합니다
+func (g *Governor) reserve(jobID string, rows int) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	rate := g.rowsPerSecondLocked()
+	if rate <= 0 || rows <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	next := g.jobs[jobID]
+	if next.Before(now) {
+		next = now
+	}
+	next = next.Add(time.Duration(float64(rows) / rate * float64(time.Second)))
+	g.jobs[jobID] = next
+	return next.Sub(now)
+}
+
+// canAcquireLocked는 커서를 새로 열 수 있는지 반환합니다 (mu 보유 상태에서 호출)
+func (g *Governor) canAcquireLocked() bool {
+	if g.mode == ModePaused {
+		return false
+	}
+	limit := g.cursorLimitLocked()
+	return limit <= 0 || g.active < limit
+}
+
+// cursorLimitLocked는 현재 상태에 적용되는 동시 커서 수 상한을 반환합니다 (slow 상태에서도 최소 1)
+func (g *Governor) cursorLimitLocked() int {
+	limit := g.config.MaxCursors
+	if limit <= 0 || g.mode != ModeSlow {
+		return limit
+	}
+	if slowed := int(float64(limit) * g.config.SlowFactor); slowed > 1 {
+		return slowed
+	}
+	return 1
+}
+
+// rowsPerSecondLocked는 현재 상태에 적용되는 Job별 초당 row 수 상한을 반환합니다
+func (g *Governor) rowsPerSecondLocked() float64 {
+	if g.mode == ModeSlow {
+		return g.config.RowsPerSecond * g.config.SlowFactor
+	}
+	return g.config.RowsPerSecond
+}
+
+// reasonLocked는 기준을 넘은 지표를 설명하는 메시지를 반환합니다 (normal이면 빈 값)
+func (g *Governor) reasonLocked() string {
+	var reasons []string
+	if g.cpuMode != ModeNormal {
+		reasons = append(reasons, fmt.Sprintf("호스트 CPU 사용률 %.1f%%", g.load.HostCPUPercent))
+	}
+	if g.sessionsMode != ModeNormal {
+		reasons = append(reasons, fmt.Sprintf("평균 활성 세션 %.1f", g.load.ActiveSessions))
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// notifyLocked는 상태 변경 또는 커서 반환을 대기 중인 호출에 알립니다 (mu 보유 상태에서 호출)
+func (g *Governor) notifyLocked() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
@@ -0,0 +1,296 @@
+package governor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+)
+
+// loadSource는 테스트에서 바꿀 수 있는 부하 지표 Sampler입니다
+type loadSource struct {
+	mu   sync.Mutex
+	load domain.LoadMetrics
+	err  error
+}
+
+func (s *loadSource) set(cpu, sessions float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load = domain.LoadMetrics{HostCPUPercent: cpu, ActiveSessions: sessions, SampledAt: time.Now()}
+	s.err = nil
+}
+
+func (s *loadSource) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *loadSource) sample(ctx context.Context) (*domain.LoadMetrics, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	load := s.load
+	return &load, nil
+}
+
+// acquireWithin은 timeout 안에 커서를 얻으면 release를, 얻지 못하면 nil을 반환합니다
+func acquireWithin(t *testing.T, g *Governor, timeout time.Duration) func() {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	release, err := g.Acquire(ctx)
+	if err != nil {
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		return nil
+	}
+	return release
+}
+
+// TestThresholds_Mode는 기준과 완화 조건(resumeRatio)에 따른 상태 판단을 테스트합니다
+func TestThresholds_Mode(t *testing.T) {
+	th := Thresholds{Slow: 70, Pause: 85}
+
+	tests := []struct {
+		name    string
+		value   float64
+		current Mode
+		want    Mode
+	}{
+		{"기준 미만", 50, ModeNormal, ModeNormal},
+		{"slow 기준 도달", 70, ModeNormal, ModeSlow},
+		{"pause 기준 도달", 85, ModeSlow, ModePaused},
+		{"pause 기준 아래지만 완화 조건 미달", 80, ModePaused, ModePaused},
+		{"pause 완화 후 slow 유지", 75, ModePaused, ModeSlow},
+		{"slow 기준 아래지만 완화 조건 미달", 65, ModeSlow, ModeSlow},
+		{"slow 완화", 60, ModeSlow, ModeNormal},
+		{"normal에서는 slow 기준 미만이면 normal", 65, ModeNormal, ModeNormal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, th.mode(tt.value, tt.current))
+		})
+	}
+
+	// 설정되지 않은 기준은 무시
+	assert.Equal(t, ModeSlow, Thresholds{Slow: 10}.mode(100, ModeNormal))
+	assert.Equal(t, ModeNormal, Thresholds{}.mode(100, ModeNormal))
+	assert.False(t, Thresholds{}.Enabled())
+}
+
+// TestGovernor_MaxCursors는 동시 커서 수 상한을 테스트합니다
+func TestGovernor_MaxCursors(t *testing.T) {
+	g := New(Config{MaxCursors: 2})
+
+	r1 := acquireWithin(t, g, time.Second)
+	r2 := acquireWithin(t, g, time.Second)
+	require.NotNil(t, r1)
+	require.NotNil(t, r2)
+	assert.Equal(t, 2, g.Status().ActiveCursors)
+
+	// 상한에 도달하면 대기
+	assert.Nil(t, acquireWithin(t, g, 30*time.Millisecond))
+
+	// 반환되면 대기 중인 호출이 커서를 얻음
+	acquired := make(chan func(), 1)
+	go func() {
+		release, _ := g.Acquire(context.Background())
+		acquired <- release
+	}()
+	time.Sleep(10 * time.Millisecond)
+	r1()
+	r1() // 중복 호출은 무시
+	select {
+	case r3 := <-acquired:
+		r3()
+	case <-time.After(time.Second):
+		t.Fatal("커서 반환 후에도 대기가 풀리지 않음")
+	}
+	r2()
+	assert.Equal(t, 0, g.Status().ActiveCursors)
+}
+
+// TestGovernor_PauseAndResume은 부하 기준 초과 시 추출 중단과 재개를 테스트합니다
+func TestGovernor_PauseAndResume(t *testing.T) {
+	src := &loadSource{}
+	src.set(95, 10)
+	g := New(Config{HostCPU: Thresholds{Slow: 70, Pause: 85}, Sampler: src.sample})
+
+	require.NoError(t, g.Sample(context.Background()))
+	status := g.Status()
+	assert.Equal(t, ModePaused, status.Mode)
+	assert.Equal(t, 95.0, status.HostCPU)
+	assert.Contains(t, status.Reason, "CPU")
+
+	// paused 상태에서는 새 커서와 다음 청크 조회가 대기
+	assert.Nil(t, acquireWithin(t, g, 30*time.Millisecond))
+
+	throttled := make(chan error, 1)
+	go func() {
+		throttled <- g.Throttle(context.Background(), "JOB-001", 100)
+	}()
+	select {
+	case <-throttled:
+		t.Fatal("paused 상태에서 Throttle이 반환됨")
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	// 부하가 내려가면 재개
+	src.set(20, 10)
+	require.NoError(t, g.Sample(context.Background()))
+	assert.Equal(t, ModeNormal, g.Status().Mode)
+	assert.Empty(t, g.Status().Reason)
+
+	select {
+	case err := <-throttled:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("재개 후에도 Throttle이 반환되지 않음")
+	}
+	release := acquireWithin(t, g, time.Second)
+	require.NotNil(t, release)
+	release()
+}
+
+// TestGovernor_PausedCancel은 paused 상태의 대기가 컨텍스트 취소로 끝나는지 테스트합니다
+func TestGovernor_PausedCancel(t *testing.T) {
+	src := &loadSource{}
+	src.set(0, 50)
+	g := New(Config{ActiveSessions: Thresholds{Pause: 40}, Sampler: src.sample})
+	require.NoError(t, g.Sample(context.Background()))
+	require.Equal(t, ModePaused, g.Status().Mode)
+	assert.Contains(t, g.Status().Reason, "활성 세션")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, g.Throttle(ctx, "JOB-001", 10), context.DeadlineExceeded)
+}
+
+// TestGovernor_SlowReducesLimits는 slow 상태에서 커서 수와 row 속도 상한이 줄어드는지 테스트합니다
+func TestGovernor_SlowReducesLimits(t *testing.T) {
+	src := &loadSource{}
+	src.set(75, 0)
+	g := New(Config{
+		MaxCursors:    4,
+		RowsPerSecond: 1000,
+		HostCPU:       Thresholds{Slow: 70, Pause: 90},
+		Sampler:       src.sample,
+	})
+
+	status := g.Status()
+	assert.Equal(t, ModeNormal, status.Mode)
+	assert.Equal(t, 4, status.MaxCursors)
+	assert.Equal(t, 1000.0, status.RowsPerSecond)
+
+	require.NoError(t, g.Sample(context.Background()))
+	status = g.Status()
+	assert.Equal(t, ModeSlow, status.Mode)
+	assert.Equal(t, 2, status.MaxCursors)
+	assert.Equal(t, 500.0, status.RowsPerSecond)
+
+	r1 := acquireWithin(t, g, time.Second)
+	r2 := acquireWithin(t, g, time.Second)
+	require.NotNil(t, r1)
+	require.NotNil(t, r2)
+	assert.Nil(t, acquireWithin(t, g, 30*time.Millisecond))
+	r1()
+	r2()
+
+	// 상한이 1이면 slow 상태에서도 1개는 허용
+	g = New(Config{MaxCursors: 1, HostCPU: Thresholds{Slow: 70}, Sampler: src.sample})
+	require.NoError(t, g.Sample(context.Background()))
+	assert.Equal(t, 1, g.Status().MaxCursors)
+}
+
+// TestGovernor_RowsPerSecond는 Job별 초당 row 수 상한을 테스트합니다
+func TestGovernor_RowsPerSecond(t *testing.T) {
+	g := New(Config{RowsPerSecond: 1000})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, g.Throttle(ctx, "JOB-001", 50))
+	}
+	// 150 row / 1000 rows/s = 150ms
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+
+	// 다른 Job은 별도로 조절
+	start = time.Now()
+	require.NoError(t, g.Throttle(ctx, "JOB-002", 10))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	g.FinishJob("JOB-001")
+	g.FinishJob("JOB-002")
+	assert.Empty(t, g.jobs)
+}
+
+// TestGovernor_SampleError는 부하 지표 조회 실패 시 이전 상태를 유지하는지 테스트합니다
+func TestGovernor_SampleError(t *testing.T) {
+	src := &loadSource{}
+	src.set(80, 0)
+	g := New(Config{HostCPU: Thresholds{Slow: 70}, Sampler: src.sample})
+	require.NoError(t, g.Sample(context.Background()))
+	require.Equal(t, ModeSlow, g.Status().Mode)
+
+	src.fail(errors.New("ORA-00942: table or view does not exist"))
+	assert.Error(t, g.Sample(context.Background()))
+	status := g.Status()
+	assert.Equal(t, ModeSlow, status.Mode)
+	assert.Contains(t, status.Error, "ORA-00942")
+
+	src.set(10, 0)
+	require.NoError(t, g.Sample(context.Background()))
+	assert.Equal(t, ModeNormal, g.Status().Mode)
+	assert.Empty(t, g.Status().Error)
+}
+
+// TestGovernor_Run은 주기적인 부하 지표 조회를 테스트합니다
+func TestGovernor_Run(t *testing.T) {
+	src := &loadSource{}
+	src.set(10, 0)
+	g := New(Config{HostCPU: Thresholds{Pause: 50}, SampleInterval: 10 * time.Millisecond, Sampler: src.sample})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		g.Run(ctx)
+		close(done)
+	}()
+
+	src.set(60, 0)
+	assert.Eventually(t, func() bool { return g.Status().Mode == ModePaused }, time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("컨텍스트 취소 후 Run이 종료되지 않음")
+	}
+
+	// Sampler가 없으면 즉시 반환
+	New(Config{}).Run(context.Background())
+}
+
+// TestGovernor_Nil은 nil Governor가 아무것도 제한하지 않는지 테스트합니다
+func TestGovernor_Nil(t *testing.T) {
+	var g *Governor
+	ctx := context.Background()
+
+	release, err := g.Acquire(ctx)
+	require.NoError(t, err)
+	release()
+	assert.NoError(t, g.Throttle(ctx, "JOB-001", 1000))
+	assert.NoError(t, g.Sample(ctx))
+	g.FinishJob("JOB-001")
+	g.Run(ctx)
+	assert.Equal(t, ModeNormal, g.Status().Mode)
+}
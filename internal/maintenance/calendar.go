@@ -0,0 +1,345 @@
+// Package maintenance는 Transport 실행을 허용하거나 금지하는 유지보수 시간대를 계산합니다.
+// 운영 DB에 부하가 큰 추출을 DBA가 허용한 시간대(allow)에만 실행하고,
+// 월말 마감 같은 금지 기간(blackout)에는 실행하지 않도록 하기 위해 사용됩니다.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"oracle-etl/pkg/cron"
+)
+
+// Type은 유지보수 시간대의 종류입니다
+type Type string
+
+const (
+	// TypeAllow는 실행을 허용하는 시간대입니다 (허용 시간대가 하나라도 적용되면 그 밖에서는 실행할 수 없음)
+	TypeAllow Type = "allow"
+	// TypeBlackout은 실행을 금지하는 기간입니다 (허용 시간대보다 우선)
+	TypeBlackout Type = "blackout"
+)
+
+// 다음 상태 변경 시각 탐색 범위
+const (
+	searchHorizon  = 2 * 366 * 24 * time.Hour
+	searchMaxSteps = 10000
+	// maxWaitInterval은 Wait에서 한 번에 대기하는 최대 시간입니다 (시스템 시각 변경 대비)
+	maxWaitInterval = time.Hour
+)
+
+// DefaultTimezone은 시간대가 지정되지 않은 유지보수 시간대의 기본 시간대입니다
+const DefaultTimezone = "Asia/Seoul"
+
+// ErrNoUpcomingWindow는 탐색 범위 안에 실행 가능한 시각이 없을 때 반환됩니다
+var ErrNoUpcomingWindow = errors.New("실행 가능한 유지보수 시간대가 없습니다")
+
+// Range는 [Start, End) 달력 구간입니다
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Window는 이름이 있는 유지보수 시간대 정의입니다
+// cron 표현식으로 시작 시각을 지정하고 Duration 동안 유지되거나(반복), Ranges의 달력 구간으로 지정합니다
+type Window struct {
+	Name     string
+	Type     Type          // allow 또는 blackout (빈 값이면 allow)
+	Cron     string        // 시간대 시작 시각 cron 표현식 (Ranges와 함께 사용할 수 없음)
+	Duration time.Duration // cron 시작 시각부터 시간대가 유지되는 시간
+	Ranges   []Range       // 달력 구간 목록
+	Timezone string        // cron 표현식을 해석할 시간대 (빈 값이면 DefaultTimezone)
+	Global   bool          // true면 모든 Transport에 적용
+}
+
+// window는 파싱된 유지보수 시간대입니다
+type window struct {
+	Window
+	schedule *cron.Schedule
+	location *time.Location
+}
+
+// inside는 t가 시간대 안에 있는지 확인합니다
+func (w *window) inside(t time.Time) bool {
+	if w.schedule != nil {
+		opened := w.lastOpening(t)
+		return !opened.IsZero() && t.Before(opened.Add(w.Duration))
+	}
+	for _, r := range w.Ranges {
+		if !t.Before(r.Start) && t.Before(r.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// lastOpening은 t 이하의 가장 최근 시작 시각을 반환합니다 (없으면 zero time)
+func (w *window) lastOpening(t time.Time) time.Time {
+	return w.schedule.Prev(t.In(w.location).Add(time.Nanosecond))
+}
+
+// nextBoundary는 t 이후(t 제외) 시간대가 열리거나 닫힐 수 있는 가장 이른 시각을 반환합니다 (없으면 zero time)
+func (w *window) nextBoundary(t time.Time) time.Time {
+	var next time.Time
+	consider := func(candidate time.Time) {
+		if candidate.After(t) && (next.IsZero() || candidate.Before(next)) {
+			next = candidate
+		}
+	}
+
+	if w.schedule != nil {
+		consider(w.schedule.Next(t.In(w.location)))
+		if opened := w.lastOpening(t); !opened.IsZero() {
+			consider(opened.Add(w.Duration))
+		}
+		return next
+	}
+	for _, r := range w.Ranges {
+		consider(r.Start)
+		consider(r.End)
+	}
+	return next
+}
+
+// Status는 특정 시각의 실행 가능 여부입니다
+type Status struct {
+	Allowed       bool      // 실행 가능 여부
+	Reason        string    // 실행할 수 없는 이유 (Allowed면 빈 값)
+	Windows       []string  // 적용된 시간대 이름 (없으면 제한 없음)
+	NextAllowedAt time.Time // 다음 실행 가능 시각 (Allowed면 조회 시각, 탐색 범위 안에 없으면 zero time)
+	ClosesAt      time.Time // 실행 가능 상태가 끝나는 시각 (Allowed가 아니거나 탐색 범위 안에 없으면 zero time)
+}
+
+// Restricted는 적용된 유지보수 시간대가 있는지 확인합니다
+func (s Status) Restricted() bool {
+	return len(s.Windows) > 0
+}
+
+// Calendar는 이름별 유지보수 시간대 모음입니다
+// nil Calendar는 시간대 제한이 없는 것으로 동작합니다
+type Calendar struct {
+	windows map[string]*window
+	global  []string // 모든 Transport에 적용되는 시간대 이름 (이름순)
+}
+
+// New는 유지보수 시간대 정의를 검증하여 Calendar를 생성합니다
+func New(windows []Window) (*Calendar, error) {
+	c := &Calendar{windows: make(map[string]*window, len(windows))}
+	for i, def := range windows {
+		if def.Name == "" {
+			return nil, fmt.Errorf("유지보수 시간대 %d번째의 name이 비어있습니다", i+1)
+		}
+		if _, exists := c.windows[def.Name]; exists {
+			return nil, fmt.Errorf("유지보수 시간대 이름 '%s'이(가) 중복되었습니다", def.Name)
+		}
+		w, err := parseWindow(def)
+		if err != nil {
+			return nil, fmt.Errorf("유지보수 시간대 '%s': %w", def.Name, err)
+		}
+		c.windows[def.Name] = w
+		if def.Global {
+			c.global = append(c.global, def.Name)
+		}
+	}
+	sort.Strings(c.global)
+	return c, nil
+}
+
+// parseWindow는 시간대 정의를 검증하고 cron 표현식과 시간대를 파싱합니다
+func parseWindow(def Window) (*window, error) {
+	switch def.Type {
+	case "":
+		def.Type = TypeAllow
+	case TypeAllow, TypeBlackout:
+	default:
+		return nil, fmt.Errorf("지원하지 않는 type '%s' (allow, blackout)", def.Type)
+	}
+
+	tz := def.Timezone
+	if tz == "" {
+		tz = DefaultTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 시간대 '%s': %w", tz, err)
+	}
+	w := &window{Window: def, location: loc}
+
+	switch {
+	case def.Cron != "" && len(def.Ranges) > 0:
+		return nil, fmt.Errorf("cron과 ranges는 함께 지정할 수 없습니다")
+	case def.Cron != "":
+		if def.Duration <= 0 {
+			return nil, fmt.Errorf("cron 시간대는 duration이 0보다 커야 합니다")
+		}
+		if w.schedule, err = cron.Parse(def.Cron); err != nil {
+			return nil, fmt.Errorf("잘못된 cron 표현식: %w", err)
+		}
+	case len(def.Ranges) > 0:
+		w.Ranges = append([]Range(nil), def.Ranges...)
+		for i, r := range w.Ranges {
+			if r.Start.IsZero() || r.End.IsZero() || !r.End.After(r.Start) {
+				return nil, fmt.Errorf("ranges[%d]의 end는 start 이후여야 합니다", i)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cron 또는 ranges 중 하나는 필수입니다")
+	}
+	return w, nil
+}
+
+// ParseTime은 달력 구간의 시각을 loc 기준으로 파싱합니다
+// RFC3339, "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02" 형식을 지원하며,
+// end가 true이고 날짜만 지정하면 그날 전체를 포함하도록 다음 날 0시를 반환합니다
+func ParseTime(value string, loc *time.Location, end bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("잘못된 시각 '%s' (예: 2026-10-29 00:00, 2026-10-29)", value)
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+// Has는 이름의 유지보수 시간대가 정의되어 있는지 확인합니다
+func (c *Calendar) Has(name string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.windows[name]
+	return ok
+}
+
+// Applicable은 전역 시간대와 names 중 정의된 시간대의 이름을 중복 없이 이름순으로 반환합니다
+func (c *Calendar) Applicable(names []string) []string {
+	if c == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(c.global)+len(names))
+	applied := make([]string, 0, len(c.global)+len(names))
+	for _, name := range append(append([]string(nil), c.global...), names...) {
+		if seen[name] || !c.Has(name) {
+			continue
+		}
+		seen[name] = true
+		applied = append(applied, name)
+	}
+	sort.Strings(applied)
+	return applied
+}
+
+// Status는 names(전역 시간대 포함)를 적용했을 때 t의 실행 가능 여부와 다음 상태 변경 시각을 반환합니다
+// 정의되지 않은 이름은 무시합니다
+func (c *Calendar) Status(names []string, t time.Time) Status {
+	applied := c.resolve(names)
+	status := Status{Windows: make([]string, 0, len(applied))}
+	for _, w := range applied {
+		status.Windows = append(status.Windows, w.Name)
+	}
+
+	status.Allowed, status.Reason = allowed(applied, t)
+	if status.Allowed {
+		status.NextAllowedAt = t
+		status.ClosesAt = nextChange(applied, t, false)
+	} else {
+		status.NextAllowedAt = nextChange(applied, t, true)
+	}
+	return status
+}
+
+// Allowed는 names(전역 시간대 포함)를 적용했을 때 t에 실행할 수 있는지 확인합니다
+func (c *Calendar) Allowed(names []string, t time.Time) bool {
+	ok, _ := allowed(c.resolve(names), t)
+	return ok
+}
+
+// Wait는 names(전역 시간대 포함)를 적용했을 때 실행할 수 있을 때까지 대기합니다
+// 탐색 범위 안에 실행 가능한 시각이 없으면 ErrNoUpcomingWindow를 반환합니다
+func (c *Calendar) Wait(ctx context.Context, names []string) error {
+	for {
+		status := c.Status(names, time.Now())
+		if status.Allowed {
+			return nil
+		}
+		if status.NextAllowedAt.IsZero() {
+			return fmt.Errorf("%w: %s", ErrNoUpcomingWindow, status.Reason)
+		}
+
+		timer := time.NewTimer(min(time.Until(status.NextAllowedAt), maxWaitInterval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// resolve는 적용할 시간대 정의를 이름순으로 반환합니다
+func (c *Calendar) resolve(names []string) []*window {
+	applied := c.Applicable(names)
+	windows := make([]*window, 0, len(applied))
+	for _, name := range applied {
+		windows = append(windows, c.windows[name])
+	}
+	return windows
+}
+
+// allowed는 t가 blackout 밖이고, 허용 시간대가 있으면 그 중 하나 안에 있는지 확인합니다
+func allowed(windows []*window, t time.Time) (bool, string) {
+	var allows []string
+	inAllow := false
+	for _, w := range windows {
+		switch w.Type {
+		case TypeBlackout:
+			if w.inside(t) {
+				return false, fmt.Sprintf("금지 기간 '%s'", w.Name)
+			}
+		default:
+			allows = append(allows, w.Name)
+			if w.inside(t) {
+				inAllow = true
+			}
+		}
+	}
+	if len(allows) > 0 && !inAllow {
+		return false, fmt.Sprintf("허용 시간대(%s) 밖", strings.Join(allows, ", "))
+	}
+	return true, ""
+}
+
+// nextChange는 t 이후 실행 가능 여부가 want가 되는 첫 시각을 반환합니다 (탐색 범위 안에 없으면 zero time)
+func nextChange(windows []*window, t time.Time, want bool) time.Time {
+	limit := t.Add(searchHorizon)
+	current := t
+	for step := 0; step < searchMaxSteps; step++ {
+		var next time.Time
+		for _, w := range windows {
+			if b := w.nextBoundary(current); !b.IsZero() && (next.IsZero() || b.Before(next)) {
+				next = b
+			}
+		}
+		if next.IsZero() || next.After(limit) {
+			return time.Time{}
+		}
+		if ok, _ := allowed(windows, next); ok == want {
+			return next
+		}
+		current = next
+	}
+	return time.Time{}
+}
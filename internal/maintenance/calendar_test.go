@@ -0,0 +1,177 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seoul은 테스트 기준 시간대입니다
+func seoul(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	return loc
+}
+
+// TestNew_Validation은 유지보수 시간대 정의 검증을 테스트합니다
+func TestNew_Validation(t *testing.T) {
+	start := time.Date(2026, 10, 29, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		windows []Window
+		errMsg  string
+	}{
+		{"이름 없음", []Window{{Cron: "0 22 * * *", Duration: time.Hour}}, "name"},
+		{"이름 중복", []Window{{Name: "night", Cron: "0 22 * * *", Duration: time.Hour}, {Name: "night", Cron: "0 1 * * *", Duration: time.Hour}}, "중복"},
+		{"잘못된 type", []Window{{Name: "night", Type: "maybe", Cron: "0 22 * * *", Duration: time.Hour}}, "type"},
+		{"잘못된 cron", []Window{{Name: "night", Cron: "0 25 * * *", Duration: time.Hour}}, "cron"},
+		{"duration 없음", []Window{{Name: "night", Cron: "0 22 * * *"}}, "duration"},
+		{"cron과 ranges 함께", []Window{{Name: "close", Cron: "0 22 * * *", Duration: time.Hour, Ranges: []Range{{Start: start, End: start.Add(time.Hour)}}}}, "함께"},
+		{"정의 없음", []Window{{Name: "empty"}}, "필수"},
+		{"잘못된 구간", []Window{{Name: "close", Ranges: []Range{{Start: start, End: start}}}}, "ranges[0]"},
+		{"잘못된 시간대", []Window{{Name: "night", Cron: "0 22 * * *", Duration: time.Hour, Timezone: "Mars/Base"}}, "시간대"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.windows)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+// TestCalendar_CronWindow는 시간대를 고려한 cron 허용 시간대를 테스트합니다
+func TestCalendar_CronWindow(t *testing.T) {
+	loc := seoul(t)
+	cal, err := New([]Window{
+		// 매일 22시부터 8시간 (서울 기준, 자정을 넘김)
+		{Name: "night", Type: TypeAllow, Cron: "0 22 * * *", Duration: 8 * time.Hour, Timezone: "Asia/Seoul"},
+	})
+	require.NoError(t, err)
+
+	// 서울 10:00 → 허용 시간대 밖, 같은 날 22:00에 열림
+	status := cal.Status([]string{"night"}, time.Date(2026, 10, 15, 10, 0, 0, 0, loc))
+	assert.False(t, status.Allowed)
+	assert.Contains(t, status.Reason, "night")
+	assert.Equal(t, []string{"night"}, status.Windows)
+	assert.True(t, status.NextAllowedAt.Equal(time.Date(2026, 10, 15, 22, 0, 0, 0, loc)))
+	assert.True(t, status.ClosesAt.IsZero())
+
+	// 서울 02:30 (UTC 전날 17:30) → 전날 22시에 열린 시간대 안, 06:00에 닫힘
+	now := time.Date(2026, 10, 15, 17, 30, 0, 0, time.UTC)
+	status = cal.Status([]string{"night"}, now)
+	assert.True(t, status.Allowed)
+	assert.Empty(t, status.Reason)
+	assert.True(t, status.NextAllowedAt.Equal(now))
+	assert.True(t, status.ClosesAt.Equal(time.Date(2026, 10, 16, 6, 0, 0, 0, loc)))
+
+	// 닫히는 시각은 시간대 밖 (반열린 구간)
+	assert.False(t, cal.Allowed([]string{"night"}, time.Date(2026, 10, 16, 6, 0, 0, 0, loc)))
+	assert.True(t, cal.Allowed([]string{"night"}, time.Date(2026, 10, 16, 5, 59, 59, 0, loc)))
+
+	// 적용되지 않은 Transport는 제한 없음
+	status = cal.Status(nil, time.Date(2026, 10, 15, 10, 0, 0, 0, loc))
+	assert.True(t, status.Allowed)
+	assert.False(t, status.Restricted())
+}
+
+// TestCalendar_Blackout은 금지 기간이 허용 시간대보다 우선하는지 테스트합니다
+func TestCalendar_Blackout(t *testing.T) {
+	loc := seoul(t)
+	closeStart := time.Date(2026, 10, 29, 0, 0, 0, 0, loc)
+	closeEnd := time.Date(2026, 11, 3, 0, 0, 0, 0, loc)
+	cal, err := New([]Window{
+		{Name: "night", Cron: "0 22 * * *", Duration: 8 * time.Hour, Global: true},
+		{Name: "month_end_close", Type: TypeBlackout, Ranges: []Range{{Start: closeStart, End: closeEnd}}, Global: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"month_end_close", "night"}, cal.Applicable(nil))
+	assert.Equal(t, []string{"month_end_close", "night"}, cal.Applicable([]string{"night", "unknown"}))
+
+	// 마감 기간 중의 야간 → 금지, 마감이 끝난 11/3 22:00에 다시 실행 가능
+	status := cal.Status(nil, time.Date(2026, 10, 30, 23, 0, 0, 0, loc))
+	assert.False(t, status.Allowed)
+	assert.Contains(t, status.Reason, "month_end_close")
+	assert.True(t, status.NextAllowedAt.Equal(time.Date(2026, 11, 3, 0, 0, 0, 0, loc)),
+		"11/2 22시에 열린 야간 시간대가 11/3 0시 마감 종료 시점에 아직 열려 있어야 함: %s", status.NextAllowedAt)
+
+	// 마감 직전 야간 → 마감 시작 시각에 닫힘
+	status = cal.Status(nil, time.Date(2026, 10, 28, 23, 0, 0, 0, loc))
+	assert.True(t, status.Allowed)
+	assert.True(t, status.ClosesAt.Equal(closeStart))
+}
+
+// TestCalendar_NoUpcomingWindow는 다시 열리지 않는 시간대를 테스트합니다
+func TestCalendar_NoUpcomingWindow(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cal, err := New([]Window{
+		{Name: "migration", Ranges: []Range{{Start: start, End: start.Add(24 * time.Hour)}}},
+	})
+	require.NoError(t, err)
+
+	status := cal.Status([]string{"migration"}, start.Add(48*time.Hour))
+	assert.False(t, status.Allowed)
+	assert.True(t, status.NextAllowedAt.IsZero())
+
+	err = cal.Wait(context.Background(), []string{"migration"})
+	assert.ErrorIs(t, err, ErrNoUpcomingWindow)
+}
+
+// TestCalendar_Wait는 시간대가 열릴 때까지 대기하는지 테스트합니다
+func TestCalendar_Wait(t *testing.T) {
+	opens := time.Now().Add(50 * time.Millisecond)
+	cal, err := New([]Window{
+		{Name: "soon", Ranges: []Range{{Start: opens, End: opens.Add(time.Hour)}}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, cal.Wait(context.Background(), []string{"soon"}))
+	assert.False(t, time.Now().Before(opens))
+
+	// 컨텍스트 취소
+	later := time.Now().Add(time.Hour)
+	cal, err = New([]Window{{Name: "later", Ranges: []Range{{Start: later, End: later.Add(time.Hour)}}}})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, cal.Wait(ctx, []string{"later"}), context.DeadlineExceeded)
+}
+
+// TestCalendar_Nil은 nil Calendar가 제한 없이 동작하는지 테스트합니다
+func TestCalendar_Nil(t *testing.T) {
+	var cal *Calendar
+	status := cal.Status([]string{"night"}, time.Now())
+	assert.True(t, status.Allowed)
+	assert.False(t, status.Restricted())
+	assert.False(t, cal.Has("night"))
+	assert.NoError(t, cal.Wait(context.Background(), nil))
+}
+
+// TestParseTime은 달력 구간 시각 파싱을 테스트합니다
+func TestParseTime(t *testing.T) {
+	loc := seoul(t)
+
+	got, err := ParseTime("2026-10-29 09:30", loc, false)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2026, 10, 29, 9, 30, 0, 0, loc)))
+
+	got, err = ParseTime("2026-10-29T00:00:00Z", loc, false)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2026, 10, 29, 0, 0, 0, 0, time.UTC)))
+
+	// 날짜만 지정한 end는 그날 전체 포함
+	got, err = ParseTime("2026-11-02", loc, true)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2026, 11, 3, 0, 0, 0, 0, loc)))
+
+	got, err = ParseTime("2026-11-02", loc, false)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2026, 11, 2, 0, 0, 0, 0, loc)))
+
+	_, err = ParseTime("next tuesday", loc, false)
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -13,6 +14,7 @@ import (
 // 인증 제외 경로 목록
 var skipAuthPaths = []string{
 	"/api/health",
+	"/api/health/ready",
 	"/health",
 }
 
@@ -35,8 +37,12 @@ func NewAuthMiddleware(cfg *config.AuthConfig) fiber.Handler {
 		// API Key 확인
 		apiKey := c.Get("X-API-Key")
 		if apiKey != "" {
-			if validateAPIKey(apiKey, cfg.APIKeys) {
+			if name, role, ok := lookupAPIKey(apiKey, cfg); ok {
+				if name != "" {
+					c.Locals("user_id", name)
+				}
 				c.Locals("auth_method", "api_key")
+				c.Locals(roleLocalKey, role)
 				return c.Next()
 			}
 			return authError(c, "유효하지 않은 API Key")
@@ -56,6 +62,7 @@ func NewAuthMiddleware(cfg *config.AuthConfig) fiber.Handler {
 				c.Locals("user_id", sub)
 			}
 			c.Locals("auth_method", "bearer")
+			c.Locals(roleLocalKey, roleFromClaims(claims, defaultRole(cfg)))
 			return c.Next()
 		}
 
@@ -64,10 +71,24 @@ func NewAuthMiddleware(cfg *config.AuthConfig) fiber.Handler {
 	}
 }
 
+// lookupAPIKey는 제공된 API Key의 이름과 역할을 찾습니다
+// 역할이 지정된 keys를 먼저 확인하고, api_keys 목록의 키는 기본 역할을 사용합니다
+func lookupAPIKey(key string, cfg *config.AuthConfig) (string, Role, bool) {
+	for _, k := range cfg.Keys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(k.Key)) == 1 {
+			return k.Name, Role(k.Role), true
+		}
+	}
+	if validateAPIKey(key, cfg.APIKeys) {
+		return "", defaultRole(cfg), true
+	}
+	return "", "", false
+}
+
 // validateAPIKey는 제공된 API Key가 유효한지 확인합니다
 func validateAPIKey(key string, validKeys []string) bool {
 	for _, validKey := range validKeys {
-		if key == validKey {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(validKey)) == 1 {
 			return true
 		}
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+
+	"oracle-etl/internal/telemetry"
 )
 
 // NewLoggingMiddleware는 구조화된 JSON 로깅 미들웨어를 생성합니다
@@ -35,6 +37,10 @@ func NewLoggingMiddleware(logger zerolog.Logger) fiber.Handler {
 			event = logger.Info()
 		}
 
+		if traceID := telemetry.TraceID(c.UserContext()); traceID != "" {
+			event = event.Str("trace_id", traceID)
+		}
+
 		event.
 			Str("request_id", requestID).
 			Str("method", c.Method()).
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+
+	"oracle-etl/internal/config"
+	apperrors "oracle-etl/internal/errors"
+)
+
+// Role은 API 권한 역할입니다 (viewer < operator < admin)
+type Role string
+
+const (
+	// RoleViewer는 Transport/Job/테이블 조회와 샘플 데이터 미리보기만 허용합니다
+	RoleViewer Role = "viewer"
+	// RoleOperator는 조회에 더해 Transport 실행과 Job 취소/재시도를 허용합니다
+	RoleOperator Role = "operator"
+	// RoleAdmin은 Transport 생성/삭제를 포함한 모든 작업을 허용합니다
+	RoleAdmin Role = "admin"
+)
+
+// roleLocalKey는 인증된 요청의 역할을 저장하는 fiber Locals 키입니다
+const roleLocalKey = "role"
+
+// level은 역할의 권한 수준을 반환합니다 (알 수 없는 역할은 0)
+func (r Role) level() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Allows는 역할이 required 역할의 작업을 수행할 수 있는지 확인합니다
+func (r Role) Allows(required Role) bool {
+	return r.level() > 0 && r.level() >= required.level()
+}
+
+// RoleFromContext는 인증 미들웨어가 저장한 요청의 역할을 반환합니다
+func RoleFromContext(c *fiber.Ctx) (Role, bool) {
+	role, ok := c.Locals(roleLocalKey).(Role)
+	return role, ok
+}
+
+// NewRoleMiddleware는 요청의 역할이 required 이상인지 확인하는 미들웨어를 생성합니다
+// 인증이 비활성화되어 있으면 모든 요청을 허용합니다 (NewAuthMiddleware 뒤에 적용해야 함)
+func NewRoleMiddleware(cfg *config.AuthConfig, required Role) fiber.Handler {
+	if cfg == nil || !cfg.Enabled {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		role, _ := RoleFromContext(c)
+		if role.Allows(required) {
+			return c.Next()
+		}
+
+		errResp := apperrors.NewForbiddenError("이 작업을 수행할 권한이 없습니다").
+			WithDetails(map[string]any{
+				"role":          string(role),
+				"required_role": string(required),
+			})
+		if traceID := traceIDFromRequest(c); traceID != "" {
+			errResp.WithTraceID(traceID)
+		}
+		return c.Status(errResp.HTTPStatus()).JSON(errResp)
+	}
+}
+
+// defaultRole은 역할 정보가 없는 자격 증명의 역할을 반환합니다 (설정이 없으면 viewer)
+func defaultRole(cfg *config.AuthConfig) Role {
+	if role := Role(cfg.DefaultRole); role.level() > 0 {
+		return role
+	}
+	return RoleViewer
+}
+
+// roleFromClaims는 JWT의 role(문자열) 또는 roles(배열) 클레임에서 가장 높은 역할을 반환합니다
+// 유효한 역할 클레임이 없으면 기본 역할을 반환합니다
+func roleFromClaims(claims jwt.MapClaims, fallback Role) Role {
+	var best Role
+	consider := func(v interface{}) {
+		if name, ok := v.(string); ok {
+			if role := Role(name); role.level() > best.level() {
+				best = role
+			}
+		}
+	}
+
+	consider(claims["role"])
+	if roles, ok := claims["roles"].([]interface{}); ok {
+		for _, v := range roles {
+			consider(v)
+		}
+	}
+
+	if best.level() == 0 {
+		return fallback
+	}
+	return best
+}
@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/config"
+	apperrors "oracle-etl/internal/errors"
+)
+
+// newRBACTestApp은 인증과 역할 검사를 적용한 테스트 앱을 생성합니다
+func newRBACTestApp(cfg *config.AuthConfig) *fiber.App {
+	app := fiber.New()
+	app.Use(NewAuthMiddleware(cfg))
+	app.Get("/transports", NewRoleMiddleware(cfg, RoleViewer), func(c *fiber.Ctx) error {
+		return c.SendString("list")
+	})
+	app.Post("/transports/:id/execute", NewRoleMiddleware(cfg, RoleOperator), func(c *fiber.Ctx) error {
+		return c.SendString("execute")
+	})
+	app.Post("/transports", NewRoleMiddleware(cfg, RoleAdmin), func(c *fiber.Ctx) error {
+		return c.SendString("create")
+	})
+	return app
+}
+
+// signToken은 테스트용 HS256 JWT를 생성합니다
+func signToken(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+// TestRole_Allows는 역할 수준 비교를 테스트
+func TestRole_Allows(t *testing.T) {
+	assert.True(t, RoleAdmin.Allows(RoleOperator))
+	assert.True(t, RoleOperator.Allows(RoleViewer))
+	assert.True(t, RoleViewer.Allows(RoleViewer))
+	assert.False(t, RoleViewer.Allows(RoleOperator))
+	assert.False(t, RoleOperator.Allows(RoleAdmin))
+	assert.False(t, Role("").Allows(RoleViewer))
+	assert.False(t, Role("root").Allows(RoleViewer))
+}
+
+// TestRoleMiddleware_APIKeyRoles는 API Key별 역할에 따른 권한 검사를 테스트
+func TestRoleMiddleware_APIKeyRoles(t *testing.T) {
+	cfg := &config.AuthConfig{
+		Enabled: true,
+		APIKeys: []string{"legacy-key"},
+		Keys: []config.APIKeyConfig{
+			{Name: "dashboard", Key: "viewer-key", Role: "viewer"},
+			{Name: "scheduler", Key: "operator-key", Role: "operator"},
+			{Name: "platform", Key: "admin-key", Role: "admin"},
+		},
+	}
+	app := newRBACTestApp(cfg)
+
+	tests := []struct {
+		key      string
+		method   string
+		path     string
+		expected int
+	}{
+		{"viewer-key", "GET", "/transports", 200},
+		{"viewer-key", "POST", "/transports/TRP-001/execute", 403},
+		{"viewer-key", "POST", "/transports", 403},
+		{"operator-key", "POST", "/transports/TRP-001/execute", 200},
+		{"operator-key", "POST", "/transports", 403},
+		{"admin-key", "POST", "/transports", 200},
+		// 역할이 없는 api_keys는 기본 역할(viewer)
+		{"legacy-key", "GET", "/transports", 200},
+		{"legacy-key", "POST", "/transports/TRP-001/execute", 403},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+" "+tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", tt.key)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+		})
+	}
+}
+
+// TestRoleMiddleware_ForbiddenResponse는 403 응답이 ErrorResponse 형식인지 테스트
+func TestRoleMiddleware_ForbiddenResponse(t *testing.T) {
+	cfg := &config.AuthConfig{
+		Enabled: true,
+		Keys:    []config.APIKeyConfig{{Name: "dashboard", Key: "viewer-key", Role: "viewer"}},
+	}
+	app := newRBACTestApp(cfg)
+
+	req := httptest.NewRequest("POST", "/transports", nil)
+	req.Header.Set("X-API-Key", "viewer-key")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 403, resp.StatusCode)
+
+	var errResp apperrors.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, apperrors.ErrCodeForbidden, errResp.Code)
+	assert.Equal(t, map[string]any{"role": "viewer", "required_role": "admin"}, errResp.Details)
+}
+
+// TestRoleMiddleware_JWTClaims는 JWT role/roles 클레임에서 역할을 결정하는지 테스트
+func TestRoleMiddleware_JWTClaims(t *testing.T) {
+	secret := "test-secret"
+	cfg := &config.AuthConfig{Enabled: true, BearerSecret: secret, DefaultRole: "viewer"}
+	app := newRBACTestApp(cfg)
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		path     string
+		expected int
+	}{
+		{"role 클레임", jwt.MapClaims{"sub": "u1", "role": "operator"}, "/transports/TRP-001/execute", 200},
+		{"roles 배열의 최고 역할", jwt.MapClaims{"sub": "u2", "roles": []string{"viewer", "admin"}}, "/transports", 200},
+		{"역할 클레임 없음은 기본 역할", jwt.MapClaims{"sub": "u3"}, "/transports/TRP-001/execute", 403},
+		{"알 수 없는 역할은 기본 역할", jwt.MapClaims{"sub": "u4", "role": "root"}, "/transports", 403},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+signToken(t, secret, tt.claims))
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+		})
+	}
+}
+
+// TestRoleMiddleware_Disabled는 인증 비활성화 시 모든 요청을 허용하는지 테스트
+func TestRoleMiddleware_Disabled(t *testing.T) {
+	app := newRBACTestApp(&config.AuthConfig{Enabled: false})
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/transports", nil), -1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
@@ -11,11 +11,12 @@ import (
 	"github.com/rs/zerolog"
 
 	apperrors "oracle-etl/internal/errors"
+	"oracle-etl/internal/telemetry"
 )
 
 // NewRecoveryMiddleware는 개선된 패닉 복구 미들웨어를 생성합니다
 // - 구조화된 에러 응답 (ErrorResponse)
-// - TraceID 지원
+// - TraceID 지원 (분산 추적 trace ID 우선)
 // - 요청 컨텍스트 로깅
 // - error 타입 패닉 처리
 func NewRecoveryMiddleware(logger zerolog.Logger) fiber.Handler {
@@ -23,10 +24,7 @@ func NewRecoveryMiddleware(logger zerolog.Logger) fiber.Handler {
 		defer func() {
 			if r := recover(); r != nil {
 				// TraceID 추출 또는 생성
-				traceID := c.Get("X-Request-ID")
-				if traceID == "" {
-					traceID = uuid.New().String()
-				}
+				traceID := panicTraceID(c, "X-Request-ID")
 
 				// 스택 트레이스 캡처
 				stack := debug.Stack()
@@ -55,6 +53,18 @@ func NewRecoveryMiddleware(logger zerolog.Logger) fiber.Handler {
 	}
 }
 
+// panicTraceID는 패닉 응답에 기록할 TraceID를 결정합니다
+// 분산 추적 span의 trace ID, header 값, 새 UUID 순으로 사용합니다
+func panicTraceID(c *fiber.Ctx, header string) string {
+	if traceID := telemetry.TraceID(c.UserContext()); traceID != "" {
+		return traceID
+	}
+	if traceID := c.Get(header); traceID != "" {
+		return traceID
+	}
+	return uuid.New().String()
+}
+
 // extractError는 패닉 값에서 ErrorResponse를 추출합니다
 func extractError(r any, traceID string) *apperrors.ErrorResponse {
 	// ErrorResponse 타입인 경우
@@ -82,10 +92,7 @@ func NewRecoveryMiddlewareWithConfig(logger zerolog.Logger, config RecoveryConfi
 		defer func() {
 			if r := recover(); r != nil {
 				// TraceID 추출 또는 생성
-				traceID := c.Get(config.TraceIDHeader)
-				if traceID == "" {
-					traceID = uuid.New().String()
-				}
+				traceID := panicTraceID(c, config.TraceIDHeader)
 
 				// 스택 트레이스 캡처
 				stack := debug.Stack()
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"oracle-etl/internal/telemetry"
+)
+
+// headerCarrier는 fiber 요청 헤더를 OpenTelemetry 전파기에서 읽을 수 있도록 감쌉니다
+type headerCarrier struct {
+	c *fiber.Ctx
+}
+
+// Get은 헤더 값을 반환합니다
+func (h headerCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+// Set은 요청 헤더를 설정합니다 (추출 전용이므로 사용되지 않음)
+func (h headerCarrier) Set(key, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+// Keys는 요청 헤더 이름 목록을 반환합니다
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, h.c.Request().Header.Len())
+	h.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
+
+// NewTracingMiddleware는 요청마다 서버 span을 생성하는 분산 추적 미들웨어를 생성합니다
+// - 상위 서비스의 traceparent 헤더가 있으면 같은 trace에 이어서 기록
+// - span이 담긴 컨텍스트를 c.UserContext()로 핸들러에 전달
+// - 5xx 응답과 핸들러 에러는 span 에러로 기록
+// 로깅 미들웨어보다 먼저 적용해야 로그에 trace_id가 포함됩니다
+func NewTracingMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), headerCarrier{c: c})
+		ctx, span := telemetry.Tracer().Start(ctx, c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Method()),
+				semconv.URLPath(c.Path()),
+				semconv.ClientAddress(c.IP()),
+				semconv.UserAgentOriginal(c.Get(fiber.HeaderUserAgent)),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		// 라우트 패턴으로 span 이름 지정 (경로 파라미터로 인한 이름 폭증 방지)
+		route := c.Route().Path
+		span.SetName(c.Method() + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route))
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			// 에러 핸들러가 아직 상태 코드를 쓰지 않았으므로 에러에서 결정
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+			span.RecordError(err)
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+
+		return err
+	}
+}
+
+// traceIDFromRequest는 ErrorResponse와 로그에 기록할 요청의 추적 ID를 반환합니다
+// 분산 추적 span이 있으면 trace ID를, 없으면 로깅 미들웨어의 request_id를 사용합니다
+func traceIDFromRequest(c *fiber.Ctx) string {
+	if traceID := telemetry.TraceID(c.UserContext()); traceID != "" {
+		return traceID
+	}
+	if requestID, ok := c.Locals("request_id").(string); ok {
+		return requestID
+	}
+	return ""
+}
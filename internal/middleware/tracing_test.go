@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"oracle-etl/internal/config"
+	apperrors "oracle-etl/internal/errors"
+)
+
+const (
+	parentTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	parentTraceparent = "00-" + parentTraceID + "-00f067aa0ba902b7-01"
+)
+
+// useSpanRecorder는 테스트 동안 span을 메모리에 기록하도록 전역 TracerProvider를 설정합니다
+func useSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	return recorder
+}
+
+// TestTracingMiddleware_ServerSpan은 traceparent 전파와 라우트 기반 span 이름을 테스트
+func TestTracingMiddleware_ServerSpan(t *testing.T) {
+	recorder := useSpanRecorder(t)
+
+	var buf bytes.Buffer
+	app := fiber.New()
+	app.Use(NewTracingMiddleware())
+	app.Use(NewLoggingMiddleware(zerolog.New(&buf)))
+	app.Get("/transports/:id", func(c *fiber.Ctx) error {
+		// 핸들러는 UserContext로 같은 trace를 이어받음
+		assert.Equal(t, parentTraceID, trace.SpanContextFromContext(c.UserContext()).TraceID().String())
+		return c.SendString("ok")
+	})
+
+	req := httptest.NewRequest("GET", "/transports/TRP-001", nil)
+	req.Header.Set("traceparent", parentTraceparent)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /transports/:id", spans[0].Name())
+	assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+	assert.Equal(t, parentTraceID, spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	// 요청 로그에 trace_id 포함
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, parentTraceID, entry["trace_id"])
+}
+
+// TestTracingMiddleware_ErrorStatus는 5xx 응답과 핸들러 에러가 span 에러로 기록되는지 테스트
+func TestTracingMiddleware_ErrorStatus(t *testing.T) {
+	recorder := useSpanRecorder(t)
+
+	app := fiber.New()
+	app.Use(NewTracingMiddleware())
+	app.Get("/fail", func(c *fiber.Ctx) error {
+		return errors.New("oracle unavailable")
+	})
+	app.Get("/missing", func(c *fiber.Ctx) error {
+		return fiber.ErrNotFound
+	})
+
+	for _, path := range []string{"/fail", "/missing"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil), -1)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code, "4xx는 서버 에러가 아님")
+}
+
+// TestTracingMiddleware_ErrorResponseTraceID는 403 ErrorResponse의 TraceID가 trace ID인지 테스트
+func TestTracingMiddleware_ErrorResponseTraceID(t *testing.T) {
+	useSpanRecorder(t)
+
+	cfg := &config.AuthConfig{
+		Enabled: true,
+		Keys:    []config.APIKeyConfig{{Name: "dashboard", Key: "viewer-key", Role: "viewer"}},
+	}
+	app := fiber.New()
+	app.Use(NewTracingMiddleware())
+	app.Use(NewAuthMiddleware(cfg))
+	app.Post("/transports", NewRoleMiddleware(cfg, RoleAdmin), func(c *fiber.Ctx) error {
+		return c.SendString("create")
+	})
+
+	req := httptest.NewRequest("POST", "/transports", nil)
+	req.Header.Set("X-API-Key", "viewer-key")
+	req.Header.Set("traceparent", parentTraceparent)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, 403, resp.StatusCode)
+
+	var errResp apperrors.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	assert.Equal(t, parentTraceID, errResp.TraceID)
+}
@@ -0,0 +1,196 @@
+// Package bolt는 bbolt 임베디드 데이터베이스 기반의 영구 저장소 구현을 제공합니다.
+// 서버 재시작 후에도 Transport 정의, Job 이력, 버전 카운터, Watermark가 유지됩니다.
+package bolt
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"oracle-etl/internal/domain"
+)
+
+// 버킷 이름
+var (
+	metaBucket        = []byte("meta")         // 스키마 버전 등 메타데이터
+	transportsBucket  = []byte("transports")   // transportID -> Transport JSON
+	jobsBucket        = []byte("jobs")         // jobID -> Job JSON
+	jobVersionsBucket = []byte("job_versions") // transportID -> 마지막으로 할당된 Job 버전
+	watermarksBucket  = []byte("watermarks")   // transportID(하위 버킷) -> tableName -> Watermark JSON
+
+	transportRevisionsBucket = []byte("transport_revisions") // transportID(하위 버킷) -> 리비전 번호 -> TransportRevision JSON
+
+	schemaVersionKey = []byte("schema_version")
+)
+
+// openTimeout은 다른 프로세스가 파일 잠금을 보유한 경우 대기하는 최대 시간입니다
+const openTimeout = 5 * time.Second
+
+// migration은 스키마 버전별 변경 작업입니다
+type migration struct {
+	version     uint64
+	description string
+	up          func(tx *bbolt.Tx) error
+}
+
+// migrations는 순서대로 적용되는 스키마 마이그레이션 목록입니다
+// 이미 배포된 항목은 수정하지 말고 새 버전을 추가해야 합니다
+var migrations = []migration{
+	{
+		version:     1,
+		description: "초기 스키마",
+		up: func(tx *bbolt.Tx) error {
+			for _, name := range [][]byte{transportsBucket, jobsBucket, jobVersionsBucket, watermarksBucket} {
+				if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+					return fmt.Errorf("버킷 %s 생성 실패: %w", name, err)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		version:     2,
+		description: "Transport 리비전 이력",
+		up: func(tx *bbolt.Tx) error {
+			revisions, err := tx.CreateBucketIfNotExists(transportRevisionsBucket)
+			if err != nil {
+				return fmt.Errorf("버킷 %s 생성 실패: %w", transportRevisionsBucket, err)
+			}
+
+			// 기존 Transport의 현재 정의를 첫 리비전으로 기록
+			transports := tx.Bucket(transportsBucket)
+			return transports.ForEach(func(k, v []byte) error {
+				var transport domain.Transport
+				if err := json.Unmarshal(v, &transport); err != nil {
+					return fmt.Errorf("transport %s 디코딩 실패: %w", k, err)
+				}
+				if transport.Revision < 1 {
+					transport.Revision = 1
+				}
+				if err := putRevision(revisions, domain.NewTransportRevision(&transport, domain.RevisionActionCreate)); err != nil {
+					return err
+				}
+				return putJSON(transports, transport.ID, &transport)
+			})
+		},
+	},
+}
+
+// SchemaVersion은 이 바이너리가 지원하는 최신 스키마 버전입니다
+var SchemaVersion = migrations[len(migrations)-1].version
+
+// DB는 저장소들이 공유하는 bbolt 데이터베이스입니다
+type DB struct {
+	db *bbolt.DB
+}
+
+// Open은 데이터베이스 파일을 열고 필요한 마이그레이션을 적용합니다
+// 파일이 없으면 상위 디렉토리와 함께 생성합니다
+func Open(path string) (*DB, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("데이터 디렉토리 생성 실패: %w", err)
+		}
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("데이터베이스 열기 실패 (%s): %w", path, err)
+	}
+
+	if err := migrate(db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &DB{db: db}, nil
+}
+
+// Close는 데이터베이스를 닫습니다
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// Path는 데이터베이스 파일 경로를 반환합니다
+func (d *DB) Path() string {
+	return d.db.Path()
+}
+
+// migrate는 저장된 스키마 버전 이후의 마이그레이션을 하나의 트랜잭션으로 적용합니다
+func migrate(db *bbolt.DB) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return fmt.Errorf("메타 버킷 생성 실패: %w", err)
+		}
+
+		current := decodeUint64(meta.Get(schemaVersionKey))
+		if current > SchemaVersion {
+			return fmt.Errorf("데이터베이스 스키마 버전 %d이(가) 지원 버전 %d보다 높습니다", current, SchemaVersion)
+		}
+
+		for _, m := range migrations {
+			if m.version <= current {
+				continue
+			}
+			if err := m.up(tx); err != nil {
+				return fmt.Errorf("스키마 마이그레이션 v%d (%s) 실패: %w", m.version, m.description, err)
+			}
+			current = m.version
+		}
+
+		return meta.Put(schemaVersionKey, encodeUint64(current))
+	})
+}
+
+// schemaVersion은 저장된 스키마 버전을 반환합니다
+func (d *DB) schemaVersion() (uint64, error) {
+	var version uint64
+	err := d.db.View(func(tx *bbolt.Tx) error {
+		version = decodeUint64(tx.Bucket(metaBucket).Get(schemaVersionKey))
+		return nil
+	})
+	return version, err
+}
+
+// encodeUint64는 정수를 big-endian 8바이트로 인코딩합니다
+func encodeUint64(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return buf
+}
+
+// decodeUint64는 big-endian 8바이트를 정수로 디코딩합니다 (값이 없으면 0)
+func decodeUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// putJSON은 값을 JSON으로 인코딩하여 저장합니다
+func putJSON(bucket *bbolt.Bucket, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("%s 인코딩 실패: %w", key, err)
+	}
+	return bucket.Put([]byte(key), data)
+}
+
+// getJSON은 저장된 JSON을 디코딩합니다 (키가 없으면 false)
+// bbolt가 반환한 슬라이스는 트랜잭션 안에서만 유효하므로 여기서 바로 디코딩합니다
+func getJSON(bucket *bbolt.Bucket, key string, v interface{}) (bool, error) {
+	data := bucket.Get([]byte(key))
+	if data == nil {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("%s 디코딩 실패: %w", key, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,105 @@
+package bolt
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	"oracle-etl/internal/domain"
+)
+
+// openTestDB는 테스트용 임시 데이터베이스를 엽니다
+func openTestDB(t *testing.T) *DB {
+	t.Helper()
+	db, err := Open(filepath.Join(t.TempDir(), "etl.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// TestOpen_AppliesMigrations는 새 데이터베이스에 마이그레이션이 적용되는지 테스트합니다
+func TestOpen_AppliesMigrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "etl.db")
+	db, err := Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.Equal(t, path, db.Path())
+
+	version, err := db.schemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, version)
+
+	err = db.db.View(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{transportsBucket, jobsBucket, jobVersionsBucket, watermarksBucket, transportRevisionsBucket} {
+			assert.NotNil(t, tx.Bucket(name), string(name))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestOpen_RejectsNewerSchema는 더 높은 스키마 버전의 파일을 거부하는지 테스트합니다
+func TestOpen_RejectsNewerSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "etl.db")
+	db, err := Open(path)
+	require.NoError(t, err)
+
+	err = db.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(metaBucket).Put(schemaVersionKey, encodeUint64(SchemaVersion+1))
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = Open(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "스키마 버전")
+}
+
+// TestOpen_MigratesTransportRevisions는 기존 Transport에 첫 리비전이 기록되는지 테스트합니다
+func TestOpen_MigratesTransportRevisions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "etl.db")
+
+	// 스키마 버전 1의 데이터베이스 (리비전 필드 없음)
+	raw, err := bbolt.Open(path, 0o600, nil)
+	require.NoError(t, err)
+	updatedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	err = raw.Update(func(tx *bbolt.Tx) error {
+		meta, err := tx.CreateBucket(metaBucket)
+		if err != nil {
+			return err
+		}
+		if err := meta.Put(schemaVersionKey, encodeUint64(1)); err != nil {
+			return err
+		}
+		if err := migrations[0].up(tx); err != nil {
+			return err
+		}
+		legacy := domain.Transport{ID: "TRP-LEGACY", Name: "Legacy", Tables: []string{"MARA"}, Enabled: true, UpdatedAt: updatedAt}
+		return putJSON(tx.Bucket(transportsBucket), legacy.ID, &legacy)
+	})
+	require.NoError(t, err)
+	require.NoError(t, raw.Close())
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTransportRepository(db)
+	ctx := context.Background()
+
+	transport, err := repo.GetByID(ctx, "TRP-LEGACY")
+	require.NoError(t, err)
+	assert.Equal(t, 1, transport.Revision)
+
+	rev, err := repo.GetRevision(ctx, "TRP-LEGACY", 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RevisionActionCreate, rev.Action)
+	assert.Equal(t, []string{"MARA"}, rev.Definition.Tables)
+	assert.True(t, rev.CreatedAt.Equal(updatedAt))
+}
@@ -0,0 +1,181 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"go.etcd.io/bbolt"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// JobRepository는 bbolt 기반 Job 저장소 구현입니다
+// Transport별 버전 카운터를 별도 버킷에 저장하여 재시작 후에도 버전이 이어집니다
+type JobRepository struct {
+	db *bbolt.DB
+}
+
+// NewJobRepository는 새로운 bbolt Job 저장소를 생성합니다
+func NewJobRepository(db *DB) repository.JobRepository {
+	return &JobRepository{db: db.db}
+}
+
+// Create는 새로운 Job을 생성합니다
+func (r *JobRepository) Create(ctx context.Context, job *domain.Job) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		if err := putNewJob(tx, job); err != nil {
+			return err
+		}
+
+		// 지정된 버전이 카운터보다 크면 카운터를 따라 올림
+		versions := tx.Bucket(jobVersionsBucket)
+		if uint64(job.Version) > decodeUint64(versions.Get([]byte(job.TransportID))) {
+			return versions.Put([]byte(job.TransportID), encodeUint64(uint64(job.Version)))
+		}
+		return nil
+	})
+}
+
+// CreateNextVersion은 Transport의 다음 버전을 할당하여 Job을 생성합니다
+func (r *JobRepository) CreateNextVersion(ctx context.Context, job *domain.Job) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		versions := tx.Bucket(jobVersionsBucket)
+		next := decodeUint64(versions.Get([]byte(job.TransportID))) + 1
+
+		allocated := *job
+		allocated.Version = int(next)
+		if err := putNewJob(tx, &allocated); err != nil {
+			return err
+		}
+		if err := versions.Put([]byte(job.TransportID), encodeUint64(next)); err != nil {
+			return err
+		}
+
+		job.Version = allocated.Version
+		return nil
+	})
+}
+
+// GetByID는 ID로 Job을 조회합니다
+func (r *JobRepository) GetByID(ctx context.Context, id string) (*domain.Job, error) {
+	var job domain.Job
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		found, err := getJSON(tx.Bucket(jobsBucket), id, &job)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("job ID '%s'를 찾을 수 없습니다", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// List는 필터에 따라 Job 목록을 조회합니다
+func (r *JobRepository) List(ctx context.Context, filter domain.JobListFilter) ([]domain.Job, int, error) {
+	list, err := r.scan(func(j *domain.Job) bool {
+		// TransportID 필터
+		if filter.TransportID != "" && j.TransportID != filter.TransportID {
+			return false
+		}
+		// Status 필터
+		if filter.Status != "" && j.Status != filter.Status {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 생성 시간 기준 정렬 (최신순)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.After(list[j].CreatedAt)
+	})
+
+	total := len(list)
+
+	// offset/limit 적용
+	if filter.Offset >= len(list) {
+		return []domain.Job{}, total, nil
+	}
+
+	end := filter.Offset + filter.Limit
+	if end > len(list) {
+		end = len(list)
+	}
+
+	return list[filter.Offset:end], total, nil
+}
+
+// Update는 Job을 수정합니다
+func (r *JobRepository) Update(ctx context.Context, job *domain.Job) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(jobsBucket)
+		if bucket.Get([]byte(job.ID)) == nil {
+			return fmt.Errorf("job ID '%s'를 찾을 수 없습니다", job.ID)
+		}
+		return putJSON(bucket, job.ID, job)
+	})
+}
+
+// GetLatestVersionByTransportID는 특정 Transport의 최신 Job 버전을 반환합니다
+func (r *JobRepository) GetLatestVersionByTransportID(ctx context.Context, transportID string) (int, error) {
+	var version uint64
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		version = decodeUint64(tx.Bucket(jobVersionsBucket).Get([]byte(transportID)))
+		return nil
+	})
+	return int(version), err
+}
+
+// GetByTransportID는 특정 Transport의 모든 Job을 조회합니다
+func (r *JobRepository) GetByTransportID(ctx context.Context, transportID string) ([]domain.Job, error) {
+	list, err := r.scan(func(j *domain.Job) bool {
+		return j.TransportID == transportID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 버전 기준 정렬 (최신순)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version > list[j].Version
+	})
+
+	return list, nil
+}
+
+// scan은 조건을 만족하는 Job을 모두 디코딩하여 반환합니다
+func (r *JobRepository) scan(match func(j *domain.Job) bool) ([]domain.Job, error) {
+	list := make([]domain.Job, 0)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(jobsBucket).ForEach(func(k, v []byte) error {
+			var j domain.Job
+			if err := json.Unmarshal(v, &j); err != nil {
+				return fmt.Errorf("job %s 디코딩 실패: %w", k, err)
+			}
+			if match(&j) {
+				list = append(list, j)
+			}
+			return nil
+		})
+	})
+	return list, err
+}
+
+// putNewJob은 ID가 중복되지 않는 경우에만 Job을 저장합니다
+func putNewJob(tx *bbolt.Tx, job *domain.Job) error {
+	bucket := tx.Bucket(jobsBucket)
+	if bucket.Get([]byte(job.ID)) != nil {
+		return fmt.Errorf("job ID '%s'가 이미 존재합니다", job.ID)
+	}
+	return putJSON(bucket, job.ID, job)
+}
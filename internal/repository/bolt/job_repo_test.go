@@ -0,0 +1,280 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+)
+
+// TestJobRepo_Create는 Job 생성을 테스트합니다
+func TestJobRepo_Create(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	job := domain.NewJob("JOB-20260118-120000-abc", "TRPID-12345678", 1)
+
+	err := repo.Create(ctx, job)
+	require.NoError(t, err)
+
+	// 중복 생성 시도
+	err = repo.Create(ctx, job)
+	assert.Error(t, err)
+}
+
+// TestJobRepo_GetByID는 ID로 Job 조회를 테스트합니다
+func TestJobRepo_GetByID(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 존재하지 않는 ID 조회
+	_, err := repo.GetByID(ctx, "non-existent")
+	assert.Error(t, err)
+
+	// Job 생성 후 조회
+	job := domain.NewJob("JOB-20260118-120000-abc", "TRPID-12345678", 1)
+	job.SnapshotSCN = 123456789
+	require.NoError(t, repo.Create(ctx, job))
+
+	found, err := repo.GetByID(ctx, "JOB-20260118-120000-abc")
+	require.NoError(t, err)
+	assert.Equal(t, "TRPID-12345678", found.TransportID)
+	assert.Equal(t, 1, found.Version)
+	assert.Equal(t, uint64(123456789), found.SnapshotSCN)
+}
+
+// TestJobRepo_List는 Job 목록 조회를 테스트합니다
+func TestJobRepo_List(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 빈 목록
+	filter := domain.DefaultJobListFilter()
+	list, total, err := repo.List(ctx, filter)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	assert.Equal(t, 0, total)
+
+	// 여러 Job 생성
+	now := time.Now().UTC()
+	for i := 0; i < 5; i++ {
+		job := domain.NewJob(
+			domain.GenerateJobID(now.Add(time.Duration(i)*time.Second), "abc"),
+			"TRPID-12345678",
+			i+1,
+		)
+		job.CreatedAt = now.Add(time.Duration(i) * time.Second)
+		require.NoError(t, repo.Create(ctx, job))
+	}
+
+	// 페이지네이션 테스트 (최신순)
+	filter.Limit = 3
+	filter.Offset = 0
+	list, total, err = repo.List(ctx, filter)
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 5, list[0].Version)
+}
+
+// TestJobRepo_ListByTransportID는 Transport ID로 필터링을 테스트합니다
+func TestJobRepo_ListByTransportID(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 서로 다른 Transport의 Job 생성
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		job := domain.NewJob(
+			domain.GenerateJobID(now.Add(time.Duration(i)*time.Second), "aaa"),
+			"TRPID-AAA",
+			i+1,
+		)
+		require.NoError(t, repo.Create(ctx, job))
+	}
+	for i := 0; i < 2; i++ {
+		job := domain.NewJob(
+			domain.GenerateJobID(now.Add(time.Duration(i+10)*time.Second), "bbb"),
+			"TRPID-BBB",
+			i+1,
+		)
+		require.NoError(t, repo.Create(ctx, job))
+	}
+
+	// Transport ID로 필터링
+	filter := domain.DefaultJobListFilter()
+	filter.TransportID = "TRPID-AAA"
+	list, total, err := repo.List(ctx, filter)
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+	assert.Equal(t, 3, total)
+}
+
+// TestJobRepo_ListByStatus는 상태로 필터링을 테스트합니다
+func TestJobRepo_ListByStatus(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	now := time.Now()
+	// 다양한 상태의 Job 생성
+	job1 := domain.NewJob(domain.GenerateJobID(now, "a"), "TRPID-AAA", 1)
+	job1.Status = domain.JobStatusCompleted
+	require.NoError(t, repo.Create(ctx, job1))
+
+	job2 := domain.NewJob(domain.GenerateJobID(now.Add(time.Second), "b"), "TRPID-AAA", 2)
+	job2.Status = domain.JobStatusFailed
+	require.NoError(t, repo.Create(ctx, job2))
+
+	job3 := domain.NewJob(domain.GenerateJobID(now.Add(2*time.Second), "c"), "TRPID-AAA", 3)
+	job3.Status = domain.JobStatusCompleted
+	require.NoError(t, repo.Create(ctx, job3))
+
+	// 상태로 필터링
+	filter := domain.DefaultJobListFilter()
+	filter.Status = domain.JobStatusCompleted
+	list, total, err := repo.List(ctx, filter)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 2, total)
+}
+
+// TestJobRepo_GetLatestVersionByTransportID는 최신 버전 조회를 테스트합니다
+func TestJobRepo_GetLatestVersionByTransportID(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// Job이 없는 경우
+	version, err := repo.GetLatestVersionByTransportID(ctx, "TRPID-NEW")
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	// Job 생성
+	now := time.Now()
+	for i := 1; i <= 3; i++ {
+		job := domain.NewJob(
+			domain.GenerateJobID(now.Add(time.Duration(i)*time.Second), "abc"),
+			"TRPID-12345678",
+			i,
+		)
+		require.NoError(t, repo.Create(ctx, job))
+	}
+
+	// 최신 버전 확인
+	version, err = repo.GetLatestVersionByTransportID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, 3, version)
+}
+
+// TestJobRepo_CreateNextVersion은 동시 생성 시 버전 할당을 테스트합니다
+func TestJobRepo_CreateNextVersion(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			job := domain.NewJob(fmt.Sprintf("JOB-%02d", i), "TRPID-12345678", 0)
+			assert.NoError(t, repo.CreateNextVersion(ctx, job))
+		}(i)
+	}
+	wg.Wait()
+
+	jobs, err := repo.GetByTransportID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.Len(t, jobs, 10)
+	for i, job := range jobs {
+		assert.Equal(t, 10-i, job.Version)
+	}
+
+	// 중복 ID는 버전을 소비하지 않음
+	err = repo.CreateNextVersion(ctx, domain.NewJob("JOB-00", "TRPID-12345678", 0))
+	assert.Error(t, err)
+
+	job := domain.NewJob("JOB-NEXT", "TRPID-12345678", 0)
+	require.NoError(t, repo.CreateNextVersion(ctx, job))
+	assert.Equal(t, 11, job.Version)
+}
+
+// TestJobRepo_Update는 Job 수정을 테스트합니다
+func TestJobRepo_Update(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	job := domain.NewJob("JOB-20260118-120000-abc", "TRPID-12345678", 1)
+	require.NoError(t, repo.Create(ctx, job))
+
+	// 상태 변경 및 업데이트
+	job.Start()
+	job.Extractions = append(job.Extractions, domain.Extraction{TableName: "VBRP", RowCount: 100})
+	err := repo.Update(ctx, job)
+	require.NoError(t, err)
+
+	// 확인
+	found, err := repo.GetByID(ctx, "JOB-20260118-120000-abc")
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusRunning, found.Status)
+	assert.NotNil(t, found.StartedAt)
+	require.Len(t, found.Extractions, 1)
+	assert.Equal(t, int64(100), found.Extractions[0].RowCount)
+
+	// 존재하지 않는 Job
+	assert.Error(t, repo.Update(ctx, domain.NewJob("non-existent", "TRPID-12345678", 1)))
+}
+
+// TestJobRepo_GetByTransportID는 Transport ID로 Job 조회를 테스트합니다
+func TestJobRepo_GetByTransportID(t *testing.T) {
+	repo := NewJobRepository(openTestDB(t))
+	ctx := context.Background()
+
+	now := time.Now()
+	for i := 1; i <= 3; i++ {
+		job := domain.NewJob(
+			domain.GenerateJobID(now.Add(time.Duration(i)*time.Second), "abc"),
+			"TRPID-12345678",
+			i,
+		)
+		require.NoError(t, repo.Create(ctx, job))
+	}
+
+	jobs, err := repo.GetByTransportID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, 3, jobs[0].Version)
+}
+
+// TestJobRepo_VersionPersistsAcrossReopen은 재시작 후 버전이 이어지는지 테스트합니다
+func TestJobRepo_VersionPersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "etl.db")
+	ctx := context.Background()
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	repo := NewJobRepository(db)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, repo.CreateNextVersion(ctx, domain.NewJob(fmt.Sprintf("JOB-%d", i), "TRPID-12345678", 0)))
+	}
+	require.NoError(t, db.Close())
+
+	db, err = Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+	repo = NewJobRepository(db)
+
+	version, err := repo.GetLatestVersionByTransportID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	job := domain.NewJob("JOB-2", "TRPID-12345678", 0)
+	require.NoError(t, repo.CreateNextVersion(ctx, job))
+	assert.Equal(t, 3, job.Version)
+	assert.Equal(t, "v003", job.VersionString())
+}
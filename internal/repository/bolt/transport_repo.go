@@ -0,0 +1,279 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// TransportRepository는 bbolt 기반 Transport 저장소 구현입니다
+type TransportRepository struct {
+	db *bbolt.DB
+}
+
+// NewTransportRepository는 새로운 bbolt Transport 저장소를 생성합니다
+func NewTransportRepository(db *DB) repository.TransportRepository {
+	return &TransportRepository{db: db.db}
+}
+
+// Create는 새로운 Transport를 생성합니다
+func (r *TransportRepository) Create(ctx context.Context, transport *domain.Transport) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportsBucket)
+		if bucket.Get([]byte(transport.ID)) != nil {
+			return fmt.Errorf("transport ID '%s'가 이미 존재합니다", transport.ID)
+		}
+		if transport.Revision < 1 {
+			transport.Revision = 1
+		}
+
+		// 삭제된 같은 ID의 이력이 남아 있으면 새 Transport의 이력으로 교체
+		revisions := tx.Bucket(transportRevisionsBucket)
+		if revisions.Bucket([]byte(transport.ID)) != nil {
+			if err := revisions.DeleteBucket([]byte(transport.ID)); err != nil {
+				return fmt.Errorf("transport %s 리비전 초기화 실패: %w", transport.ID, err)
+			}
+		}
+		if err := putRevision(revisions, domain.NewTransportRevision(transport, domain.RevisionActionCreate)); err != nil {
+			return err
+		}
+		return putJSON(bucket, transport.ID, transport)
+	})
+}
+
+// GetByID는 ID로 Transport를 조회합니다
+func (r *TransportRepository) GetByID(ctx context.Context, id string) (*domain.Transport, error) {
+	var transport domain.Transport
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		found, err := getJSON(tx.Bucket(transportsBucket), id, &transport)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &transport, nil
+}
+
+// List는 Transport 목록을 조회합니다
+func (r *TransportRepository) List(ctx context.Context, offset, limit int) ([]domain.Transport, int, error) {
+	list := make([]domain.Transport, 0)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(transportsBucket).ForEach(func(k, v []byte) error {
+			var t domain.Transport
+			if err := json.Unmarshal(v, &t); err != nil {
+				return fmt.Errorf("transport %s 디코딩 실패: %w", k, err)
+			}
+			list = append(list, t)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 생성 시간 기준 정렬 (최신순)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.After(list[j].CreatedAt)
+	})
+
+	total := len(list)
+
+	// offset/limit 적용
+	if offset >= len(list) {
+		return []domain.Transport{}, total, nil
+	}
+
+	end := offset + limit
+	if end > len(list) {
+		end = len(list)
+	}
+
+	return list[offset:end], total, nil
+}
+
+// Update는 Transport를 수정합니다
+func (r *TransportRepository) Update(ctx context.Context, transport *domain.Transport) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportsBucket)
+		if bucket.Get([]byte(transport.ID)) == nil {
+			return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", transport.ID)
+		}
+
+		// UpdatedAt 갱신
+		transport.UpdatedAt = time.Now().UTC()
+
+		return putJSON(bucket, transport.ID, transport)
+	})
+}
+
+// Delete는 Transport를 삭제합니다
+// Job 이력과 버전 카운터는 GCS 경로 충돌을 막기 위해 유지합니다
+func (r *TransportRepository) Delete(ctx context.Context, id string) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportsBucket)
+		if bucket.Get([]byte(id)) == nil {
+			return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", id)
+		}
+		return bucket.Delete([]byte(id))
+	})
+}
+
+// UpdateStatus는 Transport 상태를 변경합니다
+func (r *TransportRepository) UpdateStatus(ctx context.Context, id string, status domain.TransportStatus) error {
+	return r.modify(id, func(transport *domain.Transport) error {
+		transport.Status = status
+		transport.UpdatedAt = time.Now().UTC()
+		return nil
+	})
+}
+
+// RecordScheduledRun은 스케줄러의 마지막 실행 예정 시각을 기록합니다
+func (r *TransportRepository) RecordScheduledRun(ctx context.Context, id string, firedAt time.Time) error {
+	return r.modify(id, func(transport *domain.Transport) error {
+		if transport.Schedule == nil {
+			return fmt.Errorf("transport ID '%s'에 스케줄이 없습니다", id)
+		}
+		fired := firedAt
+		transport.Schedule.LastFiredAt = &fired
+		return nil
+	})
+}
+
+// ApplyRevision은 revision의 정의로 Transport를 수정하고 리비전을 같은 트랜잭션에서 기록합니다
+func (r *TransportRepository) ApplyRevision(ctx context.Context, revision *domain.TransportRevision, expectedRevision int) (*domain.Transport, error) {
+	var updated domain.Transport
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportsBucket)
+
+		var transport domain.Transport
+		found, err := getJSON(bucket, revision.TransportID, &transport)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", revision.TransportID)
+		}
+		if expectedRevision != 0 && transport.Revision != expectedRevision {
+			return repository.ErrTransportModified
+		}
+
+		now := time.Now().UTC()
+		transport.ApplyDefinition(revision.Definition)
+		transport.Revision++
+		transport.UpdatedAt = now
+
+		stored := *revision
+		stored.Revision = transport.Revision
+		stored.CreatedAt = now
+		if err := putRevision(tx.Bucket(transportRevisionsBucket), &stored); err != nil {
+			return err
+		}
+		if err := putJSON(bucket, transport.ID, &transport); err != nil {
+			return err
+		}
+
+		revision.Revision = stored.Revision
+		revision.CreatedAt = now
+		updated = transport
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// ListRevisions는 Transport의 모든 리비전을 최신순으로 조회합니다
+func (r *TransportRepository) ListRevisions(ctx context.Context, transportID string) ([]domain.TransportRevision, error) {
+	list := make([]domain.TransportRevision, 0)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportRevisionsBucket).Bucket([]byte(transportID))
+		if bucket == nil {
+			return nil
+		}
+
+		// 키가 빅엔디안 리비전 번호이므로 역순 순회가 최신순
+		c := bucket.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var rev domain.TransportRevision
+			if err := json.Unmarshal(v, &rev); err != nil {
+				return fmt.Errorf("transport %s 리비전 %d 디코딩 실패: %w", transportID, decodeUint64(k), err)
+			}
+			list = append(list, rev)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// GetRevision은 Transport의 특정 리비전을 조회합니다
+func (r *TransportRepository) GetRevision(ctx context.Context, transportID string, revision int) (*domain.TransportRevision, error) {
+	var rev domain.TransportRevision
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		var data []byte
+		if bucket := tx.Bucket(transportRevisionsBucket).Bucket([]byte(transportID)); bucket != nil && revision > 0 {
+			data = bucket.Get(encodeUint64(uint64(revision)))
+		}
+		if data == nil {
+			return fmt.Errorf("transport ID '%s'의 리비전 %d을(를) 찾을 수 없습니다", transportID, revision)
+		}
+		if err := json.Unmarshal(data, &rev); err != nil {
+			return fmt.Errorf("transport %s 리비전 %d 디코딩 실패: %w", transportID, revision, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// putRevision은 Transport별 하위 버킷에 리비전을 저장합니다
+func putRevision(revisions *bbolt.Bucket, revision *domain.TransportRevision) error {
+	bucket, err := revisions.CreateBucketIfNotExists([]byte(revision.TransportID))
+	if err != nil {
+		return fmt.Errorf("transport %s 리비전 버킷 생성 실패: %w", revision.TransportID, err)
+	}
+	data, err := json.Marshal(revision)
+	if err != nil {
+		return fmt.Errorf("transport %s 리비전 %d 인코딩 실패: %w", revision.TransportID, revision.Revision, err)
+	}
+	return bucket.Put(encodeUint64(uint64(revision.Revision)), data)
+}
+
+// modify는 하나의 트랜잭션에서 Transport를 읽고 수정하여 저장합니다
+func (r *TransportRepository) modify(id string, fn func(transport *domain.Transport) error) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(transportsBucket)
+
+		var transport domain.Transport
+		found, err := getJSON(bucket, id, &transport)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", id)
+		}
+
+		if err := fn(&transport); err != nil {
+			return err
+		}
+		return putJSON(bucket, id, &transport)
+	})
+}
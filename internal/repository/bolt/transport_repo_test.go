@@ -0,0 +1,261 @@
+package bolt
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// TestTransportRepo_Create는 Transport 생성을 테스트합니다
+func TestTransportRepo_Create(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test Transport", "Description", []string{"TABLE1", "TABLE2"})
+
+	err := repo.Create(ctx, transport)
+	require.NoError(t, err)
+
+	// 중복 생성 시도
+	err = repo.Create(ctx, transport)
+	assert.Error(t, err)
+}
+
+// TestTransportRepo_GetByID는 ID로 Transport 조회를 테스트합니다
+func TestTransportRepo_GetByID(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 존재하지 않는 ID 조회
+	_, err := repo.GetByID(ctx, "non-existent")
+	assert.Error(t, err)
+
+	// Transport 생성 후 조회
+	transport := domain.NewTransport("TRPID-12345678", "Test Transport", "Description", []string{"TABLE1"})
+	transport.OutputFormat = domain.OutputFormatParquet
+	transport.TableOptions = map[string]domain.TableOptions{"TABLE1": {WatermarkColumn: "LAST_UPDATE_DATE"}}
+	require.NoError(t, repo.Create(ctx, transport))
+
+	found, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "Test Transport", found.Name)
+	assert.Equal(t, []string{"TABLE1"}, found.Tables)
+	assert.Equal(t, domain.OutputFormatParquet, found.OutputFormat)
+	assert.Equal(t, "LAST_UPDATE_DATE", found.WatermarkColumn("TABLE1"))
+	assert.True(t, transport.CreatedAt.Equal(found.CreatedAt))
+}
+
+// TestTransportRepo_List는 Transport 목록 조회를 테스트합니다
+func TestTransportRepo_List(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 빈 목록
+	list, total, err := repo.List(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	assert.Equal(t, 0, total)
+
+	// 여러 Transport 생성
+	now := time.Now().UTC()
+	for i := 0; i < 5; i++ {
+		transport := domain.NewTransport(
+			domain.GenerateTransportID("uuid"+string(rune('0'+i))),
+			"Transport "+string(rune('A'+i)),
+			"",
+			[]string{"TABLE"},
+		)
+		transport.CreatedAt = now.Add(time.Duration(i) * time.Second)
+		require.NoError(t, repo.Create(ctx, transport))
+	}
+
+	// 페이지네이션 테스트 (최신순)
+	list, total, err = repo.List(ctx, 0, 3)
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, "Transport E", list[0].Name)
+
+	list, total, err = repo.List(ctx, 3, 3)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 5, total)
+}
+
+// TestTransportRepo_Update는 Transport 수정을 테스트합니다
+func TestTransportRepo_Update(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Original", "Desc", []string{"TABLE1"})
+	require.NoError(t, repo.Create(ctx, transport))
+
+	// 수정
+	transport.Name = "Updated"
+	transport.Tables = []string{"TABLE1", "TABLE2"}
+	err := repo.Update(ctx, transport)
+	require.NoError(t, err)
+
+	// 확인
+	found, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "Updated", found.Name)
+	assert.Len(t, found.Tables, 2)
+
+	// 존재하지 않는 Transport
+	assert.Error(t, repo.Update(ctx, domain.NewTransport("TRPID-87654321", "Missing", "", []string{"TABLE1"})))
+}
+
+// TestTransportRepo_Delete는 Transport 삭제를 테스트합니다
+func TestTransportRepo_Delete(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	require.NoError(t, repo.Create(ctx, transport))
+
+	err := repo.Delete(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+
+	// 삭제된 Transport 조회
+	_, err = repo.GetByID(ctx, "TRPID-12345678")
+	assert.Error(t, err)
+
+	// 존재하지 않는 ID 삭제
+	err = repo.Delete(ctx, "non-existent")
+	assert.Error(t, err)
+}
+
+// TestTransportRepo_UpdateStatus는 Transport 상태 변경을 테스트합니다
+func TestTransportRepo_UpdateStatus(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	require.NoError(t, repo.Create(ctx, transport))
+
+	err := repo.UpdateStatus(ctx, "TRPID-12345678", domain.TransportStatusRunning)
+	require.NoError(t, err)
+
+	found, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusRunning, found.Status)
+
+	assert.Error(t, repo.UpdateStatus(ctx, "non-existent", domain.TransportStatusIdle))
+}
+
+// TestTransportRepo_RecordScheduledRun은 스케줄 실행 시각 기록을 테스트합니다
+func TestTransportRepo_RecordScheduledRun(t *testing.T) {
+	repo := NewTransportRepository(openTestDB(t))
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	transport.Schedule = &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "Asia/Seoul"}
+	require.NoError(t, repo.Create(ctx, transport))
+	require.NoError(t, repo.UpdateStatus(ctx, "TRPID-12345678", domain.TransportStatusRunning))
+
+	firedAt := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.RecordScheduledRun(ctx, "TRPID-12345678", firedAt))
+
+	found, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.NotNil(t, found.Schedule.LastFiredAt)
+	assert.True(t, firedAt.Equal(*found.Schedule.LastFiredAt))
+	// 상태는 변경되지 않아야 함
+	assert.Equal(t, domain.TransportStatusRunning, found.Status)
+
+	// 반환된 복사본 수정이 저장소에 영향을 주지 않아야 함
+	found.Schedule.Expression = "0 3 * * *"
+	again, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "0 2 * * *", again.Schedule.Expression)
+
+	// 스케줄이 없는 Transport / 존재하지 않는 Transport
+	require.NoError(t, repo.Create(ctx, domain.NewTransport("TRPID-87654321", "NoSchedule", "", []string{"TABLE1"})))
+	assert.Error(t, repo.RecordScheduledRun(ctx, "TRPID-87654321", firedAt))
+	assert.Error(t, repo.RecordScheduledRun(ctx, "non-existent", firedAt))
+}
+
+// TestTransportRepo_PersistsAcrossReopen은 재시작 후에도 Transport가 유지되는지 테스트합니다
+func TestTransportRepo_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "etl.db")
+	ctx := context.Background()
+
+	db, err := Open(path)
+	require.NoError(t, err)
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	transport.Schedule = &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "Asia/Seoul"}
+	require.NoError(t, NewTransportRepository(db).Create(ctx, transport))
+	require.NoError(t, db.Close())
+
+	db, err = Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	found, err := NewTransportRepository(db).GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "0 2 * * *", found.Schedule.Expression)
+}
+
+// TestTransportRepo_ApplyRevision은 정의 수정과 리비전 기록을 테스트합니다
+func TestTransportRepo_ApplyRevision(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewTransportRepository(db)
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test Transport", "", []string{"TABLE1"})
+	transport.Schedule = &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "UTC"}
+	require.NoError(t, repo.Create(ctx, transport))
+	fired := time.Date(2024, 5, 1, 2, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.RecordScheduledRun(ctx, transport.ID, fired))
+	require.NoError(t, repo.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+
+	stored, err := repo.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+
+	def := stored.Definition()
+	def.Tables = []string{"TABLE1", "TABLE2"}
+	revision := &domain.TransportRevision{TransportID: transport.ID, Action: domain.RevisionActionUpdate, Definition: def}
+
+	// 다른 리비전을 기대하면 수정하지 않음
+	_, err = repo.ApplyRevision(ctx, revision, stored.Revision+1)
+	assert.ErrorIs(t, err, repository.ErrTransportModified)
+
+	// 상태 변경으로 UpdatedAt이 바뀌어도 리비전이 같으면 수정
+	updated, err := repo.ApplyRevision(ctx, revision, stored.Revision)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, 2, revision.Revision)
+	assert.Equal(t, []string{"TABLE1", "TABLE2"}, updated.Tables)
+	assert.Equal(t, domain.TransportStatusRunning, updated.Status)
+	require.NotNil(t, updated.Schedule.LastFiredAt)
+	assert.True(t, updated.Schedule.LastFiredAt.Equal(fired))
+
+	// 재시작 후에도 이력이 최신순으로 유지됨
+	path := db.Path()
+	require.NoError(t, db.Close())
+	reopened, err := Open(path)
+	require.NoError(t, err)
+	defer reopened.Close()
+	repo = NewTransportRepository(reopened)
+
+	revisions, err := repo.ListRevisions(ctx, transport.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Equal(t, domain.RevisionActionUpdate, revisions[0].Action)
+	assert.Equal(t, 1, revisions[1].Revision)
+	assert.Equal(t, []string{"TABLE1"}, revisions[1].Definition.Tables)
+
+	_, err = repo.GetRevision(ctx, transport.ID, 3)
+	assert.Error(t, err)
+	_, err = repo.ApplyRevision(ctx, &domain.TransportRevision{TransportID: "TRPID-MISSING"}, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// WatermarkRepository는 bbolt 기반 Watermark 저장소 구현입니다
+// Transport마다 하위 버킷을 두고 테이블 이름을 키로 저장합니다
+type WatermarkRepository struct {
+	db *bbolt.DB
+}
+
+// NewWatermarkRepository는 새로운 bbolt Watermark 저장소를 생성합니다
+func NewWatermarkRepository(db *DB) repository.WatermarkRepository {
+	return &WatermarkRepository{db: db.db}
+}
+
+// Get은 Transport/테이블의 Watermark를 조회합니다 (없으면 nil, nil)
+func (r *WatermarkRepository) Get(ctx context.Context, transportID, tableName string) (*domain.Watermark, error) {
+	var (
+		watermark domain.Watermark
+		found     bool
+	)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		tables := tx.Bucket(watermarksBucket).Bucket([]byte(transportID))
+		if tables == nil {
+			return nil
+		}
+		var err error
+		found, err = getJSON(tables, tableName, &watermark)
+		return err
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return &watermark, nil
+}
+
+// ListByTransportID는 Transport의 모든 Watermark를 테이블 이름순으로 조회합니다
+// bbolt 키는 바이트 순으로 정렬되어 있으므로 별도 정렬이 필요 없습니다
+func (r *WatermarkRepository) ListByTransportID(ctx context.Context, transportID string) ([]domain.Watermark, error) {
+	list := make([]domain.Watermark, 0)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		tables := tx.Bucket(watermarksBucket).Bucket([]byte(transportID))
+		if tables == nil {
+			return nil
+		}
+		return tables.ForEach(func(k, v []byte) error {
+			var w domain.Watermark
+			if err := json.Unmarshal(v, &w); err != nil {
+				return fmt.Errorf("watermark %s 디코딩 실패: %w", k, err)
+			}
+			list = append(list, w)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Save는 Watermark를 저장합니다 (기존 값은 덮어씀)
+func (r *WatermarkRepository) Save(ctx context.Context, watermark *domain.Watermark) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		tables, err := tx.Bucket(watermarksBucket).CreateBucketIfNotExists([]byte(watermark.TransportID))
+		if err != nil {
+			return fmt.Errorf("watermark 버킷 생성 실패: %w", err)
+		}
+		return putJSON(tables, watermark.TableName, watermark)
+	})
+}
+
+// DeleteByTransportID는 Transport의 모든 Watermark를 삭제합니다
+func (r *WatermarkRepository) DeleteByTransportID(ctx context.Context, transportID string) error {
+	return r.db.Update(func(tx *bbolt.Tx) error {
+		err := tx.Bucket(watermarksBucket).DeleteBucket([]byte(transportID))
+		if errors.Is(err, bolterrors.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	})
+}
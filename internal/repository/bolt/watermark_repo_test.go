@@ -0,0 +1,88 @@
+package bolt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+)
+
+// TestWatermarkRepo_SaveAndGet은 Watermark 저장/조회를 테스트합니다
+func TestWatermarkRepo_SaveAndGet(t *testing.T) {
+	repo := NewWatermarkRepository(openTestDB(t))
+	ctx := context.Background()
+
+	// 없는 경우 nil 반환
+	found, err := repo.Get(ctx, "TRPID-12345678", "VBRP")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	value := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Save(ctx, &domain.Watermark{
+		TransportID: "TRPID-12345678",
+		TableName:   "VBRP",
+		Column:      "LAST_UPDATE_DATE",
+		Value:       value,
+		JobID:       "JOB-001",
+	}))
+
+	found, err = repo.Get(ctx, "TRPID-12345678", "VBRP")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, value, found.Value)
+
+	// 덮어쓰기
+	next := value.Add(time.Hour)
+	require.NoError(t, repo.Save(ctx, &domain.Watermark{
+		TransportID: "TRPID-12345678",
+		TableName:   "VBRP",
+		Column:      "LAST_UPDATE_DATE",
+		Value:       next,
+		JobID:       "JOB-002",
+	}))
+
+	found, err = repo.Get(ctx, "TRPID-12345678", "VBRP")
+	require.NoError(t, err)
+	assert.Equal(t, next, found.Value)
+	assert.Equal(t, "JOB-002", found.JobID)
+
+	// 다른 테이블은 없음
+	found, err = repo.Get(ctx, "TRPID-12345678", "LIKP")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+// TestWatermarkRepo_ListAndDelete는 Watermark 목록 조회 및 삭제를 테스트합니다
+func TestWatermarkRepo_ListAndDelete(t *testing.T) {
+	repo := NewWatermarkRepository(openTestDB(t))
+	ctx := context.Background()
+
+	for _, table := range []string{"VBRP", "LIKP", "VBRK"} {
+		require.NoError(t, repo.Save(ctx, &domain.Watermark{TransportID: "TRPID-A", TableName: table, Column: "LAST_UPDATE_DATE"}))
+	}
+	require.NoError(t, repo.Save(ctx, &domain.Watermark{TransportID: "TRPID-B", TableName: "VBRP", Column: "LAST_UPDATE_DATE"}))
+
+	list, err := repo.ListByTransportID(ctx, "TRPID-A")
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.Equal(t, "LIKP", list[0].TableName)
+	assert.Equal(t, "VBRP", list[2].TableName)
+
+	require.NoError(t, repo.DeleteByTransportID(ctx, "TRPID-A"))
+
+	list, err = repo.ListByTransportID(ctx, "TRPID-A")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// 없는 Transport 삭제는 에러 없음
+	require.NoError(t, repo.DeleteByTransportID(ctx, "TRPID-A"))
+
+	// 다른 Transport는 영향 없음
+	list, err = repo.ListByTransportID(ctx, "TRPID-B")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
@@ -18,6 +18,10 @@ type JobRepository interface {
 	// List는 필터에 따라 Job 목록을 조회합니다
 	List(ctx context.Context, filter domain.JobListFilter) ([]domain.Job, int, error)
 
+	// CreateNextVersion은 Transport의 다음 버전을 할당하여 Job을 생성하고 job.Version에 설정합니다
+	// 버전 조회와 저장을 원자적으로 처리하여 동시에 생성된 Job의 버전이 겹치지 않습니다
+	CreateNextVersion(ctx context.Context, job *domain.Job) error
+
 	// Update는 Job을 수정합니다
 	Update(ctx context.Context, job *domain.Job) error
 
@@ -42,6 +42,32 @@ func (r *JobRepository) Create(ctx context.Context, job *domain.Job) error {
 	return nil
 }
 
+// CreateNextVersion은 Transport의 다음 버전을 할당하여 Job을 생성합니다
+func (r *JobRepository) CreateNextVersion(ctx context.Context, job *domain.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.jobs[job.ID]; exists {
+		return fmt.Errorf("job ID '%s'가 이미 존재합니다", job.ID)
+	}
+
+	maxVersion := 0
+	for _, j := range r.jobs {
+		if j.TransportID == job.TransportID && j.Version > maxVersion {
+			maxVersion = j.Version
+		}
+	}
+	job.Version = maxVersion + 1
+
+	// 복사본 저장
+	copied := *job
+	copied.Extractions = make([]domain.Extraction, len(job.Extractions))
+	copy(copied.Extractions, job.Extractions)
+	r.jobs[job.ID] = &copied
+
+	return nil
+}
+
 // GetByID는 ID로 Job을 조회합니다
 func (r *JobRepository) GetByID(ctx context.Context, id string) (*domain.Job, error) {
 	r.mu.RLock()
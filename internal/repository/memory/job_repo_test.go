@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -204,3 +206,35 @@ func TestJobRepo_GetByTransportID(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, jobs, 3)
 }
+
+// TestJobRepo_CreateNextVersion은 동시 생성 시 버전 할당을 테스트합니다
+func TestJobRepo_CreateNextVersion(t *testing.T) {
+	repo := NewJobRepository()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			job := domain.NewJob(fmt.Sprintf("JOB-%02d", i), "TRPID-12345678", 0)
+			assert.NoError(t, repo.CreateNextVersion(ctx, job))
+		}(i)
+	}
+	wg.Wait()
+
+	jobs, err := repo.GetByTransportID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.Len(t, jobs, 10)
+	for i, job := range jobs {
+		assert.Equal(t, 10-i, job.Version)
+	}
+
+	// 중복 ID는 버전을 소비하지 않음
+	err = repo.CreateNextVersion(ctx, domain.NewJob("JOB-00", "TRPID-12345678", 0))
+	assert.Error(t, err)
+
+	job := domain.NewJob("JOB-NEXT", "TRPID-12345678", 0)
+	require.NoError(t, repo.CreateNextVersion(ctx, job))
+	assert.Equal(t, 11, job.Version)
+}
@@ -16,12 +16,14 @@ import (
 type TransportRepository struct {
 	mu         sync.RWMutex
 	transports map[string]*domain.Transport
+	revisions  map[string][]domain.TransportRevision // transportID -> 리비전 (오래된 순)
 }
 
 // NewTransportRepository는 새로운 인메모리 Transport 저장소를 생성합니다
 func NewTransportRepository() repository.TransportRepository {
 	return &TransportRepository{
 		transports: make(map[string]*domain.Transport),
+		revisions:  make(map[string][]domain.TransportRevision),
 	}
 }
 
@@ -33,10 +35,13 @@ func (r *TransportRepository) Create(ctx context.Context, transport *domain.Tran
 	if _, exists := r.transports[transport.ID]; exists {
 		return fmt.Errorf("transport ID '%s'가 이미 존재합니다", transport.ID)
 	}
+	if transport.Revision < 1 {
+		transport.Revision = 1
+	}
 
 	// 복사본 저장
-	copied := *transport
-	r.transports[transport.ID] = &copied
+	r.transports[transport.ID] = transport.Clone()
+	r.revisions[transport.ID] = []domain.TransportRevision{*domain.NewTransportRevision(transport, domain.RevisionActionCreate)}
 
 	return nil
 }
@@ -52,8 +57,7 @@ func (r *TransportRepository) GetByID(ctx context.Context, id string) (*domain.T
 	}
 
 	// 복사본 반환
-	copied := *transport
-	return &copied, nil
+	return transport.Clone(), nil
 }
 
 // List는 Transport 목록을 조회합니다
@@ -64,7 +68,7 @@ func (r *TransportRepository) List(ctx context.Context, offset, limit int) ([]do
 	// 전체 목록을 슬라이스로 변환
 	list := make([]domain.Transport, 0, len(r.transports))
 	for _, t := range r.transports {
-		list = append(list, *t)
+		list = append(list, *t.Clone())
 	}
 
 	// 생성 시간 기준 정렬 (최신순)
@@ -100,8 +104,7 @@ func (r *TransportRepository) Update(ctx context.Context, transport *domain.Tran
 	transport.UpdatedAt = time.Now().UTC()
 
 	// 복사본 저장
-	copied := *transport
-	r.transports[transport.ID] = &copied
+	r.transports[transport.ID] = transport.Clone()
 
 	return nil
 }
@@ -134,3 +137,74 @@ func (r *TransportRepository) UpdateStatus(ctx context.Context, id string, statu
 
 	return nil
 }
+
+// RecordScheduledRun은 스케줄러의 마지막 실행 예정 시각을 기록합니다
+func (r *TransportRepository) RecordScheduledRun(ctx context.Context, id string, firedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transport, exists := r.transports[id]
+	if !exists {
+		return fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", id)
+	}
+	if transport.Schedule == nil {
+		return fmt.Errorf("transport ID '%s'에 스케줄이 없습니다", id)
+	}
+
+	fired := firedAt
+	transport.Schedule.LastFiredAt = &fired
+
+	return nil
+}
+
+// ApplyRevision은 revision의 정의로 Transport를 수정하고 리비전을 함께 기록합니다
+func (r *TransportRepository) ApplyRevision(ctx context.Context, revision *domain.TransportRevision, expectedRevision int) (*domain.Transport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transport, exists := r.transports[revision.TransportID]
+	if !exists {
+		return nil, fmt.Errorf("transport ID '%s'를 찾을 수 없습니다", revision.TransportID)
+	}
+	if expectedRevision != 0 && transport.Revision != expectedRevision {
+		return nil, repository.ErrTransportModified
+	}
+
+	now := time.Now().UTC()
+	transport.ApplyDefinition(revision.Definition)
+	transport.Revision++
+	transport.UpdatedAt = now
+
+	revision.Revision = transport.Revision
+	revision.CreatedAt = now
+	r.revisions[transport.ID] = append(r.revisions[transport.ID], revision.Clone())
+
+	return transport.Clone(), nil
+}
+
+// ListRevisions는 Transport의 모든 리비전을 최신순으로 조회합니다
+func (r *TransportRepository) ListRevisions(ctx context.Context, transportID string) ([]domain.TransportRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.revisions[transportID]
+	list := make([]domain.TransportRevision, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		list = append(list, stored[i].Clone())
+	}
+	return list, nil
+}
+
+// GetRevision은 Transport의 특정 리비전을 조회합니다
+func (r *TransportRepository) GetRevision(ctx context.Context, transportID string, revision int) (*domain.TransportRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rev := range r.revisions[transportID] {
+		if rev.Revision == revision {
+			copied := rev.Clone()
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("transport ID '%s'의 리비전 %d을(를) 찾을 수 없습니다", transportID, revision)
+}
@@ -3,11 +3,13 @@ package memory
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
 )
 
 // TestTransportRepo_Create는 Transport 생성을 테스트합니다
@@ -134,3 +136,74 @@ func TestTransportRepo_UpdateStatus(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, domain.TransportStatusRunning, found.Status)
 }
+
+// TestTransportRepo_RecordScheduledRun은 스케줄 실행 시각 기록을 테스트합니다
+func TestTransportRepo_RecordScheduledRun(t *testing.T) {
+	repo := NewTransportRepository()
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	transport.Schedule = &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "Asia/Seoul"}
+	require.NoError(t, repo.Create(ctx, transport))
+	require.NoError(t, repo.UpdateStatus(ctx, "TRPID-12345678", domain.TransportStatusRunning))
+
+	firedAt := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.RecordScheduledRun(ctx, "TRPID-12345678", firedAt))
+
+	found, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.NotNil(t, found.Schedule.LastFiredAt)
+	assert.True(t, firedAt.Equal(*found.Schedule.LastFiredAt))
+	// 상태는 변경되지 않아야 함
+	assert.Equal(t, domain.TransportStatusRunning, found.Status)
+
+	// 반환된 복사본 수정이 저장소에 영향을 주지 않아야 함
+	found.Schedule.Expression = "0 3 * * *"
+	again, err := repo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, "0 2 * * *", again.Schedule.Expression)
+
+	// 스케줄이 없는 Transport / 존재하지 않는 Transport
+	require.NoError(t, repo.Create(ctx, domain.NewTransport("TRPID-87654321", "NoSchedule", "", []string{"TABLE1"})))
+	assert.Error(t, repo.RecordScheduledRun(ctx, "TRPID-87654321", firedAt))
+	assert.Error(t, repo.RecordScheduledRun(ctx, "non-existent", firedAt))
+}
+
+// TestTransportRepo_ApplyRevision은 정의 수정과 리비전 기록을 테스트합니다
+func TestTransportRepo_ApplyRevision(t *testing.T) {
+	repo := NewTransportRepository()
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test Transport", "", []string{"TABLE1"})
+	require.NoError(t, repo.Create(ctx, transport))
+
+	def := transport.Definition()
+	def.Name = "Renamed"
+	revision := &domain.TransportRevision{TransportID: transport.ID, Action: domain.RevisionActionUpdate, Definition: def}
+
+	_, err := repo.ApplyRevision(ctx, revision, transport.Revision+1)
+	assert.ErrorIs(t, err, repository.ErrTransportModified)
+
+	// 상태 변경으로 UpdatedAt이 바뀌어도 리비전이 같으면 수정
+	require.NoError(t, repo.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+	updated, err := repo.ApplyRevision(ctx, revision, transport.Revision)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, "Renamed", updated.Name)
+
+	// 반환된 리비전을 수정해도 저장된 이력에 영향 없음
+	revisions, err := repo.ListRevisions(ctx, transport.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Equal(t, domain.RevisionActionCreate, revisions[1].Action)
+	revisions[1].Definition.Tables[0] = "CHANGED"
+
+	first, err := repo.GetRevision(ctx, transport.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Transport", first.Definition.Name)
+	assert.Equal(t, []string{"TABLE1"}, first.Definition.Tables)
+
+	_, err = repo.GetRevision(ctx, transport.ID, 3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Package memory는 개발 및 테스트용 인메모리 저장소 구현을 제공합니다.
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// WatermarkRepository는 인메모리 Watermark 저장소 구현입니다
+type WatermarkRepository struct {
+	mu         sync.RWMutex
+	watermarks map[string]map[string]domain.Watermark // transportID -> tableName -> Watermark
+}
+
+// NewWatermarkRepository는 새로운 인메모리 Watermark 저장소를 생성합니다
+func NewWatermarkRepository() repository.WatermarkRepository {
+	return &WatermarkRepository{
+		watermarks: make(map[string]map[string]domain.Watermark),
+	}
+}
+
+// Get은 Transport/테이블의 Watermark를 조회합니다 (없으면 nil, nil)
+func (r *WatermarkRepository) Get(ctx context.Context, transportID, tableName string) (*domain.Watermark, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	watermark, exists := r.watermarks[transportID][tableName]
+	if !exists {
+		return nil, nil
+	}
+	return &watermark, nil
+}
+
+// ListByTransportID는 Transport의 모든 Watermark를 테이블 이름순으로 조회합니다
+func (r *WatermarkRepository) ListByTransportID(ctx context.Context, transportID string) ([]domain.Watermark, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]domain.Watermark, 0, len(r.watermarks[transportID]))
+	for _, w := range r.watermarks[transportID] {
+		list = append(list, w)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].TableName < list[j].TableName
+	})
+	return list, nil
+}
+
+// Save는 Watermark를 저장합니다 (기존 값은 덮어씀)
+func (r *WatermarkRepository) Save(ctx context.Context, watermark *domain.Watermark) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tables, exists := r.watermarks[watermark.TransportID]
+	if !exists {
+		tables = make(map[string]domain.Watermark)
+		r.watermarks[watermark.TransportID] = tables
+	}
+	tables[watermark.TableName] = *watermark
+
+	return nil
+}
+
+// DeleteByTransportID는 Transport의 모든 Watermark를 삭제합니다
+func (r *WatermarkRepository) DeleteByTransportID(ctx context.Context, transportID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.watermarks, transportID)
+	return nil
+}
@@ -3,13 +3,18 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"oracle-etl/internal/domain"
 )
 
+// ErrTransportModified는 조건부 수정 시 Transport가 그 사이 다른 요청에 의해 변경된 경우 반환됩니다
+var ErrTransportModified = errors.New("transport가 다른 요청에 의해 수정되었습니다")
+
 // TransportRepository는 Transport 저장소 인터페이스입니다
 type TransportRepository interface {
-	// Create는 새로운 Transport를 생성합니다
+	// Create는 새로운 Transport를 생성하고 현재 정의를 첫 리비전으로 기록합니다
 	Create(ctx context.Context, transport *domain.Transport) error
 
 	// GetByID는 ID로 Transport를 조회합니다
@@ -26,4 +31,19 @@ type TransportRepository interface {
 
 	// UpdateStatus는 Transport 상태를 변경합니다
 	UpdateStatus(ctx context.Context, id string, status domain.TransportStatus) error
+
+	// RecordScheduledRun은 스케줄러가 실행한 예정 시각을 기록합니다
+	// 재시작 후 같은 예정 시각이 중복 실행되지 않도록 상태 변경과 분리하여 저장합니다
+	RecordScheduledRun(ctx context.Context, id string, firedAt time.Time) error
+
+	// ApplyRevision은 revision의 정의로 Transport를 수정하고 리비전을 함께 기록합니다
+	// 리비전 번호와 기록 시간은 저장 시 할당되며 상태와 스케줄 실행 기록은 유지됩니다
+	// expectedRevision이 0이 아니고 저장된 Revision과 다르면 ErrTransportModified를 반환합니다
+	ApplyRevision(ctx context.Context, revision *domain.TransportRevision, expectedRevision int) (*domain.Transport, error)
+
+	// ListRevisions는 Transport의 모든 리비전을 최신순으로 조회합니다
+	ListRevisions(ctx context.Context, transportID string) ([]domain.TransportRevision, error)
+
+	// GetRevision은 Transport의 특정 리비전을 조회합니다
+	GetRevision(ctx context.Context, transportID string, revision int) (*domain.TransportRevision, error)
 }
@@ -0,0 +1,23 @@
+// Package repository는 데이터 저장소 인터페이스를 정의합니다.
+package repository
+
+import (
+	"context"
+
+	"oracle-etl/internal/domain"
+)
+
+// WatermarkRepository는 증분 추출 기준값 저장소 인터페이스입니다
+type WatermarkRepository interface {
+	// Get은 Transport/테이블의 Watermark를 조회합니다 (없으면 nil, nil)
+	Get(ctx context.Context, transportID, tableName string) (*domain.Watermark, error)
+
+	// ListByTransportID는 Transport의 모든 Watermark를 테이블 이름순으로 조회합니다
+	ListByTransportID(ctx context.Context, transportID string) ([]domain.Watermark, error)
+
+	// Save는 Watermark를 저장합니다 (기존 값은 덮어씀)
+	Save(ctx context.Context, watermark *domain.Watermark) error
+
+	// DeleteByTransportID는 Transport의 모든 Watermark를 삭제합니다
+	DeleteByTransportID(ctx context.Context, transportID string) error
+}
@@ -45,6 +45,9 @@ type CircuitConfig struct {
 	SuccessThreshold int
 	// Timeout은 Open 상태에서 Half-Open으로 전환하기까지의 대기 시간
 	Timeout time.Duration
+	// IsFailure는 에러를 의존성 장애로 집계할지 판단하는 함수입니다
+	// nil이면 모든 에러를 실패로 집계하며, false인 에러는 성공과 같이 처리됩니다
+	IsFailure func(error) bool
 }
 
 // DefaultCircuitConfig는 기본 Circuit Breaker 설정을 반환합니다
@@ -76,6 +79,8 @@ type CircuitBreaker struct {
 
 	lastFailure time.Time // 마지막 실패 시간
 
+	onStateChange func(from, to State) // 상태 전환 알림 (nil이면 알리지 않음)
+
 	// 메트릭
 	totalRequests int64
 	totalSuccesses int64
@@ -106,9 +111,31 @@ func (cb *CircuitBreaker) State() State {
 	return cb.state
 }
 
+// SetOnStateChange는 상태가 전환될 때 호출할 함수를 설정합니다
+// fn은 락을 보유하지 않은 상태로 호출됩니다
+func (cb *CircuitBreaker) SetOnStateChange(fn func(from, to State)) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.onStateChange = fn
+}
+
 // Execute는 주어진 함수를 Circuit Breaker로 보호하여 실행합니다
 func (cb *CircuitBreaker) Execute(fn func() error) error {
+	if err := cb.Allow(); err != nil {
+		return err
+	}
+
+	// 함수 실행
+	err := fn()
+	cb.Record(err)
+	return err
+}
+
+// Allow는 요청을 허용할지 확인합니다 (Open 상태면 ErrCircuitOpen)
+// 허용된 요청은 결과를 Record로 기록해야 합니다
+func (cb *CircuitBreaker) Allow() error {
 	cb.mu.Lock()
+	from := cb.state
 
 	// Open 상태 확인 및 타임아웃 처리
 	if cb.state == StateOpen {
@@ -125,21 +152,35 @@ func (cb *CircuitBreaker) Execute(fn func() error) error {
 	}
 
 	cb.totalRequests++
+	notify := cb.transition(from)
 	cb.mu.Unlock()
 
-	// 함수 실행
-	err := fn()
+	notify()
+	return nil
+}
 
+// Record는 Allow로 허용된 요청의 결과를 기록합니다
+func (cb *CircuitBreaker) Record(err error) {
 	cb.mu.Lock()
-	defer cb.mu.Unlock()
-
-	if err != nil {
+	from := cb.state
+	if err != nil && (cb.config.IsFailure == nil || cb.config.IsFailure(err)) {
 		cb.onFailure()
 	} else {
 		cb.onSuccess()
 	}
+	notify := cb.transition(from)
+	cb.mu.Unlock()
 
-	return err
+	notify()
+}
+
+// transition은 상태가 from에서 바뀌었으면 알림 함수를, 아니면 빈 함수를 반환합니다 (락 보유 상태)
+func (cb *CircuitBreaker) transition(from State) func() {
+	to, fn := cb.state, cb.onStateChange
+	if from == to || fn == nil {
+		return func() {}
+	}
+	return func() { fn(from, to) }
 }
 
 // onSuccess는 성공 시 호출됩니다 (락 보유 상태)
@@ -244,3 +244,72 @@ func TestCircuitBreaker_Metrics(t *testing.T) {
 func TestErrCircuitOpen(t *testing.T) {
 	assert.Equal(t, "circuit breaker is open", ErrCircuitOpen.Error())
 }
+
+// TestCircuitBreaker_AllowRecord는 Allow/Record로 직접 보호하는 흐름을 테스트합니다
+func TestCircuitBreaker_AllowRecord(t *testing.T) {
+	cfg := CircuitConfig{
+		FailureThreshold: 2,
+		SuccessThreshold: 1,
+		Timeout:          50 * time.Millisecond,
+	}
+	cb := NewCircuitBreaker(cfg)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, cb.Allow())
+		cb.Record(errors.New("error"))
+	}
+	assert.Equal(t, StateOpen, cb.State())
+	assert.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, cb.Allow())
+	assert.Equal(t, StateHalfOpen, cb.State())
+	cb.Record(nil)
+	assert.Equal(t, StateClosed, cb.State())
+
+	metrics := cb.Metrics()
+	assert.Equal(t, int64(3), metrics.TotalRequests)
+	assert.Equal(t, int64(1), metrics.Rejections)
+}
+
+// TestCircuitBreaker_IsFailure는 IsFailure가 false인 에러를 실패로 집계하지 않는지 테스트합니다
+func TestCircuitBreaker_IsFailure(t *testing.T) {
+	permanent := errors.New("permanent")
+	cfg := CircuitConfig{
+		FailureThreshold: 1,
+		SuccessThreshold: 1,
+		Timeout:          time.Second,
+		IsFailure:        func(err error) bool { return !errors.Is(err, permanent) },
+	}
+	cb := NewCircuitBreaker(cfg)
+
+	err := cb.Execute(func() error { return permanent })
+	assert.ErrorIs(t, err, permanent)
+	assert.Equal(t, StateClosed, cb.State())
+
+	_ = cb.Execute(func() error { return errors.New("transient") })
+	assert.Equal(t, StateOpen, cb.State())
+}
+
+// TestCircuitBreaker_OnStateChange는 상태 전환 알림을 테스트합니다
+func TestCircuitBreaker_OnStateChange(t *testing.T) {
+	cfg := CircuitConfig{
+		FailureThreshold: 1,
+		SuccessThreshold: 1,
+		Timeout:          50 * time.Millisecond,
+	}
+	cb := NewCircuitBreaker(cfg)
+
+	var transitions []string
+	cb.SetOnStateChange(func(from, to State) {
+		// 락 없이 호출되므로 상태 조회가 가능해야 함
+		assert.Equal(t, to, cb.State())
+		transitions = append(transitions, from.String()+"->"+to.String())
+	})
+
+	_ = cb.Execute(func() error { return errors.New("error") })
+	time.Sleep(60 * time.Millisecond)
+	_ = cb.Execute(func() error { return nil })
+
+	assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, transitions)
+}
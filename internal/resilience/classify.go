@@ -0,0 +1,63 @@
+// Package resilience는 시스템 복원력을 위한 패턴을 제공합니다.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"syscall"
+
+	"google.golang.org/api/googleapi"
+
+	apperrors "oracle-etl/internal/errors"
+)
+
+// transientHTTPCodes는 재시도로 회복될 수 있는 GCS HTTP 상태 코드입니다
+var transientHTTPCodes = map[int]bool{
+	408: true, // Request Timeout
+	429: true, // Too Many Requests
+	500: true, // Internal Server Error
+	502: true, // Bad Gateway
+	503: true, // Service Unavailable
+	504: true, // Gateway Timeout
+}
+
+// googleapiPattern은 에러 메시지에서 GCS HTTP 상태 코드를 찾습니다
+var googleapiPattern = regexp.MustCompile(`googleapi: Error (\d{3})`)
+
+// IsTransient는 에러가 재시도로 회복될 수 있는 일시적 장애인지 판단합니다
+// 일시적 ORA- 코드(apperrors의 Oracle 에러 분류 기준), GCS 5xx/429/408 응답, 네트워크 타임아웃/연결 리셋이 해당되며
+// 컨텍스트 취소와 Circuit Open은 재시도 대상이 아닙니다
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	if code, ok := apperrors.OraCode(err); ok {
+		return apperrors.IsTransientOraCode(code)
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return transientHTTPCodes[apiErr.Code]
+	}
+	if m := googleapiPattern.FindStringSubmatch(err.Error()); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return transientHTTPCodes[code]
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
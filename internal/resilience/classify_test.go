@@ -0,0 +1,54 @@
+// Package resilience는 시스템 복원력을 위한 패턴을 제공합니다.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+)
+
+// oraError는 드라이버 에러(Code() 메서드)를 흉내냅니다
+type oraError struct{ code int }
+
+func (e *oraError) Error() string { return fmt.Sprintf("ORA-%05d: test", e.code) }
+func (e *oraError) Code() int     { return e.code }
+
+// TestIsTransient는 일시적 장애 분류를 테스트합니다
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"일반 에러", errors.New("boom"), false},
+		{"ORA-03113 메시지", errors.New("ORA-03113: end-of-file on communication channel"), true},
+		{"ORA-12541 래핑", fmt.Errorf("쿼리 실패: %w", errors.New("ORA-12541: TNS:no listener")), true},
+		{"ORA-12516 핸들러 없음", errors.New("ORA-12516: TNS:listener could not find available handler"), true},
+		{"ORA-00942 영구 에러", errors.New("ORA-00942: table or view does not exist"), false},
+		{"ORA-01555 스냅샷", errors.New("ORA-01555: snapshot too old"), false},
+		{"드라이버 코드", fmt.Errorf("fetch: %w", &oraError{code: 60}), true},
+		{"드라이버 영구 코드", &oraError{code: 1017}, false},
+		{"GCS 503", &googleapi.Error{Code: 503}, true},
+		{"GCS 429 래핑", fmt.Errorf("업로드 실패: %w", &googleapi.Error{Code: 429}), true},
+		{"GCS 403", &googleapi.Error{Code: 403}, false},
+		{"GCS 메시지", errors.New("googleapi: Error 502: bad gateway"), true},
+		{"GCS 404 메시지", errors.New("googleapi: Error 404: not found"), false},
+		{"연결 리셋", fmt.Errorf("write: %w", syscall.ECONNRESET), true},
+		{"예기치 않은 EOF", io.ErrUnexpectedEOF, true},
+		{"컨텍스트 취소", fmt.Errorf("ORA-03113: %w", context.Canceled), false},
+		{"Circuit Open", fmt.Errorf("oracle: %w", ErrCircuitOpen), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTransient(tt.err))
+		})
+	}
+}
@@ -4,6 +4,7 @@ package resilience
 
 import (
 	"context"
+	"math/rand"
 	"time"
 )
 
@@ -17,9 +18,14 @@ type RetryConfig struct {
 	MaxDelay time.Duration
 	// Multiplier는 지수 백오프 배수입니다
 	Multiplier float64
+	// Jitter는 대기 시간에 적용할 무작위 편차 비율입니다 (0~1, 0.2면 ±20%)
+	// 여러 작업이 같은 장애로 동시에 실패했을 때 재시도가 한꺼번에 몰리지 않도록 합니다
+	Jitter float64
 	// RetryableFunc은 에러가 재시도 가능한지 판단하는 함수입니다
 	// nil이면 모든 에러가 재시도 가능으로 간주됩니다
 	RetryableFunc func(error) bool
+	// OnRetry는 재시도 대기 직전에 호출됩니다 (attempt는 실패한 시도 번호, 1부터 시작)
+	OnRetry func(attempt int, err error, delay time.Duration)
 }
 
 // DefaultRetryConfig는 기본 재시도 설정을 반환합니다
@@ -66,10 +72,14 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 		}
 
 		// 지수 백오프 대기
+		wait := cfg.jittered(delay)
+		if cfg.OnRetry != nil {
+			cfg.OnRetry(attempt+1, lastErr, wait)
+		}
 		select {
 		case <-ctx.Done():
 			return lastErr
-		case <-time.After(delay):
+		case <-time.After(wait):
 		}
 
 		// 다음 대기 시간 계산
@@ -116,10 +126,14 @@ func RetryWithResult[T any](ctx context.Context, cfg RetryConfig, fn func() (T,
 		}
 
 		// 지수 백오프 대기
+		wait := cfg.jittered(delay)
+		if cfg.OnRetry != nil {
+			cfg.OnRetry(attempt+1, lastErr, wait)
+		}
 		select {
 		case <-ctx.Done():
 			return result, lastErr
-		case <-time.After(delay):
+		case <-time.After(wait):
 		}
 
 		// 다음 대기 시간 계산
@@ -131,3 +145,15 @@ func RetryWithResult[T any](ctx context.Context, cfg RetryConfig, fn func() (T,
 
 	return result, lastErr
 }
+
+// jittered는 Jitter 비율만큼 무작위로 늘리거나 줄인 대기 시간을 반환합니다
+func (cfg RetryConfig) jittered(delay time.Duration) time.Duration {
+	if cfg.Jitter <= 0 || delay <= 0 {
+		return delay
+	}
+	jitter := cfg.Jitter
+	if jitter > 1 {
+		jitter = 1
+	}
+	return time.Duration(float64(delay) * (1 + jitter*(2*rand.Float64()-1)))
+}
@@ -246,3 +246,43 @@ func TestRetry_ZeroMaxRetries(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, 1, attempts)
 }
+
+// TestRetry_OnRetry는 재시도 직전 콜백 호출을 테스트합니다
+func TestRetry_OnRetry(t *testing.T) {
+	cfg := RetryConfig{
+		MaxRetries:   3,
+		InitialDelay: 5 * time.Millisecond,
+		MaxDelay:     20 * time.Millisecond,
+		Multiplier:   2.0,
+	}
+	var attempts []int
+	var delays []time.Duration
+	cfg.OnRetry = func(attempt int, err error, delay time.Duration) {
+		assert.Error(t, err)
+		attempts = append(attempts, attempt)
+		delays = append(delays, delay)
+	}
+
+	err := Retry(context.Background(), cfg, func() error {
+		return errors.New("error")
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2}, attempts)
+	assert.Equal(t, []time.Duration{5 * time.Millisecond, 10 * time.Millisecond}, delays)
+}
+
+// TestRetryConfig_Jitter는 지터가 지정 범위 안에서 적용되는지 테스트합니다
+func TestRetryConfig_Jitter(t *testing.T) {
+	base := 100 * time.Millisecond
+
+	cfg := RetryConfig{}
+	assert.Equal(t, base, cfg.jittered(base))
+
+	cfg.Jitter = 0.2
+	for i := 0; i < 100; i++ {
+		d := cfg.jittered(base)
+		assert.GreaterOrEqual(t, d, 80*time.Millisecond)
+		assert.LessOrEqual(t, d, 120*time.Millisecond)
+	}
+}
@@ -0,0 +1,256 @@
+// Package telemetry는 ETL 파이프라인의 운영 지표(Prometheus 메트릭)와 분산 추적(OpenTelemetry)을 제공합니다.
+package telemetry
+
+import (
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/governor"
+	"oracle-etl/internal/resilience"
+	"oracle-etl/pkg/metrics"
+)
+
+// namespace는 모든 메트릭 이름의 접두사입니다
+const namespace = "oracle_etl"
+
+var (
+	// chunkBuckets는 청크 처리 시간 버킷입니다 (초, 청크당 수 ms ~ 수십 초)
+	chunkBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+	// uploadBuckets는 GCS 객체 업로드 시간 버킷입니다 (초, 소형 테이블 ~ 수 시간 대형 테이블)
+	uploadBuckets = []float64{1, 5, 15, 30, 60, 300, 900, 1800, 3600, 7200}
+	// throughputBuckets는 GCS 업로드 처리량 버킷입니다 (바이트/초, 64KB/s ~ 1GB/s)
+	throughputBuckets = []float64{1 << 16, 1 << 18, 1 << 20, 1 << 22, 1 << 24, 1 << 26, 1 << 28, 1 << 30}
+	// ratioBuckets는 압축률(원본/압축 후) 버킷입니다
+	ratioBuckets = []float64{1, 1.5, 2, 3, 4, 5, 7.5, 10, 15, 20}
+	// jobBuckets는 Job 실행 시간 버킷입니다 (초, 1분 ~ 12시간)
+	jobBuckets = []float64{60, 300, 600, 1800, 3600, 7200, 14400, 28800, 43200}
+)
+
+// Metrics는 ETL 파이프라인 메트릭을 수집합니다
+// nil Metrics의 기록 메서드는 아무 동작도 하지 않으므로 메트릭 없이도 사용할 수 있습니다
+type Metrics struct {
+	registry *metrics.Registry
+
+	rowsExtracted    *metrics.CounterVec
+	bytesExtracted   *metrics.CounterVec
+	chunkDuration    *metrics.HistogramVec
+	uploadedBytes    *metrics.CounterVec
+	uploadDuration   *metrics.HistogramVec
+	uploadThroughput *metrics.HistogramVec
+	compressionRatio *metrics.HistogramVec
+	jobs             *metrics.CounterVec
+	jobDuration      *metrics.HistogramVec
+
+	mu       sync.RWMutex
+	dbStats  map[string]func() sql.DBStats         // 이름별 커넥션 풀 통계
+	breakers map[string]*resilience.CircuitBreaker // 이름별 Circuit Breaker
+}
+
+// NewMetrics는 ETL 메트릭을 새 Registry에 등록하여 생성합니다
+func NewMetrics() *Metrics {
+	reg := metrics.NewRegistry()
+	m := &Metrics{
+		registry: reg,
+		rowsExtracted: reg.NewCounterVec(namespace+"_rows_extracted_total",
+			"Oracle에서 추출한 row 수", "transport", "table"),
+		bytesExtracted: reg.NewCounterVec(namespace+"_bytes_extracted_total",
+			"출력 형식으로 인코딩된 압축 전 바이트 수", "transport", "table"),
+		chunkDuration: reg.NewHistogramVec(namespace+"_chunk_duration_seconds",
+			"청크 하나를 fetch하여 업로드 파이프라인에 전달하기까지의 시간", chunkBuckets, "transport", "table"),
+		uploadedBytes: reg.NewCounterVec(namespace+"_gcs_uploaded_bytes_total",
+			"GCS에 업로드된 압축 후 바이트 수", "transport", "table"),
+		uploadDuration: reg.NewHistogramVec(namespace+"_gcs_upload_duration_seconds",
+			"GCS 객체 하나의 업로드 시간", uploadBuckets, "transport"),
+		uploadThroughput: reg.NewHistogramVec(namespace+"_gcs_upload_throughput_bytes_per_second",
+			"GCS 객체별 업로드 처리량 (압축 후 바이트/초)", throughputBuckets, "transport"),
+		compressionRatio: reg.NewHistogramVec(namespace+"_compression_ratio",
+			"GCS 객체별 압축률 (압축 전 바이트 / 압축 후 바이트)", ratioBuckets, "transport"),
+		jobs: reg.NewCounterVec(namespace+"_jobs_total",
+			"종료된 Job 수 (outcome: completed, failed, cancelled)", "transport", "outcome"),
+		jobDuration: reg.NewHistogramVec(namespace+"_job_duration_seconds",
+			"Job 실행 시간", jobBuckets, "transport", "outcome"),
+		dbStats:  make(map[string]func() sql.DBStats),
+		breakers: make(map[string]*resilience.CircuitBreaker),
+	}
+
+	reg.NewGaugeFunc(namespace+"_db_connections", "커넥션 풀의 커넥션 수 (state: in_use, idle, open)",
+		[]string{"pool", "state"}, m.collectDBConnections)
+	reg.NewGaugeFunc(namespace+"_db_max_open_connections", "커넥션 풀의 최대 커넥션 수",
+		[]string{"pool"}, m.collectDB(func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) }))
+	reg.NewCounterFunc(namespace+"_db_wait_total", "커넥션을 얻기 위해 대기한 횟수",
+		[]string{"pool"}, m.collectDB(func(s sql.DBStats) float64 { return float64(s.WaitCount) }))
+	reg.NewCounterFunc(namespace+"_db_wait_seconds_total", "커넥션을 얻기 위해 대기한 총 시간",
+		[]string{"pool"}, m.collectDB(func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() }))
+
+	reg.NewGaugeFunc(namespace+"_circuit_breaker_state", "Circuit Breaker 상태 (0: closed, 1: open, 2: half-open)",
+		[]string{"name"}, m.collectBreakers(func(cb *resilience.CircuitBreaker) float64 { return float64(cb.State()) }))
+	reg.NewCounterFunc(namespace+"_circuit_breaker_requests_total", "Circuit Breaker를 통과한 요청 수",
+		[]string{"name"}, m.collectBreakers(func(cb *resilience.CircuitBreaker) float64 { return float64(cb.Metrics().TotalRequests) }))
+	reg.NewCounterFunc(namespace+"_circuit_breaker_failures_total", "Circuit Breaker가 기록한 실패 수",
+		[]string{"name"}, m.collectBreakers(func(cb *resilience.CircuitBreaker) float64 { return float64(cb.Metrics().Failures) }))
+	reg.NewCounterFunc(namespace+"_circuit_breaker_rejections_total", "Open 상태에서 거부된 요청 수",
+		[]string{"name"}, m.collectBreakers(func(cb *resilience.CircuitBreaker) float64 { return float64(cb.Metrics().Rejections) }))
+
+	return m
+}
+
+// Registry는 메트릭 Registry를 반환합니다 (/metrics 핸들러용)
+func (m *Metrics) Registry() *metrics.Registry {
+	return m.registry
+}
+
+// ObserveChunk는 추출된 청크의 row 수와 처리 시간을 기록합니다
+func (m *Metrics) ObserveChunk(transportID, table string, rows int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.rowsExtracted.Add(float64(rows), transportID, table)
+	m.chunkDuration.Observe(duration.Seconds(), transportID, table)
+}
+
+// ObserveUpload는 GCS에 업로드된 객체 하나의 크기, 시간, 처리량, 압축률을 기록합니다
+// uploaded는 압축 후, original은 압축 전 바이트 수입니다
+func (m *Metrics) ObserveUpload(transportID, table string, uploaded, original int64, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.bytesExtracted.Add(float64(original), transportID, table)
+	m.uploadedBytes.Add(float64(uploaded), transportID, table)
+	m.uploadDuration.Observe(duration.Seconds(), transportID)
+	if duration > 0 {
+		m.uploadThroughput.Observe(float64(uploaded)/duration.Seconds(), transportID)
+	}
+	if uploaded > 0 {
+		m.compressionRatio.Observe(float64(original)/float64(uploaded), transportID)
+	}
+}
+
+// ObserveJob은 종료된 Job의 결과와 실행 시간을 기록합니다
+func (m *Metrics) ObserveJob(transportID string, outcome domain.JobStatus, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.jobs.Inc(transportID, string(outcome))
+	m.jobDuration.Observe(duration.Seconds(), transportID, string(outcome))
+}
+
+// RegisterDBStats는 이름(pool label)으로 커넥션 풀 통계를 노출합니다 (같은 이름이면 교체)
+func (m *Metrics) RegisterDBStats(name string, stats func() sql.DBStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dbStats[name] = stats
+}
+
+// RegisterCircuitBreaker는 이름(name label)으로 Circuit Breaker 상태와 통계를 노출합니다 (같은 이름이면 교체)
+func (m *Metrics) RegisterCircuitBreaker(name string, cb *resilience.CircuitBreaker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.breakers[name] = cb
+}
+
+// RegisterWorkerQueue는 워커 풀에서 대기 중인 작업 수(queued)와 완료되지 않은 작업 수(pending)를 노출합니다
+// 한 번만 호출해야 합니다
+func (m *Metrics) RegisterWorkerQueue(stats func() (queued, pending int)) {
+	m.registry.NewGaugeFunc(namespace+"_worker_queue_depth", "워커 풀 큐에서 실행을 기다리는 작업 수",
+		nil, func(emit metrics.Emit) {
+			queued, _ := stats()
+			emit(float64(queued))
+		})
+	m.registry.NewGaugeFunc(namespace+"_worker_pending_tasks", "제출되었으나 완료되지 않은 작업 수 (실행 중 포함)",
+		nil, func(emit metrics.Emit) {
+			_, pending := stats()
+			emit(float64(pending))
+		})
+}
+
+// RegisterLoadGovernor는 추출 부하 조절 상태, 열린 추출 커서 수, 마지막으로 조회한 데이터베이스 부하 지표를 노출합니다
+// 한 번만 호출해야 합니다
+func (m *Metrics) RegisterLoadGovernor(g *governor.Governor) {
+	m.registry.NewGaugeFunc(namespace+"_load_governor_state", "추출 부하 조절 상태 (0: normal, 1: slow, 2: paused)",
+		nil, func(emit metrics.Emit) {
+			emit(float64(g.Status().Mode.Level()))
+		})
+	m.registry.NewGaugeFunc(namespace+"_oracle_cursors_active", "모든 Job에서 열린 Oracle 추출 커서 수",
+		nil, func(emit metrics.Emit) {
+			emit(float64(g.Status().ActiveCursors))
+		})
+	m.registry.NewGaugeFunc(namespace+"_oracle_host_cpu_percent", "마지막으로 조회한 Oracle 호스트 CPU 사용률 (V$SYSMETRIC)",
+		nil, func(emit metrics.Emit) {
+			emit(g.Status().HostCPU)
+		})
+	m.registry.NewGaugeFunc(namespace+"_oracle_active_sessions", "마지막으로 조회한 Oracle 평균 활성 세션 수 (V$SYSMETRIC)",
+		nil, func(emit metrics.Emit) {
+			emit(g.Status().ActiveSessions)
+		})
+}
+
+// RegisterSSEClients는 연결된 SSE 클라이언트 수를 노출합니다 (한 번만 호출해야 합니다)
+func (m *Metrics) RegisterSSEClients(count func() int) {
+	m.registry.NewGaugeFunc(namespace+"_sse_clients", "연결된 SSE 클라이언트 수",
+		nil, func(emit metrics.Emit) {
+			emit(float64(count()))
+		})
+}
+
+// collectDBConnections는 커넥션 풀별 상태별 커넥션 수를 기록합니다
+func (m *Metrics) collectDBConnections(emit metrics.Emit) {
+	names, funcs := m.dbSnapshot()
+	for i, name := range names {
+		stats := funcs[i]()
+		emit(float64(stats.InUse), name, "in_use")
+		emit(float64(stats.Idle), name, "idle")
+		emit(float64(stats.OpenConnections), name, "open")
+	}
+}
+
+// collectDB는 커넥션 풀별로 value 값을 기록하는 수집 함수를 반환합니다
+func (m *Metrics) collectDB(value func(sql.DBStats) float64) func(metrics.Emit) {
+	return func(emit metrics.Emit) {
+		names, funcs := m.dbSnapshot()
+		for i, name := range names {
+			emit(value(funcs[i]()), name)
+		}
+	}
+}
+
+// collectBreakers는 Circuit Breaker별로 value 값을 기록하는 수집 함수를 반환합니다
+func (m *Metrics) collectBreakers(value func(*resilience.CircuitBreaker) float64) func(metrics.Emit) {
+	return func(emit metrics.Emit) {
+		m.mu.RLock()
+		names := sortedKeys(m.breakers)
+		breakers := make([]*resilience.CircuitBreaker, len(names))
+		for i, name := range names {
+			breakers[i] = m.breakers[name]
+		}
+		m.mu.RUnlock()
+
+		for i, name := range names {
+			emit(value(breakers[i]), name)
+		}
+	}
+}
+
+// dbSnapshot은 등록된 커넥션 풀 이름(정렬)과 통계 함수를 반환합니다
+func (m *Metrics) dbSnapshot() ([]string, []func() sql.DBStats) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	names := sortedKeys(m.dbStats)
+	funcs := make([]func() sql.DBStats, len(names))
+	for i, name := range names {
+		funcs[i] = m.dbStats[name]
+	}
+	return names, funcs
+}
+
+// sortedKeys는 map의 키를 정렬하여 반환합니다
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,101 @@
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/governor"
+	"oracle-etl/internal/resilience"
+)
+
+func render(t *testing.T, m *Metrics) string {
+	t.Helper()
+	var sb strings.Builder
+	require.NoError(t, m.Registry().Write(&sb))
+	return sb.String()
+}
+
+func TestMetrics_NilSafe(t *testing.T) {
+	var m *Metrics
+	assert.NotPanics(t, func() {
+		m.ObserveChunk("TRP-001", "MARA", 10, time.Second)
+		m.ObserveUpload("TRP-001", "MARA", 10, 100, time.Second)
+		m.ObserveJob("TRP-001", domain.JobStatusCompleted, time.Minute)
+	})
+}
+
+func TestMetrics_Pipeline(t *testing.T) {
+	m := NewMetrics()
+	m.ObserveChunk("TRP-001", "MARA", 1000, 200*time.Millisecond)
+	m.ObserveChunk("TRP-001", "MARA", 500, 100*time.Millisecond)
+	m.ObserveUpload("TRP-001", "MARA", 1<<20, 5<<20, 2*time.Second)
+	m.ObserveJob("TRP-001", domain.JobStatusFailed, 90*time.Second)
+
+	out := render(t, m)
+	assert.Contains(t, out, `oracle_etl_rows_extracted_total{table="MARA",transport="TRP-001"} 1500`)
+	assert.Contains(t, out, `oracle_etl_chunk_duration_seconds_count{table="MARA",transport="TRP-001"} 2`)
+	assert.Contains(t, out, `oracle_etl_bytes_extracted_total{table="MARA",transport="TRP-001"} 5.24288e+06`)
+	assert.Contains(t, out, `oracle_etl_gcs_uploaded_bytes_total{table="MARA",transport="TRP-001"} 1.048576e+06`)
+	assert.Contains(t, out, `oracle_etl_gcs_upload_throughput_bytes_per_second_sum{transport="TRP-001"} 524288`)
+	assert.Contains(t, out, `oracle_etl_compression_ratio_sum{transport="TRP-001"} 5`)
+	assert.Contains(t, out, `oracle_etl_jobs_total{outcome="failed",transport="TRP-001"} 1`)
+	assert.Contains(t, out, `oracle_etl_job_duration_seconds_sum{outcome="failed",transport="TRP-001"} 90`)
+}
+
+func TestMetrics_Collectors(t *testing.T) {
+	m := NewMetrics()
+	m.RegisterDBStats("oracle", func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 10, OpenConnections: 6, InUse: 4, Idle: 2, WaitCount: 3, WaitDuration: 1500 * time.Millisecond}
+	})
+	m.RegisterWorkerQueue(func() (int, int) { return 5, 8 })
+	m.RegisterSSEClients(func() int { return 2 })
+
+	cb := resilience.NewCircuitBreaker(resilience.CircuitConfig{FailureThreshold: 1, SuccessThreshold: 1, Timeout: time.Hour})
+	m.RegisterCircuitBreaker("gcs", cb)
+	_ = cb.Execute(func() error { return errors.New("실패") })
+	_ = cb.Execute(func() error { return nil }) // Open 상태에서 거부
+
+	out := render(t, m)
+	assert.Contains(t, out, `oracle_etl_db_connections{pool="oracle",state="in_use"} 4`)
+	assert.Contains(t, out, `oracle_etl_db_connections{pool="oracle",state="idle"} 2`)
+	assert.Contains(t, out, `oracle_etl_db_max_open_connections{pool="oracle"} 10`)
+	assert.Contains(t, out, `oracle_etl_db_wait_total{pool="oracle"} 3`)
+	assert.Contains(t, out, `oracle_etl_db_wait_seconds_total{pool="oracle"} 1.5`)
+	assert.Contains(t, out, "oracle_etl_worker_queue_depth 5\n")
+	assert.Contains(t, out, "oracle_etl_worker_pending_tasks 8\n")
+	assert.Contains(t, out, "oracle_etl_sse_clients 2\n")
+	assert.Contains(t, out, `oracle_etl_circuit_breaker_state{name="gcs"} 1`)
+	assert.Contains(t, out, `oracle_etl_circuit_breaker_failures_total{name="gcs"} 1`)
+	assert.Contains(t, out, `oracle_etl_circuit_breaker_rejections_total{name="gcs"} 1`)
+}
+
+func TestMetrics_LoadGovernor(t *testing.T) {
+	g := governor.New(governor.Config{
+		MaxCursors: 4,
+		HostCPU:    governor.Thresholds{Slow: 70, Pause: 90},
+		Sampler: func(ctx context.Context) (*domain.LoadMetrics, error) {
+			return &domain.LoadMetrics{HostCPUPercent: 75.5, ActiveSessions: 12}, nil
+		},
+	})
+	require.NoError(t, g.Sample(context.Background()))
+	release, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	m := NewMetrics()
+	m.RegisterLoadGovernor(g)
+
+	out := render(t, m)
+	assert.Contains(t, out, "oracle_etl_load_governor_state 1\n")
+	assert.Contains(t, out, "oracle_etl_oracle_cursors_active 1\n")
+	assert.Contains(t, out, "oracle_etl_oracle_host_cpu_percent 75.5\n")
+	assert.Contains(t, out, "oracle_etl_oracle_active_sessions 12\n")
+}
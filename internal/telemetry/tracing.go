@@ -0,0 +1,180 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"oracle-etl/internal/config"
+)
+
+// TracerName은 애플리케이션 span을 생성하는 Tracer 이름입니다
+const TracerName = "oracle-etl"
+
+// ETL span 공통 속성 키
+var (
+	AttrTransportID = attribute.Key("etl.transport_id")
+	AttrJobID       = attribute.Key("etl.job_id")
+	AttrTable       = attribute.Key("etl.table")
+	AttrPart        = attribute.Key("etl.part")
+	AttrChunk       = attribute.Key("etl.chunk")
+	AttrRows        = attribute.Key("etl.rows")
+	AttrBytes       = attribute.Key("etl.bytes")
+)
+
+// Tracer는 전역 TracerProvider의 애플리케이션 Tracer를 반환합니다
+// 추적이 비활성화되어 있으면 no-op Tracer가 반환됩니다
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan은 ctx의 span을 부모로 하는 내부 span을 시작합니다
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan은 err가 있으면 span에 에러를 기록한 뒤 span을 종료합니다
+func EndSpan(span trace.Span, err error) {
+	recordError(span, err)
+	span.End()
+}
+
+// RecordError는 ctx의 현재 span에 에러를 기록합니다 (err가 nil이면 무시)
+func RecordError(ctx context.Context, err error) {
+	recordError(trace.SpanFromContext(ctx), err)
+}
+
+// recordError는 span에 에러 이벤트를 추가하고 상태를 Error로 설정합니다
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TraceID는 ctx에 담긴 span의 trace ID를 반환합니다 (유효한 span이 없으면 빈 문자열)
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// Detach는 ctx의 span 정보만 유지하고 취소/데드라인은 끊은 새 컨텍스트를 반환합니다
+// HTTP 요청이 끝난 뒤에도 계속되는 백그라운드 작업을 요청 trace에 연결할 때 사용합니다
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
+
+// WithTraceLogger는 ctx의 trace_id/span_id를 필드로 추가한 로거를 반환합니다
+func WithTraceLogger(ctx context.Context, logger zerolog.Logger) zerolog.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return logger
+	}
+	return logger.With().
+		Str("trace_id", sc.TraceID().String()).
+		Str("span_id", sc.SpanID().String()).
+		Logger()
+}
+
+// SetupTracing은 설정에 따라 전역 TracerProvider와 전파기(W3C traceparent)를 구성합니다
+// 반환된 shutdown 함수는 남은 span을 내보내고 exporter를 정리합니다
+// 추적이 비활성화되어 있으면 전파기만 설정하고 no-op shutdown을 반환합니다
+func SetupTracing(ctx context.Context, cfg config.TracingConfig, serviceName, version string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeFile, err := newSpanExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("추적 리소스 생성 실패: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeFile != nil {
+			err = errors.Join(err, closeFile())
+		}
+		return err
+	}, nil
+}
+
+// newSpanExporter는 설정된 방식의 span exporter를 생성합니다
+// file 방식이면 열린 파일을 닫는 함수도 함께 반환합니다
+func newSpanExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, func() error, error) {
+	switch cfg.Exporter {
+	case "file":
+		if dir := filepath.Dir(cfg.FilePath); dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, nil, fmt.Errorf("추적 파일 디렉토리 생성 실패: %w", err)
+			}
+		}
+		f, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("추적 파일 열기 실패: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, fmt.Errorf("파일 추적 exporter 생성 실패: %w", err)
+		}
+		return exporter, f.Close, nil
+
+	case "", "otlp":
+		opts := []otlptracehttp.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("OTLP 추적 exporter 생성 실패: %w", err)
+		}
+		return exporter, nil, nil
+
+	default:
+		return nil, nil, fmt.Errorf("지원하지 않는 추적 exporter: %s", cfg.Exporter)
+	}
+}
@@ -0,0 +1,118 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"oracle-etl/internal/config"
+)
+
+// useRecorder는 테스트 동안 span을 메모리에 기록하는 TracerProvider를 전역으로 설정합니다
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+func TestStartSpan_ParentAndError(t *testing.T) {
+	recorder := useRecorder(t)
+
+	ctx, parent := StartSpan(context.Background(), "job.run", AttrJobID.String("JOB-1"))
+	_, child := StartSpan(ctx, "table.extract", AttrTable.String("MARA"))
+	EndSpan(child, errors.New("ORA-01555"))
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "table.extract", spans[0].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Len(t, spans[0].Events(), 1, "RecordError 이벤트")
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	assert.Equal(t, parent.SpanContext().TraceID().String(), TraceID(ctx))
+}
+
+func TestTraceID_NoSpan(t *testing.T) {
+	assert.Empty(t, TraceID(context.Background()))
+}
+
+func TestDetach_KeepsSpanDropsCancel(t *testing.T) {
+	useRecorder(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, span := StartSpan(ctx, "job.trigger")
+	defer span.End()
+
+	detached := Detach(ctx)
+	cancel()
+
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, TraceID(ctx), TraceID(detached))
+}
+
+func TestWithTraceLogger(t *testing.T) {
+	useRecorder(t)
+
+	var buf strings.Builder
+	logger := zerolog.New(&buf)
+
+	ctx, span := StartSpan(context.Background(), "job.run")
+	spanLogger := WithTraceLogger(ctx, logger)
+	spanLogger.Info().Msg("with span")
+	span.End()
+	plainLogger := WithTraceLogger(context.Background(), logger)
+	plainLogger.Info().Msg("no span")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"trace_id":"`+span.SpanContext().TraceID().String()+`"`)
+	assert.NotContains(t, lines[1], "trace_id")
+}
+
+func TestSetupTracing_FileExporter(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	path := filepath.Join(t.TempDir(), "traces", "spans.jsonl")
+	shutdown, err := SetupTracing(context.Background(), config.TracingConfig{
+		Enabled:     true,
+		Exporter:    "file",
+		FilePath:    path,
+		SampleRatio: 1,
+	}, "oracle-etl", "test")
+	require.NoError(t, err)
+
+	_, span := StartSpan(context.Background(), "gcs.upload", AttrTable.String("MARA"))
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Name":"gcs.upload"`)
+	assert.Contains(t, string(data), `"etl.table"`)
+	assert.Contains(t, string(data), `"oracle-etl"`)
+}
+
+func TestSetupTracing_Disabled(t *testing.T) {
+	shutdown, err := SetupTracing(context.Background(), config.TracingConfig{Enabled: false}, "oracle-etl", "test")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	// 비활성화 상태에서도 상위 서비스의 traceparent 헤더는 전파
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+}
@@ -0,0 +1,741 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+
+	"oracle-etl/internal/adapter/sse"
+	"oracle-etl/internal/domain"
+	apperrors "oracle-etl/internal/errors"
+	"oracle-etl/internal/maintenance"
+	"oracle-etl/internal/resilience"
+	"oracle-etl/internal/telemetry"
+	"oracle-etl/pkg/buffer"
+)
+
+var (
+	// ErrTransportNotFound는 실행 대상 Transport가 없을 때 반환됩니다
+	ErrTransportNotFound = errors.New("transport를 찾을 수 없습니다")
+	// ErrTransportNotExecutable은 Transport가 실행 중이거나 비활성화 상태일 때 반환됩니다
+	ErrTransportNotExecutable = errors.New("transport가 이미 실행 중이거나 비활성화 상태입니다")
+	// ErrJobNotFound는 취소/재시도 대상 Job이 없을 때 반환됩니다
+	ErrJobNotFound = errors.New("job을 찾을 수 없습니다")
+	// ErrJobNotCancellable은 이미 종료된 Job을 취소하려 할 때 반환됩니다
+	ErrJobNotCancellable = errors.New("이미 종료된 job은 취소할 수 없습니다")
+	// ErrJobNotRetryable은 실패/취소되지 않았거나 다시 추출할 테이블이 없는 Job을 재시도하려 할 때 반환됩니다
+	ErrJobNotRetryable = errors.New("재시도할 수 없는 job입니다")
+	// ErrRunnerShutdown은 서버 종료 중이어서 Job을 시작할 수 없거나 실행 중인 Job을 중단한 경우의 원인입니다
+	ErrRunnerShutdown = errors.New("서버 종료로 job 실행이 중단되었습니다")
+)
+
+// JobRunnerConfig는 JobRunner 실행 설정입니다
+type JobRunnerConfig struct {
+	Owner        string              // 추출 대상 스키마 소유자
+	Concurrency  int                 // 테이블 동시 추출 수 (0이면 기본값)
+	BufferConfig *buffer.Config      // 버퍼 설정 (nil이면 기본값)
+	Split        domain.SplitOptions // 대용량 테이블 분할 추출 설정 (MinRows가 0이면 분할하지 않음)
+	Logger       zerolog.Logger      // 백그라운드 실행 로거
+	Metrics      *telemetry.Metrics  // Job 결과/실행 시간 메트릭 (nil이면 기록하지 않음)
+}
+
+// TriggerOptions는 Job 실행 옵션입니다
+type TriggerOptions struct {
+	FullReload bool // true면 watermark를 무시하고 전체 추출
+}
+
+// JobRunner는 Job을 생성하고 백그라운드에서 ParallelExecutor로 실행합니다
+type JobRunner struct {
+	transportSvc *TransportService
+	jobSvc       *JobService
+	watermarkSvc *WatermarkService
+	executor     *ParallelExecutor
+	sse          *sse.Broadcaster
+	config       JobRunnerConfig
+	maintenance  MaintenanceConfig // 유지보수 시간대 (Calendar가 nil이면 제한 없음)
+
+	mu     sync.Mutex     // Trigger 직렬화 (동일 Transport 중복 실행 방지)
+	wg     sync.WaitGroup // 실행 중인 Job goroutine
+	closed bool           // Shutdown 이후에는 새 Job을 시작하지 않음 (mu로 보호)
+
+	runningMu sync.Mutex
+	running   map[string]*runningJob // jobID -> 실행 중인 Job의 취소 핸들
+}
+
+// runningJob은 백그라운드에서 실행 중인 Job의 취소 핸들입니다
+type runningJob struct {
+	cancel context.CancelFunc
+	abort  context.CancelCauseFunc // 원인을 기록하며 취소 (서버 종료)
+	done   chan struct{}           // run goroutine 종료 시 닫힘
+}
+
+// NewJobRunner는 새로운 JobRunner를 생성합니다
+// watermarkSvc가 nil이면 증분 추출 없이 항상 전체 추출합니다
+func NewJobRunner(transportSvc *TransportService, jobSvc *JobService, watermarkSvc *WatermarkService, executor *ParallelExecutor, sseBroadcaster *sse.Broadcaster, cfg JobRunnerConfig) *JobRunner {
+	return &JobRunner{
+		transportSvc: transportSvc,
+		jobSvc:       jobSvc,
+		watermarkSvc: watermarkSvc,
+		executor:     executor,
+		sse:          sseBroadcaster,
+		config:       cfg,
+		running:      make(map[string]*runningJob),
+	}
+}
+
+// Trigger는 기본 옵션으로 Transport의 새 Job을 생성하고 백그라운드 실행을 시작합니다
+func (r *JobRunner) Trigger(ctx context.Context, transportID string) (*domain.Job, error) {
+	return r.TriggerWithOptions(ctx, transportID, TriggerOptions{})
+}
+
+// TriggerWithOptions는 Transport의 새 Job을 생성하고 백그라운드 실행을 시작합니다
+// 반환되는 Job은 실행 시작 전(pending) 상태의 스냅샷입니다
+func (r *JobRunner) TriggerWithOptions(ctx context.Context, transportID string, opts TriggerOptions) (_ *domain.Job, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "job.trigger", telemetry.AttrTransportID.String(transportID))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, ErrRunnerShutdown
+	}
+
+	transport, err := r.transportSvc.GetByID(ctx, transportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+
+	if !transport.CanExecute() {
+		return nil, ErrTransportNotExecutable
+	}
+
+	// 유지보수 시간대 밖이면 거부하거나(reject) 시간대가 열릴 때까지 실행을 미룸(defer)
+	window, err := r.checkWindow(transport)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.jobSvc.CreateJob(ctx, transportID)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(telemetry.AttrJobID.String(job.ID))
+
+	// 재시도 시 같은 범위로 추출하도록 실행 옵션과 실행한 정의의 리비전을 기록
+	job.FullReload = opts.FullReload
+	job.TransportRevision = transport.Revision
+	if window.deferred() {
+		job.DeferredUntil = &window.deferredUntil
+	}
+	if err := r.jobSvc.UpdateJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("job 실행 옵션 기록 실패: %w", err)
+	}
+
+	// 동시 실행 요청이 409를 받도록 실행 전에 상태를 변경
+	if err := r.transportSvc.UpdateStatus(ctx, transportID, domain.TransportStatusRunning); err != nil {
+		return nil, fmt.Errorf("transport 상태 변경 실패: %w", err)
+	}
+
+	snapshot := *job
+	r.start(ctx, transport, job, opts, transport.Tables, window)
+	return &snapshot, nil
+}
+
+// Retry는 실패/취소된 Job을 같은 버전으로 다시 실행합니다
+// 완료된 테이블의 Extraction은 유지하고 완료되지 않은 테이블만 같은 GCS prefix에 다시 추출합니다
+// watermark가 뒤로 돌아가지 않도록 Transport의 최신 버전 Job만 재시도할 수 있습니다
+func (r *JobRunner) Retry(ctx context.Context, jobID string) (_ *domain.Job, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "job.retry", telemetry.AttrJobID.String(jobID))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, ErrRunnerShutdown
+	}
+
+	job, err := r.jobSvc.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJobNotFound, err)
+	}
+	if !job.Status.IsRetryable() {
+		return nil, fmt.Errorf("%w: 실패 또는 취소된 job만 재시도할 수 있습니다 (현재 상태: %s)", ErrJobNotRetryable, job.Status)
+	}
+
+	latest, err := r.jobSvc.LatestVersion(ctx, job.TransportID)
+	if err != nil {
+		return nil, fmt.Errorf("최신 job 버전 조회 실패: %w", err)
+	}
+	if job.Version != latest {
+		return nil, fmt.Errorf("%w: 최신 버전(v%03d)의 job만 재시도할 수 있습니다", ErrJobNotRetryable, latest)
+	}
+
+	transport, err := r.transportSvc.GetByID(ctx, job.TransportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+	if !transport.CanExecute() {
+		return nil, ErrTransportNotExecutable
+	}
+
+	// 한 버전의 객체가 두 정의에 걸치지 않도록 이전 시도와 같은 리비전의 정의로 재시도
+	pinned, err := r.pinnedDefinition(ctx, transport, job)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := job.PendingTables(pinned.Tables)
+	if job.SnapshotSCN != 0 {
+		// 일관된 스냅샷 Job은 시간이 지나 undo가 만료된 이전 SCN 대신 새 SCN으로 모든 테이블을 다시 추출
+		if snapshotTooOld(job) {
+			return nil, fmt.Errorf("%w: 스냅샷 SCN %d의 undo 데이터가 만료되어(ORA-01555) 재시도할 수 없습니다. 새 Job으로 전체 재실행이 필요합니다", ErrJobNotRetryable, job.SnapshotSCN)
+		}
+		tables = pinned.Tables
+	}
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("%w: 다시 추출할 테이블이 없습니다", ErrJobNotRetryable)
+	}
+
+	window, err := r.checkWindow(transport)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Retry()
+	job.SnapshotSCN = 0
+	job.DeferredUntil = nil
+	if window.deferred() {
+		job.DeferredUntil = &window.deferredUntil
+	}
+	if err := r.jobSvc.UpdateJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("job 재시도 상태 기록 실패: %w", err)
+	}
+
+	if err := r.transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning); err != nil {
+		return nil, fmt.Errorf("transport 상태 변경 실패: %w", err)
+	}
+
+	snapshot := *job
+	r.start(ctx, pinned, job, TriggerOptions{FullReload: job.FullReload}, tables, window)
+
+	return &snapshot, nil
+}
+
+// pinnedDefinition은 Job이 처음 실행한 리비전(TransportRevision)의 정의를 적용한 Transport를 반환합니다
+// 이후 PUT/PATCH로 정의가 바뀌었으면 리비전 이력에서 정의를 불러오며, 리비전을 찾을 수 없으면 재시도를 거부합니다
+func (r *JobRunner) pinnedDefinition(ctx context.Context, transport *domain.Transport, job *domain.Job) (*domain.Transport, error) {
+	if job.TransportRevision == 0 || job.TransportRevision == transport.Revision {
+		return transport, nil
+	}
+	rev, err := r.transportSvc.GetRevision(ctx, transport.ID, job.TransportRevision)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 이전 시도의 Transport 정의(리비전 %d)를 찾을 수 없습니다: %v", ErrJobNotRetryable, job.TransportRevision, err)
+	}
+	pinned := transport.Clone()
+	pinned.ApplyDefinition(rev.Definition)
+	pinned.Revision = rev.Revision
+	return pinned, nil
+}
+
+// snapshotTooOld는 Job의 테이블 중 ORA-01555(snapshot too old)로 실패한 테이블이 있는지 확인합니다
+func snapshotTooOld(job *domain.Job) bool {
+	for _, ext := range job.Extractions {
+		if ext.ErrorDetail != nil && ext.ErrorDetail.Code == apperrors.ErrCodeOracleSnapshotTooOld {
+			return true
+		}
+	}
+	return false
+}
+
+// ResumeDeferred는 서버 재시작 전에 유지보수 시간대를 기다리던(deferred) Job을 다시 예약합니다
+// 현재 시각 기준으로 시간대를 다시 확인하여 열려 있으면 바로 실행하고, 닫혀 있으면 다음 시간대까지 미룹니다
+// 시간대 설정이 바뀌어 더 이상 실행할 수 없으면(reject 정책, 다가오는 시간대 없음) 실패로 기록합니다
+func (r *JobRunner) ResumeDeferred(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return 0, ErrRunnerShutdown
+	}
+
+	jobs, err := r.jobSvc.ListDeferred(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for i := range jobs {
+		job := &jobs[i]
+		transport, err := r.transportSvc.GetByID(ctx, job.TransportID)
+		if err != nil {
+			// 삭제된 Transport의 Job은 실행할 수 없으므로 실패 처리
+			if err := r.jobSvc.FailJob(ctx, job.ID, "서버 재시작 후 Transport를 찾을 수 없어 예약된 실행을 취소했습니다"); err != nil {
+				return resumed, fmt.Errorf("job %s 상태 기록 실패: %w", job.ID, err)
+			}
+			continue
+		}
+
+		window, err := r.checkWindow(transport)
+		if err != nil {
+			r.finish(ctx, transport.ID, job, time.Now(), nil, err)
+			continue
+		}
+
+		// 재시도를 기다리던 Job은 이전 시도와 같은 정의로, 아직 실행 전인 Job은 현재 정의로 실행
+		if job.Attempt > 1 {
+			if transport, err = r.pinnedDefinition(ctx, transport, job); err != nil {
+				r.finish(ctx, job.TransportID, job, time.Now(), nil, err)
+				continue
+			}
+		} else {
+			job.TransportRevision = transport.Revision
+		}
+		job.DeferredUntil = nil
+		if window.deferred() {
+			job.DeferredUntil = &window.deferredUntil
+		}
+		if err := r.jobSvc.UpdateJob(ctx, job); err != nil {
+			return resumed, fmt.Errorf("job %s 실행 예정 시각 기록 실패: %w", job.ID, err)
+		}
+
+		r.start(ctx, transport, job, TriggerOptions{FullReload: job.FullReload}, job.PendingTables(transport.Tables), window)
+		resumed++
+	}
+	return resumed, nil
+}
+
+// start는 Job별 취소 핸들을 등록하고 백그라운드에서 tables를 추출합니다
+// 백그라운드 실행은 ctx의 취소와 무관하지만 같은 trace로 기록됩니다
+// window가 nil이 아니면 유지보수 시간대에 맞춰 실행을 미루거나 멈추거나 중단합니다
+func (r *JobRunner) start(ctx context.Context, transport *domain.Transport, job *domain.Job, opts TriggerOptions, tables []string, window *jobWindow) {
+	// Cancel에서 중단할 수 있도록 Job별 context를 등록 (유지보수 시간대 종료로 중단할 때는 원인을 기록)
+	jobCtx, abort := context.WithCancelCause(telemetry.Detach(ctx))
+	cancel := func() { abort(nil) }
+	handle := &runningJob{cancel: cancel, abort: abort, done: make(chan struct{})}
+	r.runningMu.Lock()
+	r.running[job.ID] = handle
+	r.runningMu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.runningMu.Lock()
+			delete(r.running, job.ID)
+			r.runningMu.Unlock()
+			cancel()
+			close(handle.done)
+		}()
+		r.run(jobCtx, abort, transport, job, opts, tables, window)
+	}()
+}
+
+// Cancel은 실행 중인 Job의 context를 취소하고 실행이 정리될 때까지 대기합니다
+// 진행 중인 추출과 GCS 업로드는 중단되며 부분 객체는 확정되지 않습니다
+// 이 실행기에서 실행되지 않는 미종료 Job(재시작 전에 생성된 경우 등)은 상태만 취소로 정리합니다
+func (r *JobRunner) Cancel(ctx context.Context, jobID string) (*domain.Job, error) {
+	// 상태 조회보다 먼저 핸들을 확인 (run은 종료 상태를 기록한 뒤 핸들을 제거)
+	r.runningMu.Lock()
+	handle := r.running[jobID]
+	r.runningMu.Unlock()
+
+	job, err := r.jobSvc.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJobNotFound, err)
+	}
+	if job.Status.IsTerminal() {
+		return nil, ErrJobNotCancellable
+	}
+
+	if handle == nil {
+		if err := r.jobSvc.CancelJob(ctx, jobID); err != nil {
+			return nil, fmt.Errorf("job 취소 상태 기록 실패: %w", err)
+		}
+		if err := r.transportSvc.UpdateStatus(ctx, job.TransportID, domain.TransportStatusIdle); err != nil {
+			r.config.Logger.Warn().Err(err).Str("job_id", jobID).Msg("transport 상태 변경 실패")
+		}
+		r.sendStatusEvent(job.TransportID, jobID, sse.StatusCancelled, fmt.Sprintf("Job %s 취소됨", job.VersionString()))
+		return r.jobSvc.GetByID(ctx, jobID)
+	}
+
+	handle.cancel()
+	select {
+	case <-handle.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	job, err = r.jobSvc.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	// 취소 전에 이미 완료/실패한 경우
+	if job.Status != domain.JobStatusCancelled {
+		return nil, ErrJobNotCancellable
+	}
+	return job, nil
+}
+
+// Wait는 실행 중인 모든 Job이 종료될 때까지 대기합니다
+func (r *JobRunner) Wait() {
+	r.wg.Wait()
+}
+
+// Shutdown은 새 Job 시작을 막고 실행 중인 모든 Job을 중단한 뒤 종료 상태가 기록될 때까지 대기합니다
+// 중단된 Job은 ErrRunnerShutdown 원인으로 실패 처리되어 재시도할 수 있고, 시간대를 기다리던 Job은 대기 상태로 남습니다
+// 저장소와 Oracle/GCS 연결을 닫기 전에 호출해야 하며, ctx가 먼저 만료되면 대기를 멈추고 에러를 반환합니다
+func (r *JobRunner) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+
+	r.runningMu.Lock()
+	for _, handle := range r.running {
+		handle.abort(ErrRunnerShutdown)
+	}
+	r.runningMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("실행 중인 job 종료 대기 실패: %w", ctx.Err())
+	}
+}
+
+// CircuitBreakers는 실행기의 의존성별(oracle, gcs) Circuit Breaker를 반환합니다
+func (r *JobRunner) CircuitBreakers() map[string]*resilience.CircuitBreaker {
+	return r.executor.CircuitBreakers()
+}
+
+// run은 단일 Job의 tables를 실행하고 결과를 기록합니다
+// abort는 유지보수 시간대가 닫혔을 때(on_close: abort) 원인과 함께 Job context를 취소합니다
+func (r *JobRunner) run(ctx context.Context, abort context.CancelCauseFunc, transport *domain.Transport, job *domain.Job, opts TriggerOptions, tables []string, window *jobWindow) {
+	ctx, span := telemetry.StartSpan(ctx, "job.run",
+		telemetry.AttrTransportID.String(transport.ID),
+		telemetry.AttrJobID.String(job.ID),
+		attribute.String("etl.job_version", job.VersionString()),
+		attribute.Int("etl.attempt", job.Attempt),
+		attribute.Int("etl.tables", len(tables)),
+	)
+	defer span.End()
+
+	logger := telemetry.WithTraceLogger(ctx, r.config.Logger).With().
+		Str("transport_id", transport.ID).
+		Str("job_id", job.ID).
+		Int("attempt", job.Attempt).
+		Logger()
+
+	// 유지보수 시간대 밖이어서 미룬 Job은 pending 상태로 시간대가 열릴 때까지 대기
+	if window.deferred() {
+		r.sendStatusEvent(transport.ID, job.ID, sse.StatusDeferred, fmt.Sprintf("Job %s 실행 대기: 유지보수 시간대 밖 (실행 예정 %s)",
+			job.VersionString(), window.deferredUntil.Format(time.RFC3339)))
+		logger.Info().Time("deferred_until", window.deferredUntil).Msg("유지보수 시간대 밖, 실행 대기")
+		if err := window.waitOpen(ctx); err != nil {
+			// 서버 종료로 중단된 경우 재시작 후 다시 예약되도록 대기 상태로 둠 (ResumeDeferred)
+			if errors.Is(context.Cause(ctx), ErrRunnerShutdown) {
+				logger.Info().Msg("서버 종료, 실행 대기 중인 job은 재시작 후 다시 예약")
+				return
+			}
+			logger.Warn().Err(err).Msg("유지보수 시간대 대기 중단")
+			r.finish(ctx, transport.ID, job, time.Now(), nil, err)
+			return
+		}
+	}
+
+	startedAt := time.Now()
+
+	if err := r.jobSvc.StartJob(ctx, job.ID); err != nil {
+		logger.Error().Err(err).Msg("job 시작 실패")
+		r.finish(ctx, transport.ID, job, startedAt, nil, err)
+		return
+	}
+
+	// 증분 추출 범위 계산
+	var watermarks map[string]domain.WatermarkRange
+	if r.watermarkSvc != nil {
+		ranges, err := r.watermarkSvc.Ranges(ctx, transport, opts.FullReload)
+		if err != nil {
+			logger.Error().Err(err).Msg("watermark 범위 계산 실패")
+			r.finish(ctx, transport.ID, job, startedAt, nil, err)
+			return
+		}
+		watermarks = ranges
+	}
+
+	// 일관된 스냅샷: Job 시작 시점의 SCN을 모든 테이블 조회에 사용
+	// 재시도는 SCN을 비우고 모든 테이블을 다시 추출하므로 시도마다 새 SCN을 기록 (Retry)
+	snapshotSCN := job.SnapshotSCN
+	if transport.ConsistentSnapshot && snapshotSCN == 0 {
+		scn, err := r.executor.CurrentSCN(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("스냅샷 SCN 조회 실패")
+			r.finish(ctx, transport.ID, job, startedAt, nil, err)
+			return
+		}
+		if err := r.jobSvc.SetSnapshotSCN(ctx, job.ID, scn); err != nil {
+			logger.Error().Err(err).Msg("스냅샷 SCN 기록 실패")
+			r.finish(ctx, transport.ID, job, startedAt, nil, err)
+			return
+		}
+		snapshotSCN = scn
+	}
+
+	message := fmt.Sprintf("Job %s 실행 시작", job.VersionString())
+	if job.Attempt > 1 {
+		message = fmt.Sprintf("Job %s 재시도 #%d 시작 (%d개 테이블)", job.VersionString(), job.Attempt, len(tables))
+	}
+	r.sendStatusEvent(transport.ID, job.ID, sse.StatusRunning, message)
+	logger.Info().
+		Int("tables", len(tables)).
+		Int("incremental_tables", len(watermarks)).
+		Bool("full_reload", opts.FullReload).
+		Uint64("snapshot_scn", snapshotSCN).
+		Msg("job 실행 시작")
+
+	plan := ExecutionPlan{
+		TransportID:  transport.ID,
+		JobID:        job.ID,
+		JobVersion:   job.VersionString(),
+		Tables:       tables,
+		Concurrency:  r.config.Concurrency,
+		Owner:        r.config.Owner,
+		BufferConfig: r.config.BufferConfig,
+		Watermarks:   watermarks,
+		SnapshotSCN:  snapshotSCN,
+		Split:        r.config.Split,
+		Sources:      transport.SourceMap(),
+		Columns:      transport.ColumnFilters(),
+		LOBs:         transport.LOBPolicies(),
+		OutputFormat: transport.OutputFormat,
+		Reconcile:    transport.ReconcilePolicy,
+		Attempt:      job.Attempt,
+		Completed:    completedExtractions(job.Extractions, tables),
+	}
+
+	// 실행 중 유지보수 시간대가 닫힐 때의 처리 (continue는 끝까지 실행)
+	if window != nil {
+		switch window.onClose {
+		case domain.WindowClosePause:
+			window.notify = func(paused bool, status maintenance.Status) {
+				r.notifyWindow(transport.ID, job, paused, status)
+			}
+			plan.Gate = window.gate
+		case domain.WindowCloseAbort:
+			go window.watch(ctx, abort)
+		}
+	}
+
+	result, execErr := r.executor.Execute(ctx, plan)
+	r.finish(ctx, transport.ID, job, startedAt, result, execErr)
+
+	if execErr != nil {
+		logger.Error().Err(execErr).Msg("job 실행 실패")
+		return
+	}
+	logger.Info().
+		Int64("total_rows", result.TotalRows).
+		Int64("total_bytes", result.TotalBytes).
+		Dur("duration", result.Duration()).
+		Msg("job 실행 완료")
+}
+
+// finish는 실행 결과를 Job과 Transport 상태에 반영하고 Job 결과 메트릭을 기록합니다
+func (r *JobRunner) finish(ctx context.Context, transportID string, job *domain.Job, startedAt time.Time, result *ExecutionResult, execErr error) {
+	logger := telemetry.WithTraceLogger(ctx, r.config.Logger).With().
+		Str("transport_id", transportID).
+		Str("job_id", job.ID).
+		Logger()
+
+	// Job context가 취소되었으면 실패가 아닌 취소로 기록
+	// 유지보수 시간대 종료나 서버 종료로 중단된 경우는 남은 테이블을 재시도할 수 있도록 실패로 기록
+	cancelled := execErr != nil && ctx.Err() != nil
+	if cause := context.Cause(ctx); cancelled && (errors.Is(cause, ErrMaintenanceWindowClosed) || errors.Is(cause, ErrRunnerShutdown)) {
+		cancelled, execErr = false, cause
+	}
+	telemetry.RecordError(ctx, execErr)
+	ctx = context.WithoutCancel(ctx)
+
+	var extractions []domain.Extraction
+	if result != nil {
+		current, err := r.jobSvc.GetByID(ctx, job.ID)
+		if err != nil {
+			logger.Error().Err(err).Msg("job 조회 실패")
+			extractions = extractionsFromResult(job.ID, result, cancelled)
+		} else {
+			// 재시도에서 제외된(이전 시도에서 완료된) 테이블의 Extraction은 유지
+			extractions = mergeExtractions(current.Extractions, extractionsFromResult(job.ID, result, cancelled))
+			current.Extractions = extractions
+			current.UpdateMetrics()
+			if err := r.jobSvc.UpdateJob(ctx, current); err != nil {
+				logger.Error().Err(err).Msg("extraction 기록 실패")
+			}
+		}
+	}
+
+	transportStatus := domain.TransportStatusIdle
+	outcome := domain.JobStatusCompleted
+	if cancelled {
+		outcome = domain.JobStatusCancelled
+		if err := r.jobSvc.CancelJob(ctx, job.ID); err != nil {
+			logger.Error().Err(err).Msg("job 취소 상태 기록 실패")
+		}
+		r.sendStatusEvent(transportID, job.ID, sse.StatusCancelled, fmt.Sprintf("Job %s 취소됨", job.VersionString()))
+		logger.Info().Msg("job 취소됨")
+	} else if execErr != nil {
+		transportStatus = domain.TransportStatusFailed
+		outcome = domain.JobStatusFailed
+		if err := r.jobSvc.FailJob(ctx, job.ID, execErr.Error()); err != nil {
+			logger.Error().Err(err).Msg("job 실패 상태 기록 실패")
+		}
+		r.sendStatusEvent(transportID, job.ID, sse.StatusFailed, execErr.Error())
+	} else if err := r.jobSvc.CompleteJob(ctx, job.ID); err != nil {
+		logger.Error().Err(err).Msg("job 완료 상태 기록 실패")
+	} else if r.watermarkSvc != nil {
+		// 성공한 Job에 대해서만 기준값 갱신
+		if err := r.watermarkSvc.Advance(ctx, transportID, job.ID, extractions); err != nil {
+			logger.Error().Err(err).Msg("watermark 갱신 실패")
+		}
+	}
+
+	if err := r.transportSvc.UpdateStatus(ctx, transportID, transportStatus); err != nil {
+		logger.Error().Err(err).Msg("transport 상태 변경 실패")
+	}
+	r.config.Metrics.ObserveJob(transportID, outcome, time.Since(startedAt))
+}
+
+// sendStatusEvent는 Job 상태 이벤트를 발송합니다
+func (r *JobRunner) sendStatusEvent(transportID, jobID, status, message string) {
+	if r.sse == nil {
+		return
+	}
+
+	r.sse.BroadcastStatus(sse.StatusEvent{
+		TransportID: transportID,
+		JobID:       jobID,
+		Status:      status,
+		Message:     message,
+	})
+}
+
+// notifyWindow는 유지보수 시간대 종료로 Job이 멈추거나 다시 열려 재개될 때 상태 이벤트를 발송합니다
+func (r *JobRunner) notifyWindow(transportID string, job *domain.Job, paused bool, status maintenance.Status) {
+	logger := r.config.Logger.With().Str("transport_id", transportID).Str("job_id", job.ID).Logger()
+	if !paused {
+		logger.Info().Msg("유지보수 시간대 열림, job 재개")
+		r.sendStatusEvent(transportID, job.ID, sse.StatusRunning, fmt.Sprintf("Job %s 재개: 유지보수 시간대 열림", job.VersionString()))
+		return
+	}
+	logger.Info().Str("reason", status.Reason).Time("resume_at", status.NextAllowedAt).Msg("유지보수 시간대 종료, job 일시 정지")
+	r.sendStatusEvent(transportID, job.ID, sse.StatusPaused, fmt.Sprintf("Job %s 일시 정지: %s (재개 예정 %s)",
+		job.VersionString(), status.Reason, status.NextAllowedAt.Format(time.RFC3339)))
+}
+
+// errorDetail은 Oracle 에러를 분류하여 Extraction에 기록할 에러 정보로 변환합니다 (Oracle 에러가 아니면 nil)
+func errorDetail(err error) *domain.ErrorDetail {
+	resp := apperrors.ClassifyOracle(err)
+	if resp == nil {
+		return nil
+	}
+	code, _ := apperrors.OraCode(err)
+	return &domain.ErrorDetail{
+		Code:      resp.Code,
+		OraCode:   apperrors.FormatOraCode(code),
+		Retryable: resp.Retryable,
+		Hint:      resp.Hint,
+	}
+}
+
+// extractionsFromResult는 테이블별 실행 결과를 Extraction 목록으로 변환합니다
+// TableResults는 완료 순서로 수집되므로 테이블 이름순으로 정렬합니다
+// Job이 취소된 경우 완료되지 못한 테이블은 취소 상태로 기록합니다
+func extractionsFromResult(jobID string, result *ExecutionResult, cancelled bool) []domain.Extraction {
+	extractions := make([]domain.Extraction, 0, len(result.TableResults))
+	for _, tr := range result.TableResults {
+		ext := domain.NewExtraction(fmt.Sprintf("%s-%s", jobID, tr.TableName), jobID, tr.TableName)
+		ext.Watermark = tr.Watermark
+		ext.Parts = tr.Parts
+		ext.Objects = tr.Objects
+		ext.LOBObjects = tr.LOBObjects
+		ext.Reconciliation = tr.Reconciliation
+		switch {
+		case tr.Success():
+			ext.Complete(tr.RowCount, tr.ByteCount, tr.GCSPath)
+		case cancelled:
+			ext.Cancel(tr.Error)
+			ext.RowCount = tr.RowCount
+			ext.ByteCount = tr.ByteCount
+		default:
+			ext.Fail(tr.Error)
+			ext.ErrorDetail = errorDetail(tr.Error)
+			ext.RowCount = tr.RowCount
+			ext.ByteCount = tr.ByteCount
+		}
+
+		ext.Attempts = 1
+
+		// 실제 실행 시간으로 기록
+		startedAt := tr.StartTime.UTC()
+		completedAt := tr.EndTime.UTC()
+		ext.StartedAt = &startedAt
+		ext.CompletedAt = &completedAt
+
+		extractions = append(extractions, *ext)
+	}
+
+	sort.Slice(extractions, func(i, j int) bool {
+		return extractions[i].TableName < extractions[j].TableName
+	})
+	return extractions
+}
+
+// completedExtractions는 이번 실행에서 제외된(이전 시도에서 완료된) 테이블의 Extraction을 반환합니다
+func completedExtractions(extractions []domain.Extraction, tables []string) []domain.Extraction {
+	retried := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		retried[table] = true
+	}
+
+	var completed []domain.Extraction
+	for _, ext := range extractions {
+		if ext.Status == domain.ExtractionStatusCompleted && !retried[ext.TableName] {
+			completed = append(completed, ext)
+		}
+	}
+	return completed
+}
+
+// mergeExtractions는 이전 시도의 Extraction에 이번 시도의 결과를 덮어씁니다
+// 다시 추출된 테이블은 이전 시도 횟수에 1을 더해 기록하고, 결과는 테이블 이름순으로 정렬합니다
+func mergeExtractions(previous, latest []domain.Extraction) []domain.Extraction {
+	attempts := make(map[string]int, len(previous))
+	byTable := make(map[string]domain.Extraction, len(previous)+len(latest))
+	for _, ext := range previous {
+		attempts[ext.TableName] = max(ext.Attempts, 1) // 시도 횟수 도입 전 기록은 1회로 간주
+		byTable[ext.TableName] = ext
+	}
+	for _, ext := range latest {
+		ext.Attempts = attempts[ext.TableName] + 1
+		byTable[ext.TableName] = ext
+	}
+
+	merged := make([]domain.Extraction, 0, len(byTable))
+	for _, ext := range byTable {
+		merged = append(merged, ext)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].TableName < merged[j].TableName
+	})
+	return merged
+}
@@ -0,0 +1,798 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/adapter/oracle"
+	"oracle-etl/internal/adapter/sse"
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository/memory"
+	"oracle-etl/internal/telemetry"
+)
+
+// setupJobRunner는 테스트용 JobRunner와 서비스를 생성합니다
+func setupJobRunner(t *testing.T, mockRepo *oracle.MockRepository) (*JobRunner, *TransportService, *JobService) {
+	t.Helper()
+
+	transportRepo := memory.NewTransportRepository()
+	jobRepo := memory.NewJobRepository()
+	transportSvc := NewTransportService(transportRepo)
+	jobSvc := NewJobService(jobRepo, transportRepo)
+	executor := NewParallelExecutor(mockRepo, nil, nil, 2)
+
+	watermarkSvc := NewWatermarkService(memory.NewWatermarkRepository())
+
+	runner := NewJobRunner(transportSvc, jobSvc, watermarkSvc, executor, nil, JobRunnerConfig{Owner: "SAPSR3"})
+	return runner, transportSvc, jobSvc
+}
+
+// TestJobRunner_Trigger는 Job 생성 및 백그라운드 실행 완료를 테스트합니다
+func TestJobRunner_Trigger(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 1000, IsLastChunk: true, TotalRowsSent: 1000},
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRP", "VBRK"},
+	})
+	require.NoError(t, err)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusPending, job.Status)
+	assert.Equal(t, 1, job.Version)
+
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	assert.Equal(t, 1, finished.TransportRevision)
+	require.Len(t, finished.Extractions, 2)
+	assert.Equal(t, "VBRK", finished.Extractions[0].TableName)
+	assert.Equal(t, domain.ExtractionStatusCompleted, finished.Extractions[0].Status)
+	assert.Equal(t, int64(1000), finished.Extractions[0].RowCount)
+	assert.Equal(t, int64(2000), finished.Metrics.TotalRows)
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+}
+
+// TestJobRunner_TriggerPartialFailure는 일부 테이블 실패 시 Job/Transport 실패 처리를 테스트합니다
+func TestJobRunner_TriggerPartialFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	mockRepo.TableErrors = map[string]error{
+		"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist"),
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRP", "FAIL_TABLE"},
+	})
+	require.NoError(t, err)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, finished.Status)
+	require.NotNil(t, finished.Error)
+	require.Len(t, finished.Extractions, 2)
+	assert.Equal(t, domain.ExtractionStatusFailed, finished.Extractions[0].Status)
+	assert.Equal(t, domain.ExtractionStatusCompleted, finished.Extractions[1].Status)
+
+	// 실패한 추출에는 분류된 Oracle 에러 정보가 기록되어야 함
+	detail := finished.Extractions[0].ErrorDetail
+	require.NotNil(t, detail)
+	assert.Equal(t, "ORACLE_TABLE_NOT_FOUND", detail.Code)
+	assert.Equal(t, "ORA-00942", detail.OraCode)
+	assert.False(t, detail.Retryable)
+	assert.NotEmpty(t, detail.Hint)
+	assert.Nil(t, finished.Extractions[1].ErrorDetail)
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusFailed, updated.Status)
+}
+
+// TestJobRunner_TriggerNotExecutable은 실행 중/비활성 Transport 실행 거부를 테스트합니다
+func TestJobRunner_TriggerNotExecutable(t *testing.T) {
+	runner, transportSvc, _ := setupJobRunner(t, oracle.NewMockRepository())
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRP"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+
+	_, err = runner.Trigger(ctx, transport.ID)
+	assert.ErrorIs(t, err, ErrTransportNotExecutable)
+
+	_, err = runner.Trigger(ctx, "non-existent")
+	assert.ErrorIs(t, err, ErrTransportNotFound)
+}
+
+// TestJobRunner_IncrementalWatermark는 이전 성공 Job의 기준값으로 증분 추출하고
+// full_reload 시 기준값을 무시하는지 테스트합니다
+func TestJobRunner_IncrementalWatermark(t *testing.T) {
+	first := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)
+
+	var (
+		mu       sync.Mutex
+		captured []*domain.WatermarkRange
+		maxValue = first
+	)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(*domain.ChunkResult) error) error {
+		mu.Lock()
+		captured = append(captured, opts.Watermark)
+		value := maxValue
+		mu.Unlock()
+		return handler(&domain.ChunkResult{
+			TableName:      tableName,
+			ChunkNumber:    1,
+			RowCount:       10,
+			IsLastChunk:    true,
+			TotalRowsSent:  10,
+			WatermarkValue: &value,
+		})
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Incremental",
+		Tables: []string{"RA_CUSTOMER_TRX_ALL"},
+		TableOptions: map[string]domain.TableOptions{
+			"RA_CUSTOMER_TRX_ALL": {WatermarkColumn: "LAST_UPDATE_DATE"},
+		},
+	})
+	require.NoError(t, err)
+
+	run := func(opts TriggerOptions) *domain.Job {
+		t.Helper()
+		job, err := runner.TriggerWithOptions(ctx, transport.ID, opts)
+		require.NoError(t, err)
+		runner.Wait()
+		finished, err := jobSvc.GetByID(ctx, job.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.JobStatusCompleted, finished.Status)
+		require.Len(t, finished.Extractions, 1)
+		return finished
+	}
+
+	// 첫 실행: 기준값이 없으므로 전체 추출
+	job := run(TriggerOptions{})
+	require.NotNil(t, captured[0])
+	assert.Nil(t, captured[0].From)
+	require.NotNil(t, job.Extractions[0].Watermark)
+	assert.True(t, first.Equal(*job.Extractions[0].Watermark.To))
+	assert.False(t, job.Extractions[0].Watermark.Inclusive)
+
+	// 두 번째 실행: 이전 기준값부터 증분 추출
+	mu.Lock()
+	maxValue = second
+	mu.Unlock()
+	job = run(TriggerOptions{})
+	require.NotNil(t, captured[1].From)
+	assert.True(t, first.Equal(*captured[1].From))
+	assert.Equal(t, "LAST_UPDATE_DATE", job.Extractions[0].Watermark.Column)
+	assert.True(t, second.Equal(*job.Extractions[0].Watermark.To))
+	assert.True(t, job.Extractions[0].Watermark.Inclusive, "하한 포함 조회는 직전 최대값과 같은 row가 중복될 수 있음")
+
+	// full_reload: 기준값 무시
+	run(TriggerOptions{FullReload: true})
+	assert.Nil(t, captured[2].From)
+
+	list, err := runner.watermarkSvc.List(ctx, transport.ID)
+	require.NoError(t, err)
+	require.Len(t, list.Watermarks, 1)
+	assert.True(t, second.Equal(list.Watermarks[0].Value))
+}
+
+// TestJobRunner_FailedJobKeepsWatermark는 실패한 Job이 기준값을 갱신하지 않는지 테스트합니다
+func TestJobRunner_FailedJobKeepsWatermark(t *testing.T) {
+	value := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 10, IsLastChunk: true, TotalRowsSent: 10, WatermarkValue: &value},
+	}
+	mockRepo.TableErrors = map[string]error{
+		"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist"),
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Incremental",
+		Tables: []string{"VBRP", "FAIL_TABLE"},
+		TableOptions: map[string]domain.TableOptions{
+			"VBRP": {WatermarkColumn: "AEDAT"},
+		},
+	})
+	require.NoError(t, err)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, finished.Status)
+
+	list, err := runner.watermarkSvc.List(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list.Watermarks)
+}
+
+// TestJobRunner_ConsistentSnapshot은 모든 테이블이 Job 시작 시점의 같은 SCN으로 조회되는지 테스트합니다
+func TestJobRunner_ConsistentSnapshot(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		scns = make(map[string]uint64)
+	)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockSCN = 4815162342
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(*domain.ChunkResult) error) error {
+		mu.Lock()
+		scns[tableName] = opts.AsOfSCN
+		mu.Unlock()
+		return handler(&domain.ChunkResult{TableName: tableName, ChunkNumber: 1, RowCount: 1, IsLastChunk: true, TotalRowsSent: 1})
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:               "Snapshot",
+		Tables:             []string{"RA_CUSTOMER_TRX_ALL", "RA_CUSTOMER_TRX_LINES_ALL"},
+		ConsistentSnapshot: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, transport.ConsistentSnapshot)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	assert.Equal(t, uint64(4815162342), finished.SnapshotSCN)
+	assert.Equal(t, map[string]uint64{
+		"RA_CUSTOMER_TRX_ALL":       4815162342,
+		"RA_CUSTOMER_TRX_LINES_ALL": 4815162342,
+	}, scns)
+
+	// 옵션이 없으면 SCN을 사용하지 않음
+	plain, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Plain", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+	job, err = runner.Trigger(ctx, plain.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err = jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Zero(t, finished.SnapshotSCN)
+	assert.Zero(t, scns["VBRP"])
+}
+
+// TestJobRunner_ConsistentSnapshotRetry는 일관된 스냅샷 Job의 재시도가 새 SCN으로 모든 테이블을 다시 추출하고,
+// ORA-01555로 실패한 Job의 재시도는 거부하는지 테스트합니다
+func TestJobRunner_ConsistentSnapshotRetry(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		failure error
+		scns    = make(map[string][]uint64)
+	)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockSCN = 100
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(*domain.ChunkResult) error) error {
+		mu.Lock()
+		scns[tableName] = append(scns[tableName], opts.AsOfSCN)
+		err := failure
+		mu.Unlock()
+		if err != nil && tableName == "VBRP" {
+			return err
+		}
+		return handler(&domain.ChunkResult{TableName: tableName, ChunkNumber: 1, RowCount: 1, IsLastChunk: true, TotalRowsSent: 1})
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	run := func(err error) *domain.Job {
+		mu.Lock()
+		failure = err
+		scns = make(map[string][]uint64)
+		mu.Unlock()
+		transport, createErr := transportSvc.Create(ctx, domain.CreateTransportRequest{
+			Name:               "Snapshot",
+			Tables:             []string{"VBRK", "VBRP"},
+			ConsistentSnapshot: true,
+		})
+		require.NoError(t, createErr)
+		job, triggerErr := runner.Trigger(ctx, transport.ID)
+		require.NoError(t, triggerErr)
+		runner.Wait()
+		failed, getErr := jobSvc.GetByID(ctx, job.ID)
+		require.NoError(t, getErr)
+		require.Equal(t, domain.JobStatusFailed, failed.Status)
+		return failed
+	}
+
+	failed := run(errors.New("ORA-03113: end-of-file on communication channel"))
+	assert.Equal(t, uint64(100), failed.SnapshotSCN)
+
+	mu.Lock()
+	failure = nil
+	scns = make(map[string][]uint64)
+	mu.Unlock()
+	mockRepo.MockSCN = 200
+
+	_, err := runner.Retry(ctx, failed.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	assert.Equal(t, uint64(200), finished.SnapshotSCN)
+	// 완료된 VBRK도 새 SCN으로 다시 추출
+	mu.Lock()
+	assert.Equal(t, map[string][]uint64{"VBRK": {200}, "VBRP": {200}}, scns)
+	mu.Unlock()
+
+	// undo 만료로 실패한 Job은 전체 재실행이 필요
+	expired := run(errors.New("ORA-01555: snapshot too old: rollback segment number 9 with name \"_SYSSMU9$\" too small"))
+	_, err = runner.Retry(ctx, expired.ID)
+	require.ErrorIs(t, err, ErrJobNotRetryable)
+	assert.Contains(t, err.Error(), "전체 재실행")
+}
+
+// TestJobRunner_ConsistentSnapshotSCNFailure는 SCN 조회 실패 시 Job 실패 처리를 테스트합니다
+func TestJobRunner_ConsistentSnapshotSCNFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.ShouldError = true
+	mockRepo.ErrorMessage = "ORA-00942: table or view does not exist"
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:               "Snapshot",
+		Tables:             []string{"VBRP"},
+		ConsistentSnapshot: true,
+	})
+	require.NoError(t, err)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, finished.Status)
+	require.NotNil(t, finished.Error)
+	assert.Contains(t, *finished.Error, "ORA-00942")
+	assert.False(t, mockRepo.StreamCalled)
+}
+
+// TestJobRunner_Cancel은 실행 중인 Job 취소 시 추출/업로드 중단과 상태 정리를 테스트합니다
+func TestJobRunner_Cancel(t *testing.T) {
+	started := make(chan struct{})
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, chunk := range mockRowChunks(1, 10) {
+			if err := handler(chunk); err != nil {
+				return err
+			}
+		}
+		if tableName != "VBRP" {
+			return nil
+		}
+		// 취소될 때까지 추출이 진행 중인 상태 유지
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	broadcaster := sse.NewBroadcaster()
+	sseCtx, sseCancel := context.WithCancel(context.Background())
+	defer sseCancel()
+	go broadcaster.Run(sseCtx)
+
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := NewTransportService(transportRepo)
+	jobSvc := NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 1)
+	runner := NewJobRunner(transportSvc, jobSvc, nil, executor, broadcaster, JobRunnerConfig{Owner: "SAPSR3", Concurrency: 1})
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRK", "VBRP", "LIKP"},
+	})
+	require.NoError(t, err)
+	client := broadcaster.Register(transport.ID)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("VBRP 추출이 시작되지 않았습니다")
+	}
+
+	cancelled, err := runner.Cancel(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCancelled, cancelled.Status)
+	require.Len(t, cancelled.Extractions, 3)
+	assert.Equal(t, "LIKP", cancelled.Extractions[0].TableName)
+	assert.Equal(t, domain.ExtractionStatusCancelled, cancelled.Extractions[0].Status)
+	assert.Equal(t, domain.ExtractionStatusCompleted, cancelled.Extractions[1].Status)
+	assert.Equal(t, domain.ExtractionStatusCancelled, cancelled.Extractions[2].Status)
+	assert.Nil(t, cancelled.Extractions[2].Error)
+
+	// 완료된 테이블만 GCS 객체가 확정되어야 함
+	assert.Equal(t, []string{
+		transport.ID + "/v001/VBRK.jsonl.gz",
+		transport.ID + "/v001/_FAILED",
+		transport.ID + "/v001/manifest.json",
+	}, gcsClient.ObjectPaths())
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+
+	// 취소 상태 이벤트 발송
+	timeout := time.After(5 * time.Second)
+	for found := false; !found; {
+		select {
+		case event := <-client.Events:
+			if status, ok := event.Data.(sse.StatusEvent); ok && status.Status == sse.StatusCancelled {
+				found = true
+			}
+		case <-timeout:
+			t.Fatal("취소 이벤트를 받지 못했습니다")
+		}
+	}
+
+	// 종료된 Job은 다시 취소할 수 없음
+	_, err = runner.Cancel(ctx, job.ID)
+	assert.ErrorIs(t, err, ErrJobNotCancellable)
+
+	_, err = runner.Cancel(ctx, "JOB-NOT-EXIST")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+// TestJobRunner_CancelOrphanedJob은 실행기에 등록되지 않은 미종료 Job의 취소를 테스트합니다
+func TestJobRunner_CancelOrphanedJob(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Test", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+
+	job, err := jobSvc.CreateJob(ctx, transport.ID)
+	require.NoError(t, err)
+	require.NoError(t, jobSvc.StartJob(ctx, job.ID))
+
+	cancelled, err := runner.Cancel(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCancelled, cancelled.Status)
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+}
+
+// TestJobRunner_Shutdown은 서버 종료 시 실행 중인 Job을 중단하고 종료 상태 기록까지 대기하는지 테스트합니다
+func TestJobRunner_Shutdown(t *testing.T) {
+	started := make(chan struct{})
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	runner, transportSvc, jobSvc := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Test", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("추출이 시작되지 않았습니다")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, runner.Shutdown(shutdownCtx))
+
+	// Shutdown이 반환되면 종료 상태가 이미 기록되어 있어야 함 (재시도 가능한 실패)
+	stopped, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, stopped.Status)
+	require.NotNil(t, stopped.Error)
+	assert.Contains(t, *stopped.Error, ErrRunnerShutdown.Error())
+	assert.True(t, stopped.Status.IsRetryable())
+
+	// 종료 이후에는 새 Job을 시작하지 않음
+	_, err = runner.Trigger(ctx, transport.ID)
+	assert.ErrorIs(t, err, ErrRunnerShutdown)
+	_, err = runner.Retry(ctx, job.ID)
+	assert.ErrorIs(t, err, ErrRunnerShutdown)
+}
+
+// TestJobRunner_Retry는 실패한 테이블만 같은 버전으로 다시 추출하고 시도 횟수를 기록하는지 테스트합니다
+func TestJobRunner_Retry(t *testing.T) {
+	var mu sync.Mutex
+	failing := true
+	streamed := make(map[string]int)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mu.Lock()
+		streamed[tableName]++
+		fail := failing && tableName == "VBRP"
+		mu.Unlock()
+		if fail {
+			return errors.New("ORA-03113: end-of-file on communication channel")
+		}
+		for _, chunk := range mockRowChunks(1, 10) {
+			if err := handler(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := NewTransportService(transportRepo)
+	jobSvc := NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+	runner := NewJobRunner(transportSvc, jobSvc, nil, executor, nil, JobRunnerConfig{Owner: "SAPSR3"})
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRK", "VBRP"},
+	})
+	require.NoError(t, err)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	failed, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.JobStatusFailed, failed.Status)
+	assert.Equal(t, 1, failed.Attempt)
+
+	// 이전 시도에서 남은 part 객체는 재시도 전에 정리되어야 함
+	stale, err := gcsClient.NewWriter(ctx, transport.ID+"/v001/VBRP/part-00003.jsonl.gz")
+	require.NoError(t, err)
+	require.NoError(t, stale.Close())
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+
+	// 시도 사이에 정의가 바뀌어도 재시도는 첫 시도의 리비전 정의로 실행 (추가된 LIKP는 추출하지 않음)
+	patched, err := transportSvc.Patch(ctx, transport.ID, []byte(`{"tables":["VBRK","VBRP","LIKP"]}`), 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, patched.Revision)
+
+	retried, err := runner.Retry(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, retried.ID)
+	assert.Equal(t, domain.JobStatusPending, retried.Status)
+	assert.Equal(t, 2, retried.Attempt)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	assert.Equal(t, 1, finished.Version)
+	assert.Equal(t, 1, finished.TransportRevision)
+	assert.Nil(t, finished.Error)
+	require.Len(t, finished.Extractions, 2)
+	assert.Equal(t, "VBRK", finished.Extractions[0].TableName)
+	assert.Equal(t, 1, finished.Extractions[0].Attempts)
+	assert.Equal(t, "VBRP", finished.Extractions[1].TableName)
+	assert.Equal(t, domain.ExtractionStatusCompleted, finished.Extractions[1].Status)
+	assert.Equal(t, 2, finished.Extractions[1].Attempts)
+	assert.Equal(t, int64(20), finished.Metrics.TotalRows)
+
+	// 완료된 테이블은 다시 추출하지 않음
+	mu.Lock()
+	assert.Equal(t, map[string]int{"VBRK": 1, "VBRP": 2}, streamed)
+	mu.Unlock()
+
+	// 재시도 성공 시 이전 시도의 _FAILED는 제거되고 _SUCCESS가 기록됨
+	assert.Equal(t, []string{
+		transport.ID + "/v001/VBRK.jsonl.gz",
+		transport.ID + "/v001/VBRP.jsonl.gz",
+		transport.ID + "/v001/_SUCCESS",
+		transport.ID + "/v001/manifest.json",
+	}, gcsClient.ObjectPaths())
+
+	// manifest에는 이전 시도에서 완료된 테이블도 포함
+	manifest := readManifest(t, gcsClient, transport.ID+"/v001/manifest.json")
+	assert.Equal(t, 2, manifest.Attempt)
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, finished.Extractions[0].Objects, manifest.Tables[0].Objects)
+	assert.Len(t, manifest.Tables[1].Objects, 1)
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+
+	// 완료된 Job은 재시도할 수 없음
+	_, err = runner.Retry(ctx, job.ID)
+	assert.ErrorIs(t, err, ErrJobNotRetryable)
+
+	_, err = runner.Retry(ctx, "non-existent")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+// TestJobRunner_RetryOnlyLatestVersion은 이전 버전 Job의 재시도를 거부하는지 테스트합니다
+func TestJobRunner_RetryOnlyLatestVersion(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	mockRepo.TableErrors = map[string]error{
+		"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist"),
+	}
+	runner, transportSvc, _ := setupJobRunner(t, mockRepo)
+	ctx := context.Background()
+
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Test",
+		Tables: []string{"VBRP", "FAIL_TABLE"},
+	})
+	require.NoError(t, err)
+
+	first, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	_, err = runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	_, err = runner.Retry(ctx, first.ID)
+	assert.ErrorIs(t, err, ErrJobNotRetryable)
+
+	// 실행 중인 Transport는 재시도 불가
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+	jobs, err := runner.jobSvc.GetJobsByTransportID(ctx, transport.ID)
+	require.NoError(t, err)
+	_, err = runner.Retry(ctx, jobs[0].ID)
+	assert.ErrorIs(t, err, ErrTransportNotExecutable)
+}
+
+// TestJobRunner_ReconcilePolicy는 Transport 대사 정책에 따른 Job 실패와 불일치 기록을 테스트합니다
+func TestJobRunner_ReconcilePolicy(t *testing.T) {
+	tests := []struct {
+		name             string
+		policy           domain.ReconcilePolicy
+		expectStatus     domain.JobStatus
+		expectExtraction domain.ExtractionStatus
+	}{
+		{name: "warn", policy: domain.ReconcilePolicyWarn, expectStatus: domain.JobStatusCompleted, expectExtraction: domain.ExtractionStatusCompleted},
+		{name: "fail", policy: domain.ReconcilePolicyFail, expectStatus: domain.JobStatusFailed, expectExtraction: domain.ExtractionStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := oracle.NewMockRepository()
+			mockRepo.MockChunks = mockRowChunks(1, 10)
+			mockRepo.MockCounts = map[string]int64{"VBRP": 11}
+			mockRepo.MockSCN = 4815162342
+
+			gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"})
+			transportRepo := memory.NewTransportRepository()
+			transportSvc := NewTransportService(transportRepo)
+			jobSvc := NewJobService(memory.NewJobRepository(), transportRepo)
+			executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+			runner := NewJobRunner(transportSvc, jobSvc, nil, executor, nil, JobRunnerConfig{Owner: "SAPSR3"})
+			ctx := context.Background()
+
+			transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+				Name:               "Test",
+				Tables:             []string{"VBRK", "VBRP"},
+				ConsistentSnapshot: true,
+				ReconcilePolicy:    tt.policy,
+			})
+			require.NoError(t, err)
+
+			job, err := runner.Trigger(ctx, transport.ID)
+			require.NoError(t, err)
+			runner.Wait()
+
+			finished, err := jobSvc.GetByID(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, finished.Status)
+			assert.Equal(t, 1, finished.Metrics.ReconcileMismatches)
+
+			require.Len(t, finished.Extractions, 2)
+			assert.True(t, finished.Extractions[0].Reconciliation.Matched)
+			vbrp := finished.Extractions[1]
+			assert.Equal(t, tt.expectExtraction, vbrp.Status)
+			require.NotNil(t, vbrp.Reconciliation)
+			assert.False(t, vbrp.Reconciliation.Matched)
+			assert.Equal(t, int64(11), vbrp.Reconciliation.SourceRows)
+			assert.Equal(t, int64(10), vbrp.Reconciliation.StreamedRows)
+		})
+	}
+}
+
+// TestJobRunner_Metrics는 종료된 Job의 결과와 실행 시간이 메트릭에 기록되는지 테스트합니다
+func TestJobRunner_Metrics(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	mockRepo.TableErrors = map[string]error{
+		"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist"),
+	}
+
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := NewTransportService(transportRepo)
+	jobSvc := NewJobService(memory.NewJobRepository(), transportRepo)
+	metrics := telemetry.NewMetrics()
+	runner := NewJobRunner(transportSvc, jobSvc, nil, NewParallelExecutor(mockRepo, nil, nil, 2), nil, JobRunnerConfig{Owner: "SAPSR3", Metrics: metrics})
+	ctx := context.Background()
+
+	ok, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "OK", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+	failing, err := transportSvc.Create(ctx, domain.CreateTransportRequest{Name: "Fail", Tables: []string{"FAIL_TABLE"}})
+	require.NoError(t, err)
+
+	_, err = runner.Trigger(ctx, ok.ID)
+	require.NoError(t, err)
+	_, err = runner.Trigger(ctx, failing.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	var sb strings.Builder
+	require.NoError(t, metrics.Registry().Write(&sb))
+	out := sb.String()
+	assert.Contains(t, out, `oracle_etl_jobs_total{outcome="completed",transport="`+ok.ID+`"} 1`)
+	assert.Contains(t, out, `oracle_etl_jobs_total{outcome="failed",transport="`+failing.ID+`"} 1`)
+	assert.Contains(t, out, `oracle_etl_job_duration_seconds_count{outcome="completed",transport="`+ok.ID+`"} 1`)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -35,25 +36,16 @@ func (s *JobService) CreateJob(ctx context.Context, transportID string) (*domain
 		return nil, fmt.Errorf("transport를 찾을 수 없습니다: %w", err)
 	}
 
-	// 최신 버전 조회
-	latestVersion, err := s.jobRepo.GetLatestVersionByTransportID(ctx, transportID)
-	if err != nil {
-		return nil, fmt.Errorf("최신 버전 조회 실패: %w", err)
-	}
-
-	// 새 버전
-	newVersion := latestVersion + 1
-
 	// Job ID 생성
 	now := time.Now().UTC()
 	randomPart := uuid.New().String()[:8]
 	jobID := domain.GenerateJobID(now, randomPart)
 
-	// Job 엔티티 생성
-	job := domain.NewJob(jobID, transportID, newVersion)
+	// Job 엔티티 생성 (버전은 저장 시 할당)
+	job := domain.NewJob(jobID, transportID, 0)
 
-	// 저장
-	if err := s.jobRepo.Create(ctx, job); err != nil {
+	// 다음 버전 할당과 저장을 원자적으로 처리
+	if err := s.jobRepo.CreateNextVersion(ctx, job); err != nil {
 		return nil, fmt.Errorf("job 생성 실패: %w", err)
 	}
 
@@ -135,12 +127,80 @@ func (s *JobService) CancelJob(ctx context.Context, jobID string) error {
 	return s.jobRepo.Update(ctx, job)
 }
 
+// SetSnapshotSCN은 Job의 일관된 스냅샷 기준 SCN을 기록합니다
+func (s *JobService) SetSnapshotSCN(ctx context.Context, jobID string, scn uint64) error {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	job.SnapshotSCN = scn
+
+	return s.jobRepo.Update(ctx, job)
+}
+
 // UpdateJob은 Job을 업데이트합니다
 func (s *JobService) UpdateJob(ctx context.Context, job *domain.Job) error {
 	return s.jobRepo.Update(ctx, job)
 }
 
+// LatestVersion은 Transport의 가장 최근 Job 버전을 반환합니다 (Job이 없으면 0)
+func (s *JobService) LatestVersion(ctx context.Context, transportID string) (int, error) {
+	return s.jobRepo.GetLatestVersionByTransportID(ctx, transportID)
+}
+
 // GetJobsByTransportID는 특정 Transport의 모든 Job을 조회합니다
 func (s *JobService) GetJobsByTransportID(ctx context.Context, transportID string) ([]domain.Job, error) {
 	return s.jobRepo.GetByTransportID(ctx, transportID)
 }
+
+// RecoverInterrupted는 이전 프로세스 종료로 중단된 Job과 Transport 상태를 정리합니다
+// 영구 저장소에서는 실행 중(running/pending) 상태가 재시작 후에도 남아 Transport가 다시 실행되지 않으므로
+// 서버 시작 시 해당 Job을 실패 처리하고 Transport를 대기 상태로 되돌립니다
+// 유지보수 시간대를 기다리던(deferred) Job은 아직 시작 전이므로 그대로 두며, JobRunner.ResumeDeferred로 다시 예약합니다
+func (s *JobService) RecoverInterrupted(ctx context.Context) (int, error) {
+	recovered := 0
+	for _, status := range []domain.JobStatus{domain.JobStatusRunning, domain.JobStatusPending} {
+		jobs, _, err := s.jobRepo.List(ctx, domain.JobListFilter{Status: status, Limit: math.MaxInt32})
+		if err != nil {
+			return recovered, fmt.Errorf("중단된 job 조회 실패: %w", err)
+		}
+
+		for i := range jobs {
+			job := &jobs[i]
+			if job.IsDeferred() {
+				continue
+			}
+			job.Fail(errors.New("서버 재시작으로 실행이 중단되었습니다"))
+			if err := s.jobRepo.Update(ctx, job); err != nil {
+				return recovered, fmt.Errorf("job %s 상태 복구 실패: %w", job.ID, err)
+			}
+			recovered++
+
+			transport, err := s.transportRepo.GetByID(ctx, job.TransportID)
+			if err != nil || transport.Status != domain.TransportStatusRunning {
+				continue // 삭제된 Transport는 건너뜀
+			}
+			if err := s.transportRepo.UpdateStatus(ctx, job.TransportID, domain.TransportStatusIdle); err != nil {
+				return recovered, fmt.Errorf("transport %s 상태 복구 실패: %w", job.TransportID, err)
+			}
+		}
+	}
+	return recovered, nil
+}
+
+// ListDeferred는 유지보수 시간대가 열리기를 기다리는(deferred) 대기 Job을 조회합니다
+func (s *JobService) ListDeferred(ctx context.Context) ([]domain.Job, error) {
+	jobs, _, err := s.jobRepo.List(ctx, domain.JobListFilter{Status: domain.JobStatusPending, Limit: math.MaxInt32})
+	if err != nil {
+		return nil, fmt.Errorf("대기 job 조회 실패: %w", err)
+	}
+
+	deferred := make([]domain.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if job.IsDeferred() {
+			deferred = append(deferred, job)
+		}
+	}
+	return deferred, nil
+}
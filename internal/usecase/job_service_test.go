@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -212,3 +213,76 @@ func TestJobService_FailJob(t *testing.T) {
 	assert.NotNil(t, found.Error)
 	assert.Equal(t, "테스트 에러", *found.Error)
 }
+
+// TestJobService_RecoverInterrupted는 재시작 시 중단된 Job 정리를 테스트합니다
+func TestJobService_RecoverInterrupted(t *testing.T) {
+	jobRepo := memory.NewJobRepository()
+	transportRepo := memory.NewTransportRepository()
+	svc := NewJobService(jobRepo, transportRepo)
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	require.NoError(t, transportRepo.Create(ctx, transport))
+	require.NoError(t, transportRepo.UpdateStatus(ctx, "TRPID-12345678", domain.TransportStatusRunning))
+
+	completed, err := svc.CreateJob(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.NoError(t, svc.CompleteJob(ctx, completed.ID))
+
+	running, err := svc.CreateJob(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.NoError(t, svc.StartJob(ctx, running.ID))
+
+	recovered, err := svc.RecoverInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, recovered)
+
+	found, err := svc.GetByID(ctx, running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, found.Status)
+	require.NotNil(t, found.Error)
+
+	found, err = svc.GetByID(ctx, completed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, found.Status)
+
+	foundTransport, err := transportRepo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, foundTransport.Status)
+}
+
+// TestJobService_RecoverInterrupted_KeepsDeferred는 유지보수 시간대를 기다리던 Job은 실패 처리하지 않는지 테스트합니다
+func TestJobService_RecoverInterrupted_KeepsDeferred(t *testing.T) {
+	jobRepo := memory.NewJobRepository()
+	transportRepo := memory.NewTransportRepository()
+	svc := NewJobService(jobRepo, transportRepo)
+	ctx := context.Background()
+
+	transport := domain.NewTransport("TRPID-12345678", "Test", "Desc", []string{"TABLE1"})
+	require.NoError(t, transportRepo.Create(ctx, transport))
+	require.NoError(t, transportRepo.UpdateStatus(ctx, "TRPID-12345678", domain.TransportStatusRunning))
+
+	deferred, err := svc.CreateJob(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	deferredUntil := time.Now().Add(time.Hour).UTC()
+	deferred.DeferredUntil = &deferredUntil
+	require.NoError(t, svc.UpdateJob(ctx, deferred))
+
+	recovered, err := svc.RecoverInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, recovered)
+
+	found, err := svc.GetByID(ctx, deferred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusPending, found.Status)
+	assert.Nil(t, found.Error)
+
+	foundTransport, err := transportRepo.GetByID(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusRunning, foundTransport.Status)
+
+	list, err := svc.ListDeferred(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, deferred.ID, list[0].ID)
+}
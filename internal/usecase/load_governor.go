@@ -0,0 +1,30 @@
+// Package usecase는 비즈니스 로직을 구현합니다
+package usecase
+
+import (
+	"oracle-etl/internal/adapter/sse"
+	"oracle-etl/internal/governor"
+)
+
+// SetGovernor는 모든 Job의 Oracle 추출 부하를 조절할 Governor를 설정합니다
+// 추출과 row 수 대사(COUNT(*))는 커서를 열기 전에, 추출 중에는 청크마다 Governor의 허용을 기다립니다
+func (e *ParallelExecutor) SetGovernor(g *governor.Governor) {
+	e.governor = g
+}
+
+// throttleInfo는 진행률 이벤트에 포함할 부하 조절 상태를 반환합니다 (Governor가 없으면 nil)
+func (e *ParallelExecutor) throttleInfo() *sse.ThrottleInfo {
+	if e.governor == nil {
+		return nil
+	}
+	status := e.governor.Status()
+	return &sse.ThrottleInfo{
+		State:              string(status.Mode),
+		Reason:             status.Reason,
+		HostCPUPercent:     status.HostCPU,
+		ActiveSessions:     status.ActiveSessions,
+		ActiveCursors:      status.ActiveCursors,
+		MaxCursors:         status.MaxCursors,
+		RowsPerSecondLimit: status.RowsPerSecond,
+	}
+}
@@ -0,0 +1,45 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/domain"
+)
+
+// lobObjectWriter는 object 정책 LOB 값을 테이블(또는 분할 범위)별 GCS 객체로 스트리밍합니다
+// 한 범위의 추출(커서 하나)에서 row 순서대로 호출되므로 동기화하지 않습니다
+type lobObjectWriter struct {
+	client      gcs.Client
+	transportID string
+	jobVersion  string
+	tableName   string
+	part        int                 // 분할 범위 번호 (분할하지 않으면 0)
+	objects     []domain.ObjectInfo // 업로드한 LOB 객체 (row 순서)
+	bytes       int64               // 업로드한 LOB 객체의 총 바이트 수
+}
+
+// WriteLOB은 LOB 값을 {table}/_lobs/{column}/{part}-{row}.bin 객체로 업로드하고 gs:// URI를 반환합니다
+// 업로드한 객체의 경로, 크기, CRC32C는 테이블 결과와 manifest에 기록하기 위해 보관합니다
+func (w *lobObjectWriter) WriteLOB(ctx context.Context, column string, row int64, r io.Reader) (string, error) {
+	objectPath := w.client.LOBObjectPath(w.transportID, w.jobVersion, w.tableName, column, w.part, row)
+	result, err := gcs.CopyObject(ctx, w.client, objectPath, r)
+	if err != nil {
+		return "", err
+	}
+	uri := fmt.Sprintf("gs://%s/%s", w.client.BucketName(), objectPath)
+	w.objects = append(w.objects, domain.ObjectInfo{
+		Path:              uri,
+		ByteCount:         result.BytesWritten,
+		UncompressedBytes: result.BytesOriginal,
+		CRC32C:            gcs.EncodeCRC32C(result.CRC32C),
+	})
+	w.bytes += result.BytesWritten
+	return uri, nil
+}
+
+// 인터페이스 구현 확인
+var _ domain.LOBWriter = (*lobObjectWriter)(nil)
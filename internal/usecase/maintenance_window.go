@@ -0,0 +1,262 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/maintenance"
+)
+
+// 스케줄 실행 시각 탐색 상한 (reject 정책에서 시간대 안의 예정 시각을 찾을 때)
+const maxScheduleSearchSteps = 1000
+
+// windowRecheckInterval은 닫히는 시각이 없는(탐색 범위 밖) 시간대를 다시 확인하는 주기입니다
+const windowRecheckInterval = time.Hour
+
+var (
+	// ErrOutsideMaintenanceWindow는 유지보수 시간대 밖이어서 실행할 수 없을 때 반환됩니다
+	ErrOutsideMaintenanceWindow = errors.New("유지보수 시간대 밖이어서 실행할 수 없습니다")
+	// ErrMaintenanceWindowClosed는 실행 중에 유지보수 시간대가 닫혀 Job을 중단한 경우의 원인입니다 (on_close: abort)
+	ErrMaintenanceWindowClosed = errors.New("유지보수 시간대가 닫혀 job을 중단했습니다")
+)
+
+// WindowError는 유지보수 시간대 밖 실행 요청의 거부 사유와 다음 실행 가능 시각입니다
+// errors.Is(err, ErrOutsideMaintenanceWindow)로 확인할 수 있습니다
+type WindowError struct {
+	Reason        string    // 실행할 수 없는 이유
+	NextAllowedAt time.Time // 다음 실행 가능 시각 (없으면 zero time)
+}
+
+// Error는 에러 메시지를 반환합니다
+func (e *WindowError) Error() string {
+	msg := fmt.Sprintf("%v: %s", ErrOutsideMaintenanceWindow, e.Reason)
+	if !e.NextAllowedAt.IsZero() {
+		msg += fmt.Sprintf(" (다음 실행 가능 시각: %s)", e.NextAllowedAt.Format(time.RFC3339))
+	}
+	return msg
+}
+
+// Unwrap은 ErrOutsideMaintenanceWindow를 반환합니다
+func (e *WindowError) Unwrap() error {
+	return ErrOutsideMaintenanceWindow
+}
+
+// MaintenanceConfig는 유지보수 시간대와 Transport가 처리 방식을 지정하지 않았을 때의 기본값입니다
+type MaintenanceConfig struct {
+	Calendar      *maintenance.Calendar      // 이름별 유지보수 시간대 (nil이면 제한 없음)
+	OutsideWindow domain.OutsideWindowAction // 시간대 밖 실행 요청 처리 (빈 값이면 reject)
+	OnClose       domain.WindowCloseAction   // 실행 중 시간대가 닫힐 때 처리 (빈 값이면 continue)
+}
+
+// policy는 Transport에 적용할 시간대 이름과 처리 방식을 반환합니다
+func (c MaintenanceConfig) policy(transport *domain.Transport) ([]string, domain.OutsideWindowAction, domain.WindowCloseAction) {
+	outside, onClose := c.OutsideWindow, c.OnClose
+	if outside == "" {
+		outside = domain.OutsideWindowReject
+	}
+	if onClose == "" {
+		onClose = domain.WindowCloseContinue
+	}
+
+	var windows []string
+	if p := transport.Maintenance; p != nil {
+		windows = p.Windows
+		if p.OutsideWindow != "" {
+			outside = p.OutsideWindow
+		}
+		if p.OnClose != "" {
+			onClose = p.OnClose
+		}
+	}
+	return windows, outside, onClose
+}
+
+// validate는 Transport가 지정한 시간대가 모두 정의되어 있는지 확인합니다
+func (c MaintenanceConfig) validate(transport *domain.Transport) error {
+	if transport.Maintenance == nil {
+		return nil
+	}
+	for _, name := range transport.Maintenance.Windows {
+		if !c.Calendar.Has(name) {
+			return fmt.Errorf("정의되지 않은 유지보수 시간대: %s", name)
+		}
+	}
+	return nil
+}
+
+// executionWindow는 now 기준 Transport의 실행 가능 상태를 계산합니다 (적용된 시간대가 없으면 nil)
+func (c MaintenanceConfig) executionWindow(transport *domain.Transport, now time.Time) *domain.ExecutionWindow {
+	windows, outside, onClose := c.policy(transport)
+	status := c.Calendar.Status(windows, now)
+	if !status.Restricted() {
+		return nil
+	}
+
+	ew := &domain.ExecutionWindow{
+		Allowed:       status.Allowed,
+		Reason:        status.Reason,
+		Windows:       status.Windows,
+		OutsideWindow: string(outside),
+		OnClose:       string(onClose),
+		NextAllowedAt: timePtr(status.NextAllowedAt),
+		ClosesAt:      timePtr(status.ClosesAt),
+	}
+	if transport.Schedule != nil {
+		ew.NextScheduledRunAt = timePtr(c.nextScheduledRun(transport.Schedule, windows, outside, now))
+	}
+	return ew
+}
+
+// nextScheduledRun은 시간대를 반영한 다음 스케줄 실행 시각을 반환합니다 (없으면 zero time)
+// reject는 시간대 안의 첫 예정 시각, defer는 다음 예정 시각 이후 시간대가 처음 열리는 시각입니다
+func (c MaintenanceConfig) nextScheduledRun(schedule *domain.CronSchedule, windows []string, outside domain.OutsideWindowAction, now time.Time) time.Time {
+	parsed, loc, err := schedule.Parse()
+	if err != nil {
+		return time.Time{}
+	}
+
+	slot := parsed.Next(now.In(loc))
+	for step := 0; step < maxScheduleSearchSteps && !slot.IsZero(); step++ {
+		status := c.Calendar.Status(windows, slot)
+		if status.Allowed || outside == domain.OutsideWindowDefer {
+			return status.NextAllowedAt
+		}
+		if status.NextAllowedAt.IsZero() {
+			return time.Time{}
+		}
+		// 시간대가 다시 열리는 시각 이후의 첫 예정 시각부터 확인
+		slot = parsed.Next(status.NextAllowedAt.In(loc).Add(-time.Nanosecond))
+	}
+	return time.Time{}
+}
+
+// timePtr는 zero time이 아니면 포인터를 반환합니다
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// jobWindow는 Job 실행에 적용되는 유지보수 시간대입니다
+type jobWindow struct {
+	calendar      *maintenance.Calendar
+	windows       []string
+	onClose       domain.WindowCloseAction
+	deferredUntil time.Time                                    // 시간대 밖이어서 실행을 미룬 경우 실행 예정 시각
+	notify        func(paused bool, status maintenance.Status) // on_close: pause 상태 변경 알림
+
+	mu        sync.Mutex
+	openUntil time.Time // 이 시각 전까지는 다시 계산하지 않고 실행 허용
+	paused    bool
+}
+
+// SetMaintenance는 Job 실행에 적용할 유지보수 시간대를 설정합니다
+// 설정하지 않으면 시간대 제한 없이 실행합니다
+func (r *JobRunner) SetMaintenance(cfg MaintenanceConfig) {
+	r.maintenance = cfg
+}
+
+// checkWindow는 Transport의 유지보수 시간대를 확인합니다 (적용된 시간대가 없으면 nil)
+// 시간대 밖이면 reject 정책은 WindowError를 반환하고, defer 정책은 실행 예정 시각을 기록한 jobWindow를 반환합니다
+func (r *JobRunner) checkWindow(transport *domain.Transport) (*jobWindow, error) {
+	windows, outside, onClose := r.maintenance.policy(transport)
+	status := r.maintenance.Calendar.Status(windows, time.Now())
+	if !status.Restricted() {
+		return nil, nil
+	}
+
+	window := &jobWindow{calendar: r.maintenance.Calendar, windows: windows, onClose: onClose}
+	if !status.Allowed {
+		if outside != domain.OutsideWindowDefer || status.NextAllowedAt.IsZero() {
+			return nil, &WindowError{Reason: status.Reason, NextAllowedAt: status.NextAllowedAt}
+		}
+		window.deferredUntil = status.NextAllowedAt
+	}
+	return window, nil
+}
+
+// deferred는 시간대 밖이어서 실행을 미룬 Job인지 확인합니다
+func (w *jobWindow) deferred() bool {
+	return w != nil && !w.deferredUntil.IsZero()
+}
+
+// waitOpen은 시간대가 열릴 때까지 대기합니다 (실행을 미룬 Job의 시작 전)
+func (w *jobWindow) waitOpen(ctx context.Context) error {
+	return w.calendar.Wait(ctx, w.windows)
+}
+
+// gate는 시간대가 닫혀 있으면 다시 열릴 때까지 대기합니다 (on_close: pause)
+// 테이블 추출 커서를 열기 전에만 호출되므로 진행 중인 테이블은 끝까지 추출한 뒤 멈추며,
+// 상태가 바뀔 때 한 번씩 notify를 호출합니다
+func (w *jobWindow) gate(ctx context.Context) error {
+	for {
+		now := time.Now()
+		w.mu.Lock()
+		if now.Before(w.openUntil) {
+			w.mu.Unlock()
+			return nil
+		}
+
+		status := w.calendar.Status(w.windows, now)
+		if status.Allowed {
+			w.openUntil = status.ClosesAt
+			if w.openUntil.IsZero() {
+				w.openUntil = now.Add(windowRecheckInterval)
+			}
+			resumed := w.paused
+			w.paused = false
+			w.mu.Unlock()
+			if resumed && w.notify != nil {
+				w.notify(false, status)
+			}
+			return nil
+		}
+		if status.NextAllowedAt.IsZero() {
+			w.mu.Unlock()
+			return fmt.Errorf("%w: %s", maintenance.ErrNoUpcomingWindow, status.Reason)
+		}
+		paused := !w.paused
+		w.paused = true
+		w.mu.Unlock()
+		if paused && w.notify != nil {
+			w.notify(true, status)
+		}
+
+		timer := time.NewTimer(min(time.Until(status.NextAllowedAt), windowRecheckInterval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// watch는 시간대가 닫히면 ErrMaintenanceWindowClosed를 원인으로 Job context를 취소합니다 (on_close: abort)
+func (w *jobWindow) watch(ctx context.Context, abort context.CancelCauseFunc) {
+	for {
+		status := w.calendar.Status(w.windows, time.Now())
+		if !status.Allowed {
+			abort(fmt.Errorf("%w: %s", ErrMaintenanceWindowClosed, status.Reason))
+			return
+		}
+
+		wait := windowRecheckInterval
+		if !status.ClosesAt.IsZero() {
+			wait = min(time.Until(status.ClosesAt), wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
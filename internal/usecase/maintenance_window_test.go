@@ -0,0 +1,380 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/adapter/oracle"
+	"oracle-etl/internal/adapter/sse"
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/maintenance"
+	"oracle-etl/internal/repository/memory"
+)
+
+// setupMaintenanceRunner는 유지보수 시간대를 적용한 테스트용 JobRunner와 서비스를 생성합니다
+func setupMaintenanceRunner(t *testing.T, mockRepo *oracle.MockRepository, broadcaster *sse.Broadcaster, windows ...maintenance.Window) (*JobRunner, *TransportService, *JobService) {
+	t.Helper()
+
+	calendar, err := maintenance.New(windows)
+	require.NoError(t, err)
+	cfg := MaintenanceConfig{Calendar: calendar}
+
+	transportRepo := memory.NewTransportRepository()
+	transportSvc := NewTransportService(transportRepo)
+	transportSvc.SetMaintenance(cfg)
+	jobSvc := NewJobService(memory.NewJobRepository(), transportRepo)
+	executor := NewParallelExecutor(mockRepo, nil, nil, 1)
+	runner := NewJobRunner(transportSvc, jobSvc, nil, executor, broadcaster, JobRunnerConfig{Owner: "SAPSR3", Concurrency: 1})
+	runner.SetMaintenance(cfg)
+	return runner, transportSvc, jobSvc
+}
+
+// createMaintenanceTransport는 policy를 적용한 테스트용 Transport를 생성합니다
+func createMaintenanceTransport(t *testing.T, transportSvc *TransportService, policy *domain.MaintenancePolicy) *domain.Transport {
+	t.Helper()
+	transport, err := transportSvc.Create(context.Background(), domain.CreateTransportRequest{
+		Name:        "Test",
+		Tables:      []string{"VBRP"},
+		Maintenance: policy,
+	})
+	require.NoError(t, err)
+	return transport
+}
+
+// waitStatusEvent는 status 상태 이벤트를 받을 때까지 대기합니다
+func waitStatusEvent(t *testing.T, client *sse.Client, status string) {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-client.Events:
+			if data, ok := event.Data.(sse.StatusEvent); ok && data.Status == status {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("%s 상태 이벤트를 받지 못했습니다", status)
+		}
+	}
+}
+
+// TestJobRunner_MaintenanceReject는 시간대 밖 실행 요청 거부를 테스트합니다
+func TestJobRunner_MaintenanceReject(t *testing.T) {
+	opens := time.Now().Add(time.Hour).Truncate(time.Second)
+	runner, transportSvc, _ := setupMaintenanceRunner(t, oracle.NewMockRepository(), nil,
+		maintenance.Window{Name: "later", Ranges: []maintenance.Range{{Start: opens, End: opens.Add(time.Hour)}}},
+	)
+	ctx := context.Background()
+
+	// 정의되지 않은 시간대는 생성 시 거부
+	_, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:        "Test",
+		Tables:      []string{"VBRP"},
+		Maintenance: &domain.MaintenancePolicy{Windows: []string{"unknown"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown")
+
+	transport := createMaintenanceTransport(t, transportSvc, &domain.MaintenancePolicy{Windows: []string{"later"}})
+	_, err = runner.Trigger(ctx, transport.ID)
+	require.ErrorIs(t, err, ErrOutsideMaintenanceWindow)
+	var windowErr *WindowError
+	require.True(t, errors.As(err, &windowErr))
+	assert.True(t, windowErr.NextAllowedAt.Equal(opens))
+	assert.Contains(t, windowErr.Reason, "later")
+
+	// 거부된 요청은 Transport 상태를 바꾸지 않음
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+
+	// 시간대를 지정하지 않은 Transport는 제한 없음
+	free := createMaintenanceTransport(t, transportSvc, nil)
+	_, err = runner.Trigger(ctx, free.ID)
+	require.NoError(t, err)
+	runner.Wait()
+}
+
+// TestJobRunner_MaintenanceDefer는 시간대 밖 실행 요청을 시간대가 열릴 때까지 미루는지 테스트합니다
+func TestJobRunner_MaintenanceDefer(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	opens := time.Now().Add(150 * time.Millisecond)
+	broadcaster := sse.NewBroadcaster()
+	sseCtx, sseCancel := context.WithCancel(context.Background())
+	defer sseCancel()
+	go broadcaster.Run(sseCtx)
+
+	runner, transportSvc, jobSvc := setupMaintenanceRunner(t, mockRepo, broadcaster,
+		maintenance.Window{Name: "soon", Ranges: []maintenance.Range{{Start: opens, End: opens.Add(time.Hour)}}},
+	)
+	ctx := context.Background()
+	transport := createMaintenanceTransport(t, transportSvc, &domain.MaintenancePolicy{
+		Windows:       []string{"soon"},
+		OutsideWindow: domain.OutsideWindowDefer,
+	})
+	client := broadcaster.Register(transport.ID)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusPending, job.Status)
+	require.NotNil(t, job.DeferredUntil)
+	assert.True(t, job.DeferredUntil.Equal(opens))
+	waitStatusEvent(t, client, sse.StatusDeferred)
+
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	require.NotNil(t, finished.StartedAt)
+	assert.False(t, finished.StartedAt.Before(opens), "시간대가 열리기 전에 시작되었습니다: %s", finished.StartedAt)
+}
+
+// TestJobRunner_ResumeDeferred는 재시작 전에 시간대를 기다리던 Job을 다시 예약하는지 테스트합니다
+func TestJobRunner_ResumeDeferred(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	opens := time.Now().Add(150 * time.Millisecond)
+	runner, transportSvc, jobSvc := setupMaintenanceRunner(t, mockRepo, nil,
+		maintenance.Window{Name: "soon", Ranges: []maintenance.Range{{Start: opens, End: opens.Add(time.Hour)}}},
+	)
+	ctx := context.Background()
+	policy := &domain.MaintenancePolicy{Windows: []string{"soon"}, OutsideWindow: domain.OutsideWindowDefer}
+
+	// 재시작 전 프로세스가 남긴 상태: 실행을 미룬 pending Job과 running 상태의 Transport
+	deferJob := func(transportID string) *domain.Job {
+		job, err := jobSvc.CreateJob(ctx, transportID)
+		require.NoError(t, err)
+		stale := opens.Add(-time.Minute)
+		job.DeferredUntil = &stale
+		require.NoError(t, jobSvc.UpdateJob(ctx, job))
+		require.NoError(t, transportSvc.UpdateStatus(ctx, transportID, domain.TransportStatusRunning))
+		return job
+	}
+	transport := createMaintenanceTransport(t, transportSvc, policy)
+	job := deferJob(transport.ID)
+	deleted := createMaintenanceTransport(t, transportSvc, policy)
+	orphan := deferJob(deleted.ID)
+	require.NoError(t, transportSvc.UpdateStatus(ctx, deleted.ID, domain.TransportStatusIdle))
+	require.NoError(t, transportSvc.Delete(ctx, deleted.ID))
+
+	recovered, err := jobSvc.RecoverInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, recovered)
+
+	resumed, err := runner.ResumeDeferred(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resumed)
+
+	// 실행 예정 시각은 현재 시간대 기준으로 다시 계산
+	rearmed, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	require.NotNil(t, rearmed.DeferredUntil)
+	assert.True(t, rearmed.DeferredUntil.Equal(opens))
+
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, finished.Status)
+	require.NotNil(t, finished.StartedAt)
+	assert.False(t, finished.StartedAt.Before(opens), "시간대가 열리기 전에 시작되었습니다: %s", finished.StartedAt)
+
+	failed, err := jobSvc.GetByID(ctx, orphan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, failed.Status)
+	require.NotNil(t, failed.Error)
+}
+
+// TestJobRunner_ShutdownKeepsDeferred는 서버 종료 시 시간대를 기다리던 Job을 실패 처리하지 않는지 테스트합니다
+func TestJobRunner_ShutdownKeepsDeferred(t *testing.T) {
+	opens := time.Now().Add(time.Hour)
+	runner, transportSvc, jobSvc := setupMaintenanceRunner(t, oracle.NewMockRepository(), nil,
+		maintenance.Window{Name: "later", Ranges: []maintenance.Range{{Start: opens, End: opens.Add(time.Hour)}}},
+	)
+	ctx := context.Background()
+	transport := createMaintenanceTransport(t, transportSvc, &domain.MaintenancePolicy{
+		Windows:       []string{"later"},
+		OutsideWindow: domain.OutsideWindowDefer,
+	})
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	require.NotNil(t, job.DeferredUntil)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, runner.Shutdown(shutdownCtx))
+
+	// 재시작 후 ResumeDeferred로 다시 예약되도록 대기 상태 유지
+	pending, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.True(t, pending.IsDeferred())
+}
+
+// TestJobRunner_MaintenancePause는 시간대가 닫히면 진행 중인 테이블은 끝까지 추출하고
+// 다음 테이블의 커서를 열기 전에 멈췄다가 다시 열리면 이어서 추출하는지 테스트합니다
+func TestJobRunner_MaintenancePause(t *testing.T) {
+	var mu sync.Mutex
+	started := make(map[string]time.Time)
+	finished := make(map[string]time.Time)
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mu.Lock()
+		started[tableName] = time.Now()
+		mu.Unlock()
+		for _, chunk := range mockRowChunks(3, 10) {
+			time.Sleep(40 * time.Millisecond)
+			if err := handler(chunk); err != nil {
+				return err
+			}
+		}
+		mu.Lock()
+		finished[tableName] = time.Now()
+		mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	closes := now.Add(60 * time.Millisecond)
+	reopens := now.Add(250 * time.Millisecond)
+	broadcaster := sse.NewBroadcaster()
+	sseCtx, sseCancel := context.WithCancel(context.Background())
+	defer sseCancel()
+	go broadcaster.Run(sseCtx)
+
+	runner, transportSvc, jobSvc := setupMaintenanceRunner(t, mockRepo, broadcaster,
+		maintenance.Window{Name: "night", Ranges: []maintenance.Range{
+			{Start: now.Add(-time.Minute), End: closes},
+			{Start: reopens, End: now.Add(time.Hour)},
+		}},
+	)
+	ctx := context.Background()
+	transport, err := transportSvc.Create(ctx, domain.CreateTransportRequest{
+		Name:        "Test",
+		Tables:      []string{"VBRK", "VBRP"},
+		Maintenance: &domain.MaintenancePolicy{Windows: []string{"night"}, OnClose: domain.WindowClosePause},
+	})
+	require.NoError(t, err)
+	client := broadcaster.Register(transport.ID)
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Nil(t, job.DeferredUntil)
+
+	waitStatusEvent(t, client, sse.StatusPaused)
+	waitStatusEvent(t, client, sse.StatusRunning)
+	runner.Wait()
+
+	result, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusCompleted, result.Status)
+	assert.Equal(t, int64(60), result.Metrics.TotalRows)
+
+	// 시간대가 닫힌 뒤에도 첫 테이블은 멈추지 않고 끝까지 추출 (커서를 열어 둔 채 대기하지 않음)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, started, 2)
+	first, second := "VBRK", "VBRP"
+	if started[second].Before(started[first]) {
+		first, second = second, first
+	}
+	assert.True(t, finished[first].After(closes))
+	assert.True(t, finished[first].Before(reopens), "진행 중인 테이블이 청크 사이에서 멈췄습니다")
+	// 다음 테이블은 시간대가 다시 열린 뒤 시작
+	assert.False(t, started[second].Before(reopens), "시간대가 열리기 전에 다음 테이블을 시작했습니다: %s", started[second])
+}
+
+// TestJobRunner_MaintenanceAbort는 시간대가 닫히면 Job을 중단하고 재시도할 수 있는 실패로 기록하는지 테스트합니다
+func TestJobRunner_MaintenanceAbort(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	now := time.Now()
+	runner, transportSvc, jobSvc := setupMaintenanceRunner(t, mockRepo, nil,
+		maintenance.Window{Name: "night", Ranges: []maintenance.Range{
+			{Start: now.Add(-time.Minute), End: now.Add(100 * time.Millisecond)},
+			{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)},
+		}},
+	)
+	ctx := context.Background()
+	transport := createMaintenanceTransport(t, transportSvc, &domain.MaintenancePolicy{
+		Windows: []string{"night"},
+		OnClose: domain.WindowCloseAbort,
+	})
+
+	job, err := runner.Trigger(ctx, transport.ID)
+	require.NoError(t, err)
+	runner.Wait()
+
+	finished, err := jobSvc.GetByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusFailed, finished.Status)
+	require.NotNil(t, finished.Error)
+	assert.Contains(t, *finished.Error, ErrMaintenanceWindowClosed.Error())
+
+	updated, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransportStatusFailed, updated.Status)
+
+	// 시간대가 닫혀 있으므로 재시도도 거부
+	_, err = runner.Retry(ctx, job.ID)
+	assert.ErrorIs(t, err, ErrOutsideMaintenanceWindow)
+}
+
+// TestTransportService_ExecutionWindow는 조회 시 유지보수 시간대 기준 실행 가능 상태 계산을 테스트합니다
+func TestTransportService_ExecutionWindow(t *testing.T) {
+	calendar, err := maintenance.New([]maintenance.Window{
+		{Name: "night", Cron: "0 22 * * *", Duration: 8 * time.Hour, Timezone: "Asia/Seoul"},
+	})
+	require.NoError(t, err)
+	svc := NewTransportService(memory.NewTransportRepository())
+	svc.SetMaintenance(MaintenanceConfig{Calendar: calendar})
+	svc.now = func() time.Time { return time.Date(2026, 10, 15, 1, 0, 0, 0, time.UTC) } // KST 10:00
+	ctx := context.Background()
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	transport, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:        "Test",
+		Tables:      []string{"VBRP"},
+		Schedule:    &domain.CronSchedule{Expression: "30 * * * *", Timezone: "Asia/Seoul"},
+		Maintenance: &domain.MaintenancePolicy{Windows: []string{"night"}},
+	})
+	require.NoError(t, err)
+
+	ew := transport.ExecutionWindow
+	require.NotNil(t, ew)
+	assert.False(t, ew.Allowed)
+	assert.Equal(t, []string{"night"}, ew.Windows)
+	assert.Equal(t, "reject", ew.OutsideWindow)
+	assert.Equal(t, "continue", ew.OnClose)
+	require.NotNil(t, ew.NextAllowedAt)
+	assert.True(t, ew.NextAllowedAt.Equal(time.Date(2026, 10, 15, 22, 0, 0, 0, seoul)))
+	assert.Nil(t, ew.ClosesAt)
+	// reject: 시간대 안의 첫 예정 시각
+	require.NotNil(t, ew.NextScheduledRunAt)
+	assert.True(t, ew.NextScheduledRunAt.Equal(time.Date(2026, 10, 15, 22, 30, 0, 0, seoul)))
+
+	// defer: 다음 예정 시각 이후 시간대가 처음 열리는 시각
+	transport, err = svc.Patch(ctx, transport.ID, []byte(`{"maintenance":{"windows":["night"],"outside_window":"defer"}}`), 0)
+	require.NoError(t, err)
+	require.NotNil(t, transport.ExecutionWindow.NextScheduledRunAt)
+	assert.True(t, transport.ExecutionWindow.NextScheduledRunAt.Equal(time.Date(2026, 10, 15, 22, 0, 0, 0, seoul)))
+
+	// 시간대를 지정하지 않은 Transport는 제한 없음
+	free, err := svc.Create(ctx, domain.CreateTransportRequest{Name: "Free", Tables: []string{"VBRP"}})
+	require.NoError(t, err)
+	assert.Nil(t, free.ExecutionWindow)
+}
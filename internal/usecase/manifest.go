@@ -0,0 +1,191 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/domain"
+)
+
+// clearManifest는 재시도 전에 이전 시도의 manifest와 완료 marker를 삭제합니다
+// 다운스트림 로더가 다시 기록 중인 버전을 완료된 것으로 보지 않도록 추출보다 먼저 실행합니다
+func (e *ParallelExecutor) clearManifest(ctx context.Context, plan ExecutionPlan) error {
+	if plan.Attempt <= 1 || e.gcs == nil {
+		return nil
+	}
+
+	for _, name := range []string{domain.SuccessMarkerName, domain.FailedMarkerName, domain.ManifestObjectName} {
+		if err := e.gcs.DeleteObject(ctx, e.gcs.VersionObjectPath(plan.TransportID, plan.JobVersion, name)); err != nil {
+			return fmt.Errorf("이전 시도 manifest 삭제 실패: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeManifest는 모든 테이블이 끝난 뒤 manifest.json과 완료 marker를 기록합니다
+// execErr가 없으면 manifest 다음에 _SUCCESS를, 있으면 에러 요약을 담은 _FAILED를 기록합니다
+// manifest 기록에 실패하면 _FAILED를 남기고 에러를 반환합니다
+func (e *ParallelExecutor) writeManifest(ctx context.Context, plan ExecutionPlan, result *ExecutionResult, execErr error) error {
+	if e.gcs == nil {
+		return nil
+	}
+
+	err := e.putManifest(ctx, plan, result, execErr)
+	if err != nil {
+		err = fmt.Errorf("manifest 기록 실패: %w", err)
+		if execErr == nil {
+			execErr = err
+		}
+	}
+
+	if execErr == nil {
+		successPath := e.gcs.VersionObjectPath(plan.TransportID, plan.JobVersion, domain.SuccessMarkerName)
+		if err := gcs.PutObject(ctx, e.gcs, successPath, nil); err != nil {
+			return fmt.Errorf("%s marker 기록 실패: %w", domain.SuccessMarkerName, err)
+		}
+		return nil
+	}
+
+	failedPath := e.gcs.VersionObjectPath(plan.TransportID, plan.JobVersion, domain.FailedMarkerName)
+	if markerErr := gcs.PutObject(ctx, e.gcs, failedPath, []byte(failureSummary(result, execErr))); markerErr != nil {
+		return errors.Join(err, fmt.Errorf("%s marker 기록 실패: %w", domain.FailedMarkerName, markerErr))
+	}
+	return err
+}
+
+// putManifest는 manifest.json을 생성하여 업로드합니다
+func (e *ParallelExecutor) putManifest(ctx context.Context, plan ExecutionPlan, result *ExecutionResult, execErr error) error {
+	manifest, err := e.buildManifest(ctx, plan, result, execErr)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("manifest 직렬화 실패: %w", err)
+	}
+	return gcs.PutObject(ctx, e.gcs, e.gcs.VersionObjectPath(plan.TransportID, plan.JobVersion, domain.ManifestObjectName), data)
+}
+
+// buildManifest는 실행 결과와 이전 시도에서 완료된 테이블로 manifest를 구성합니다
+func (e *ParallelExecutor) buildManifest(ctx context.Context, plan ExecutionPlan, result *ExecutionResult, execErr error) (*domain.Manifest, error) {
+	format := plan.OutputFormat
+	if format == "" {
+		format = domain.OutputFormatJSONL
+	}
+
+	manifest := &domain.Manifest{
+		TransportID: plan.TransportID,
+		JobID:       plan.JobID,
+		JobVersion:  plan.JobVersion,
+		Attempt:     max(plan.Attempt, 1),
+		Owner:       plan.Owner,
+		Format:      format,
+		SnapshotSCN: plan.SnapshotSCN,
+		Success:     execErr == nil,
+		StartedAt:   result.StartTime.UTC(),
+		CompletedAt: result.EndTime.UTC(),
+		Tables:      make([]domain.ManifestTable, 0, len(result.TableResults)+len(plan.Completed)),
+	}
+	if execErr != nil {
+		manifest.Error = execErr.Error()
+	}
+
+	reported := make(map[string]bool, len(result.TableResults))
+	for _, tr := range result.TableResults {
+		reported[tr.TableName] = true
+
+		table := domain.ManifestTable{
+			TableName:         tr.TableName,
+			Status:            domain.ExtractionStatusCompleted,
+			RowCount:          tr.RowCount,
+			ByteCount:         tr.ByteCount,
+			UncompressedBytes: tr.UncompressedBytes,
+			Objects:           tr.Objects,
+			LOBObjects:        tr.LOBObjects,
+			Columns:           tr.Columns,
+			Watermark:         tr.Watermark,
+			Reconciliation:    tr.Reconciliation,
+		}
+		if !tr.Success() {
+			table.Status = domain.ExtractionStatusFailed
+			if errors.Is(tr.Error, context.Canceled) {
+				table.Status = domain.ExtractionStatusCancelled
+			}
+			table.Error = tr.Error.Error()
+			table.Objects = nil // 실패한 테이블의 객체는 확정되지 않았거나 불완전함
+			table.LOBObjects = nil
+		}
+		manifest.Tables = append(manifest.Tables, table)
+	}
+
+	// 이전 시도에서 완료된 테이블 (컬럼 스키마는 다시 조회)
+	for _, ext := range plan.Completed {
+		if reported[ext.TableName] {
+			continue
+		}
+		columns, err := e.columns(ctx, plan, ext.TableName)
+		if err != nil {
+			return nil, fmt.Errorf("테이블 %s 컬럼 정보 조회 실패: %w", ext.TableName, err)
+		}
+
+		table := domain.ManifestTable{
+			TableName:      ext.TableName,
+			Status:         domain.ExtractionStatusCompleted,
+			RowCount:       ext.RowCount,
+			ByteCount:      ext.ByteCount,
+			Objects:        ext.Objects,
+			LOBObjects:     ext.LOBObjects,
+			Columns:        columns,
+			Watermark:      ext.Watermark,
+			Reconciliation: ext.Reconciliation,
+		}
+		for _, obj := range ext.Objects {
+			table.UncompressedBytes += obj.UncompressedBytes
+		}
+		for _, obj := range ext.LOBObjects {
+			table.UncompressedBytes += obj.UncompressedBytes
+		}
+		manifest.Tables = append(manifest.Tables, table)
+	}
+
+	for i := range manifest.Tables {
+		if manifest.Tables[i].Objects == nil {
+			manifest.Tables[i].Objects = []domain.ObjectInfo{}
+		}
+		manifest.TotalRows += manifest.Tables[i].RowCount
+		manifest.TotalBytes += manifest.Tables[i].ByteCount
+	}
+	sort.Slice(manifest.Tables, func(i, j int) bool {
+		return manifest.Tables[i].TableName < manifest.Tables[j].TableName
+	})
+	return manifest, nil
+}
+
+// failureSummary는 _FAILED marker에 기록할 에러 요약을 만듭니다
+// 첫 줄은 전체 에러이고, 이후 실패한 테이블별 에러가 테이블 이름순으로 이어집니다
+func failureSummary(result *ExecutionResult, execErr error) string {
+	var sb strings.Builder
+	sb.WriteString(execErr.Error())
+	sb.WriteString("\n")
+
+	failed := make([]TableResult, 0, result.FailedTables)
+	for _, tr := range result.TableResults {
+		if !tr.Success() {
+			failed = append(failed, tr)
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].TableName < failed[j].TableName
+	})
+	for _, tr := range failed {
+		fmt.Fprintf(&sb, "%s: %v\n", tr.TableName, tr.Error)
+	}
+	return sb.String()
+}
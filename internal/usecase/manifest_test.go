@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/adapter/oracle"
+	"oracle-etl/internal/domain"
+)
+
+// readManifest는 Mock GCS에 기록된 manifest.json을 읽습니다
+func readManifest(t *testing.T, client *gcs.MockClient, objectPath string) domain.Manifest {
+	t.Helper()
+	data, ok := client.Object(objectPath)
+	require.True(t, ok, "manifest가 기록되지 않았습니다")
+
+	var manifest domain.Manifest
+	require.NoError(t, json.Unmarshal(data, &manifest))
+	return manifest
+}
+
+// TestParallelExecutor_ManifestSuccess는 성공 시 manifest와 _SUCCESS marker 기록을 테스트합니다
+func TestParallelExecutor_ManifestSuccess(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "VBELN", DataType: "VARCHAR2", Position: 1},
+		{Name: "NETWR", DataType: "NUMBER", Nullable: true, Position: 2},
+	}
+	mockRepo.MockRanges = map[string][]domain.TableRange{
+		"VBRP": {{Index: 0, Partition: "P2023"}, {Index: 1, Partition: "P2024"}},
+	}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP", "VBRK"},
+		Owner:       "SAPSR3",
+		SnapshotSCN: 4815162342,
+		Split:       domain.SplitOptions{MinRows: 1, RowsPerRange: 1, MaxRanges: 4},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success())
+
+	assert.Equal(t, []string{
+		"TRP-001/v001/VBRK.jsonl.gz",
+		"TRP-001/v001/VBRP/part-00000.jsonl.gz",
+		"TRP-001/v001/VBRP/part-00001.jsonl.gz",
+		"TRP-001/v001/_SUCCESS",
+		"TRP-001/v001/manifest.json",
+	}, gcsClient.ObjectPaths())
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	assert.Equal(t, "JOB-001", manifest.JobID)
+	assert.Equal(t, "v001", manifest.JobVersion)
+	assert.Equal(t, 1, manifest.Attempt)
+	assert.Equal(t, domain.OutputFormatJSONL, manifest.Format)
+	assert.Equal(t, uint64(4815162342), manifest.SnapshotSCN)
+	assert.True(t, manifest.Success)
+	assert.Empty(t, manifest.Error)
+	assert.Equal(t, int64(30), manifest.TotalRows)
+	assert.Equal(t, result.TotalBytes, manifest.TotalBytes)
+
+	require.Len(t, manifest.Tables, 2)
+	vbrk := manifest.Tables[0]
+	assert.Equal(t, "VBRK", vbrk.TableName)
+	assert.Equal(t, domain.ExtractionStatusCompleted, vbrk.Status)
+	assert.Equal(t, mockRepo.MockColumns, vbrk.Columns)
+	require.Len(t, vbrk.Objects, 1)
+	assert.Equal(t, "gs://test-bucket/TRP-001/v001/VBRK.jsonl.gz", vbrk.Objects[0].Path)
+	assert.Positive(t, vbrk.UncompressedBytes)
+
+	// part 객체는 범위 순서로 나열되고 CRC32C는 업로드된 내용과 일치
+	vbrp := manifest.Tables[1]
+	require.Len(t, vbrp.Objects, 2)
+	for i, path := range []string{"TRP-001/v001/VBRP/part-00000.jsonl.gz", "TRP-001/v001/VBRP/part-00001.jsonl.gz"} {
+		data, ok := gcsClient.Object(path)
+		require.True(t, ok)
+		obj := vbrp.Objects[i]
+		assert.Equal(t, "gs://test-bucket/"+path, obj.Path)
+		assert.Equal(t, int64(10), obj.RowCount)
+		assert.Equal(t, int64(len(data)), obj.ByteCount)
+		assert.Equal(t, gcs.EncodeCRC32C(crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))), obj.CRC32C)
+	}
+	assert.Equal(t, int64(20), vbrp.RowCount)
+}
+
+// TestParallelExecutor_ManifestFailure는 실패 시 manifest와 에러 요약이 담긴 _FAILED marker 기록을 테스트합니다
+func TestParallelExecutor_ManifestFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.TableErrors = map[string]error{
+		"FAIL_TABLE": errors.New("ORA-00942: table or view does not exist"),
+	}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	_, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP", "FAIL_TABLE"},
+		Owner:       "SAPSR3",
+	})
+	require.Error(t, err)
+
+	_, ok := gcsClient.Object("TRP-001/v001/_SUCCESS")
+	assert.False(t, ok)
+	marker, ok := gcsClient.Object("TRP-001/v001/_FAILED")
+	require.True(t, ok)
+	assert.Equal(t, "1개 테이블 추출 실패\nFAIL_TABLE: ORA-00942: table or view does not exist\n", string(marker))
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	assert.False(t, manifest.Success)
+	assert.Equal(t, "1개 테이블 추출 실패", manifest.Error)
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, domain.ExtractionStatusFailed, manifest.Tables[0].Status)
+	assert.Contains(t, manifest.Tables[0].Error, "ORA-00942")
+	assert.Empty(t, manifest.Tables[0].Objects)
+	assert.Equal(t, domain.ExtractionStatusCompleted, manifest.Tables[1].Status)
+	assert.Len(t, manifest.Tables[1].Objects, 1)
+}
+
+// TestParallelExecutor_ManifestRetry는 재시도 시 이전 marker를 지우고
+// 이전 시도에서 완료된 테이블을 manifest에 포함하는지 테스트합니다
+func TestParallelExecutor_ManifestRetry(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+	ctx := context.Background()
+
+	// 이전 시도의 결과
+	for _, path := range []string{"TRP-001/v001/VBRK.jsonl.gz", "TRP-001/v001/_FAILED", "TRP-001/v001/manifest.json"} {
+		require.NoError(t, gcs.PutObject(ctx, gcsClient, path, []byte("previous")))
+	}
+	completed := domain.Extraction{
+		TableName: "VBRK",
+		Status:    domain.ExtractionStatusCompleted,
+		RowCount:  7,
+		ByteCount: 8,
+		Objects: []domain.ObjectInfo{
+			{Path: "gs://test-bucket/TRP-001/v001/VBRK.jsonl.gz", RowCount: 7, ByteCount: 8, UncompressedBytes: 20, CRC32C: "AAAAAA=="},
+		},
+	}
+
+	_, err := executor.Execute(ctx, ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Attempt:     2,
+		Completed:   []domain.Extraction{completed},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"TRP-001/v001/VBRK.jsonl.gz",
+		"TRP-001/v001/VBRP.jsonl.gz",
+		"TRP-001/v001/_SUCCESS",
+		"TRP-001/v001/manifest.json",
+	}, gcsClient.ObjectPaths())
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	assert.Equal(t, 2, manifest.Attempt)
+	assert.True(t, manifest.Success)
+	assert.Equal(t, int64(17), manifest.TotalRows)
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, "VBRK", manifest.Tables[0].TableName)
+	assert.Equal(t, completed.Objects, manifest.Tables[0].Objects)
+	assert.Equal(t, int64(20), manifest.Tables[0].UncompressedBytes)
+	assert.Equal(t, "VBRP", manifest.Tables[1].TableName)
+}
+
+// TestParallelExecutor_ManifestWriteFailure는 manifest 기록 실패 시 실행을 실패로 처리하는지 테스트합니다
+func TestParallelExecutor_ManifestWriteFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	// 재시도의 이전 완료 테이블 컬럼 조회가 실패하면 manifest를 만들 수 없음
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mockRepo.ShouldError = true
+		mockRepo.ErrorMessage = "ORA-12541: TNS:no listener"
+		return handler(mockRepo.MockChunks[0])
+	}
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Attempt:     2,
+		Completed:   []domain.Extraction{{TableName: "VBRK", Status: domain.ExtractionStatusCompleted}},
+	})
+	require.Error(t, err)
+	assert.True(t, result.Success())
+	assert.Contains(t, err.Error(), "manifest 기록 실패")
+
+	_, ok := gcsClient.Object("TRP-001/v001/_SUCCESS")
+	assert.False(t, ok)
+	marker, ok := gcsClient.Object("TRP-001/v001/_FAILED")
+	require.True(t, ok)
+	assert.Contains(t, string(marker), "ORA-12541")
+}
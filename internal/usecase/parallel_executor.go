@@ -9,23 +9,41 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"oracle-etl/internal/adapter/gcs"
 	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/adapter/sse"
 	"oracle-etl/internal/domain"
+	apperrors "oracle-etl/internal/errors"
+	"oracle-etl/internal/governor"
+	"oracle-etl/internal/resilience"
+	"oracle-etl/internal/telemetry"
 	"oracle-etl/pkg/buffer"
 	"oracle-etl/pkg/pool"
+	"oracle-etl/pkg/rowset"
 )
 
 // ExecutionPlan은 병렬 추출 실행 계획을 정의합니다
 type ExecutionPlan struct {
-	TransportID  string         // Transport ID
-	JobID        string         // Job ID
-	JobVersion   string         // Job 버전 (v001, v002, ...)
-	Tables       []string       // 추출할 테이블 목록
-	Concurrency  int            // 동시 실행 수 (0이면 기본값)
-	Owner        string         // 스키마 소유자
-	BufferConfig *buffer.Config // 버퍼 설정 (nil이면 기본값)
+	TransportID  string                           // Transport ID
+	JobID        string                           // Job ID
+	JobVersion   string                           // Job 버전 (v001, v002, ...)
+	Tables       []string                         // 추출할 테이블(또는 원본 이름) 목록
+	Sources      map[string]domain.Source         // 이름별 추출 원본 정의 (없는 이름은 같은 이름의 테이블 전체)
+	Columns      map[string]domain.ColumnFilter   // 테이블별 추출 컬럼 필터 (없으면 전체 컬럼)
+	LOBs         map[string]domain.LOBPolicies    // 테이블별 LOB 컬럼 출력 방식 (없으면 CLOB은 text, BLOB은 base64)
+	Concurrency  int                              // 동시 실행 수 (0이면 기본값)
+	Owner        string                           // 스키마 소유자
+	BufferConfig *buffer.Config                   // 버퍼 설정 (nil이면 기본값)
+	Watermarks   map[string]domain.WatermarkRange // 테이블별 증분 추출 범위 (없으면 전체 추출)
+	SnapshotSCN  uint64                           // 모든 테이블에 적용할 조회 기준 SCN (0이면 테이블별 현재 시점)
+	Split        domain.SplitOptions              // 대용량 테이블 분할 추출 설정 (MinRows가 0이면 분할하지 않음)
+	OutputFormat domain.OutputFormat              // GCS 객체 형식 (빈 값이면 JSONL)
+	Attempt      int                              // Job 실행 시도 번호 (1보다 크면 이전 시도의 객체를 정리한 뒤 추출)
+	Completed    []domain.Extraction              // 이전 시도에서 완료되어 이번 실행에서 제외된 테이블 (manifest에 포함)
+	Reconcile    domain.ReconcilePolicy           // row 수 대사 불일치 처리 정책 (빈 값이면 warn)
+	Gate         func(ctx context.Context) error  // 테이블 추출 시작 전(커서를 열기 전) 호출되어 실행을 멈출 수 있음 (nil이면 호출하지 않음)
 }
 
 // Validate는 ExecutionPlan의 유효성을 검사합니다
@@ -39,6 +57,23 @@ func (p *ExecutionPlan) Validate() error {
 	return nil
 }
 
+// wait는 Gate가 있으면 호출하여 실행을 계속해도 될 때까지 대기합니다
+func (p *ExecutionPlan) wait(ctx context.Context) error {
+	if p.Gate == nil {
+		return nil
+	}
+	return p.Gate(ctx)
+}
+
+// source는 이름에 해당하는 추출 원본 정의를 반환합니다 (정의가 없으면 nil, 테이블 전체 추출)
+func (p *ExecutionPlan) source(name string) *domain.Source {
+	src, ok := p.Sources[name]
+	if !ok {
+		return nil
+	}
+	return &src
+}
+
 // EffectiveConcurrency는 실제 사용할 동시 실행 수를 반환합니다
 func (p *ExecutionPlan) EffectiveConcurrency() int {
 	if p.Concurrency <= 0 {
@@ -60,14 +95,21 @@ func (p *ExecutionPlan) EffectiveBufferConfig() buffer.Config {
 
 // TableResult는 단일 테이블 추출 결과입니다
 type TableResult struct {
-	TableName   string        // 테이블 이름
-	RowCount    int64         // 처리된 row 수
-	ByteCount   int64         // 전송된 바이트 수
-	StartTime   time.Time     // 시작 시간
-	EndTime     time.Time     // 종료 시간
-	Duration    time.Duration // 소요 시간
-	GCSPath     string        // GCS 경로
-	Error       error         // 에러 (있는 경우)
+	TableName         string                 // 테이블 이름
+	RowCount          int64                  // 처리된 row 수
+	ByteCount         int64                  // 전송된 바이트 수 (압축 후)
+	UncompressedBytes int64                  // 압축 전 바이트 수 (JSONL 또는 Parquet data page)
+	StartTime         time.Time              // 시작 시간
+	EndTime           time.Time              // 종료 시간
+	Duration          time.Duration          // 소요 시간
+	GCSPath           string                 // GCS 경로 (분할 추출 시 part 객체 prefix)
+	Parts             int                    // 분할 추출된 범위 수 (분할하지 않으면 0)
+	Watermark         *domain.WatermarkRange // 증분 추출 범위 (watermark 미설정 테이블은 nil)
+	Objects           []domain.ObjectInfo    // 업로드된 객체 (분할 추출 시 part 순서)
+	LOBObjects        []domain.ObjectInfo    // object 정책으로 업로드된 LOB 객체 (ByteCount에 포함)
+	Columns           []domain.ColumnInfo    // 컬럼 스키마 (GCS 미설정 시 nil)
+	Reconciliation    *domain.Reconciliation // 원본/대상 row 수 대사 결과 (대사하지 않으면 nil)
+	Error             error                  // 에러 (있는 경우)
 }
 
 // Success는 테이블 추출이 성공했는지 반환합니다
@@ -83,6 +125,141 @@ func (r TableResult) RowsPerSecond() float64 {
 	return float64(r.RowCount) / r.Duration.Seconds()
 }
 
+// errUploadAborted는 업로드가 먼저 종료되어 추출을 중단할 때 사용됩니다
+var errUploadAborted = errors.New("GCS 업로드가 중단되었습니다")
+
+// UploadError는 추출은 진행되었으나 GCS 업로드가 실패했음을 나타냅니다
+type UploadError struct {
+	ObjectPath string // 업로드 대상 객체 경로
+	Err        error  // 원인 에러
+}
+
+// Error는 에러 메시지를 반환합니다
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("GCS 업로드 실패 (%s): %v", e.ObjectPath, e.Err)
+}
+
+// Unwrap은 원인 에러를 반환합니다
+func (e *UploadError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileError는 업로드는 완료되었으나 원본/대상 row 수 대사가 실패했음을 나타냅니다
+// 대사 정책이 fail인 경우에만 테이블 에러로 사용됩니다
+type ReconcileError struct {
+	Reconciliation *domain.Reconciliation // 대사 결과
+}
+
+// Error는 에러 메시지를 반환합니다
+func (e *ReconcileError) Error() string {
+	return fmt.Sprintf("row 수 대사 실패: %s", e.Reconciliation)
+}
+
+// splitTracker는 범위별로 나누어 추출 중인 테이블의 진행률과 결과를 집계합니다
+type splitTracker struct {
+	mu      sync.Mutex
+	rows    []int64       // 범위별 누적 row 수
+	bytes   []int64       // 범위별 업로드 바이트 수
+	results []TableResult // 범위별 결과 (범위 순번 인덱스)
+	pending int           // 완료되지 않은 범위 수
+}
+
+// newSplitTracker는 parts개 범위의 집계기를 생성합니다
+func newSplitTracker(parts int) *splitTracker {
+	return &splitTracker{
+		rows:    make([]int64, parts),
+		bytes:   make([]int64, parts),
+		results: make([]TableResult, parts),
+		pending: parts,
+	}
+}
+
+// progress는 범위의 진행률을 기록하고 테이블 전체 누적값을 반환합니다
+func (t *splitTracker) progress(part int, rows, bytes int64) (totalRows, totalBytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rows[part] = rows
+	t.bytes[part] = bytes
+	for i := range t.rows {
+		totalRows += t.rows[i]
+		totalBytes += t.bytes[i]
+	}
+	return totalRows, totalBytes
+}
+
+// complete는 범위 결과를 기록하고, 마지막 범위이면 병합된 테이블 결과를 반환합니다
+func (t *splitTracker) complete(part int, result TableResult) (TableResult, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.results[part] = result
+	t.pending--
+	if t.pending > 0 {
+		return TableResult{}, false
+	}
+	return mergeTableResults(t.results), true
+}
+
+// mergeTableResults는 범위별 결과를 하나의 테이블 결과로 합칩니다
+// 실패한 범위가 있으면 테이블 전체를 실패로 처리하고 첫 번째 실패 원인을 보존합니다
+func mergeTableResults(parts []TableResult) TableResult {
+	merged := TableResult{
+		TableName: parts[0].TableName,
+		StartTime: parts[0].StartTime,
+		EndTime:   parts[0].EndTime,
+		Parts:     len(parts),
+	}
+
+	var (
+		failed   int
+		firstErr error
+	)
+	for i, part := range parts {
+		merged.RowCount += part.RowCount
+		merged.ByteCount += part.ByteCount
+		merged.UncompressedBytes += part.UncompressedBytes
+		merged.Objects = append(merged.Objects, part.Objects...)
+		merged.LOBObjects = append(merged.LOBObjects, part.LOBObjects...)
+		if part.Reconciliation != nil {
+			if merged.Reconciliation == nil {
+				rec := *part.Reconciliation
+				merged.Reconciliation = &rec
+			} else {
+				merged.Reconciliation.Merge(part.Reconciliation)
+			}
+		}
+		if part.StartTime.Before(merged.StartTime) {
+			merged.StartTime = part.StartTime
+		}
+		if part.EndTime.After(merged.EndTime) {
+			merged.EndTime = part.EndTime
+		}
+
+		if part.Watermark != nil {
+			if merged.Watermark == nil {
+				merged.Watermark = domain.NewWatermarkRange(part.Watermark.Column, part.Watermark.From)
+			}
+			if part.Watermark.To != nil {
+				merged.Watermark.Observe(*part.Watermark.To)
+			}
+		}
+
+		if part.Error != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("part %d: %w", i, part.Error)
+			}
+		}
+	}
+	merged.Duration = merged.EndTime.Sub(merged.StartTime)
+
+	if firstErr != nil {
+		merged.Error = fmt.Errorf("%d/%d개 범위 추출 실패, %w", failed, len(parts), firstErr)
+	}
+	return merged
+}
+
 // ExecutionResult는 전체 실행 결과입니다
 type ExecutionResult struct {
 	TransportID      string        // Transport ID
@@ -118,10 +295,17 @@ func (r *ExecutionResult) Success() bool {
 
 // ParallelExecutor는 다중 테이블 병렬 추출을 관리합니다
 type ParallelExecutor struct {
-	oracle      oracle.Repository
-	gcs         gcs.Client
-	sse         *sse.Broadcaster
-	maxWorkers  int
+	oracle     oracle.Repository
+	gcs        gcs.Client
+	uploader   *gcs.PipelineUploader // gcs가 nil이면 nil (row 수만 집계)
+	sse        *sse.Broadcaster
+	metrics    *telemetry.Metrics // nil이면 메트릭을 기록하지 않음
+	maxWorkers int
+	resilience ResilienceConfig   // 테이블 재시도 및 의존성별 Circuit Breaker (기본값은 재시도/차단 없음)
+	governor   *governor.Governor // 모든 Job의 Oracle 추출 부하 조절 (nil이면 조절하지 않음)
+
+	poolsMu sync.Mutex
+	pools   map[*pool.WorkerPool]ExecutionPlan // 실행 중인 Execute의 워커 풀과 계획 (큐 길이 메트릭, Circuit 이벤트용)
 }
 
 // NewParallelExecutor는 새로운 ParallelExecutor를 생성합니다
@@ -129,12 +313,33 @@ func NewParallelExecutor(oracleRepo oracle.Repository, gcsClient gcs.Client, sse
 	if maxWorkers <= 0 {
 		maxWorkers = buffer.DefaultParallelism
 	}
-	return &ParallelExecutor{
+	executor := &ParallelExecutor{
 		oracle:     oracleRepo,
 		gcs:        gcsClient,
 		sse:        sseBroadcaster,
 		maxWorkers: maxWorkers,
+		pools:      make(map[*pool.WorkerPool]ExecutionPlan),
 	}
+	if gcsClient != nil {
+		executor.uploader = gcs.NewPipelineUploader(gcsClient)
+	}
+	return executor
+}
+
+// SetMetrics는 청크/업로드 메트릭을 기록할 Metrics를 설정합니다
+func (e *ParallelExecutor) SetMetrics(m *telemetry.Metrics) {
+	e.metrics = m
+}
+
+// QueueStats는 실행 중인 모든 워커 풀의 대기 작업 수와 완료되지 않은 작업 수를 합산합니다
+func (e *ParallelExecutor) QueueStats() (queued, pending int) {
+	e.poolsMu.Lock()
+	defer e.poolsMu.Unlock()
+	for p := range e.pools {
+		queued += p.QueuedTasks()
+		pending += p.PendingTasks()
+	}
+	return queued, pending
 }
 
 // MaxWorkers는 최대 워커 수를 반환합니다
@@ -142,8 +347,20 @@ func (e *ParallelExecutor) MaxWorkers() int {
 	return e.maxWorkers
 }
 
+// CurrentSCN은 일관된 스냅샷 추출을 위해 데이터베이스의 현재 SCN을 조회합니다
+func (e *ParallelExecutor) CurrentSCN(ctx context.Context) (uint64, error) {
+	return e.oracle.GetCurrentSCN(ctx)
+}
+
 // Execute는 실행 계획에 따라 병렬 추출을 수행합니다
-func (e *ParallelExecutor) Execute(ctx context.Context, plan ExecutionPlan) (*ExecutionResult, error) {
+func (e *ParallelExecutor) Execute(ctx context.Context, plan ExecutionPlan) (_ *ExecutionResult, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "executor.execute",
+		telemetry.AttrTransportID.String(plan.TransportID),
+		telemetry.AttrJobID.String(plan.JobID),
+		attribute.Int("etl.tables", len(plan.Tables)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// 컨텍스트 취소 확인
 	select {
 	case <-ctx.Done():
@@ -156,6 +373,11 @@ func (e *ParallelExecutor) Execute(ctx context.Context, plan ExecutionPlan) (*Ex
 		return nil, fmt.Errorf("실행 계획 유효성 검사 실패: %w", err)
 	}
 
+	// 재시도 중에는 버전이 다시 기록되므로 이전 시도의 manifest와 marker를 제거
+	if err := e.clearManifest(ctx, plan); err != nil {
+		return nil, err
+	}
+
 	result := &ExecutionResult{
 		TransportID:  plan.TransportID,
 		JobID:        plan.JobID,
@@ -172,36 +394,93 @@ func (e *ParallelExecutor) Execute(ctx context.Context, plan ExecutionPlan) (*Ex
 
 	workerPool := pool.NewWorkerPool(concurrency)
 	workerPool.Start(ctx)
+	e.trackPool(workerPool, plan)
+	defer e.untrackPool(workerPool)
+	defer e.governor.FinishJob(plan.JobID)
 
 	// 버퍼 설정
 	bufferConfig := plan.EffectiveBufferConfig()
 
-	// 결과 수집용 채널
+	// 결과 수집용 채널 (테이블당 하나의 결과)
 	resultCh := make(chan TableResult, len(plan.Tables))
-	var wg sync.WaitGroup
 
-	// 작업 제출
+	// 작업 제출 (분할 대상 테이블은 범위별로 제출)
 	for _, tableName := range plan.Tables {
+		// 취소된 경우 남은 테이블은 제출하지 않음
+		if ctx.Err() != nil {
+			break
+		}
 		table := tableName // 클로저용 복사
-		wg.Add(1)
-
-		workerPool.Submit(pool.Task{
-			ID:        fmt.Sprintf("%s-%s", plan.JobID, table),
-			TableName: table,
-			Execute: func(taskCtx context.Context) error {
-				defer wg.Done()
-				
-				tableResult := e.extractTable(taskCtx, plan, table, bufferConfig)
-				resultCh <- tableResult
-				
-				// SSE 이벤트 발송
-				if e.sse != nil {
-					e.sendTableEvent(plan.TransportID, plan.JobID, tableResult)
-				}
-				
-				return tableResult.Error
-			},
+
+		// 추출 준비 (이전 시도 객체 정리, 분할 범위/컬럼 조회)는 일시적 장애 시 재시도
+		var (
+			ranges []domain.TableRange
+			format gcs.FormatOptions
+		)
+		err := e.withRetry(ctx, plan, table, func() (err error) {
+			if err = e.removeStaleObjects(ctx, plan, table); err != nil {
+				return err
+			}
+			if ranges, err = e.splitTable(ctx, plan, table); err != nil {
+				return err
+			}
+			format, err = e.tableFormat(ctx, plan, table, bufferConfig)
+			return err
 		})
+		if err != nil {
+			now := time.Now()
+			tableResult := TableResult{TableName: table, StartTime: now, EndTime: now, Error: err}
+			resultCh <- tableResult
+			e.sendTableEvent(plan.TransportID, plan.JobID, tableResult)
+			continue
+		}
+
+		if len(ranges) == 0 {
+			workerPool.Submit(pool.Task{
+				ID:        fmt.Sprintf("%s-%s", plan.JobID, table),
+				TableName: table,
+				Execute: func(taskCtx context.Context) error {
+					tableResult := e.extractTableWithRetry(taskCtx, plan, table, nil, format, func(rows, bytes int64) {
+						e.sendProgressEvent(plan.TransportID, plan.JobID, table, rows, bytes)
+					})
+					tableResult.Columns = format.Columns
+					resultCh <- tableResult
+
+					// SSE 이벤트 발송
+					e.sendTableEvent(plan.TransportID, plan.JobID, tableResult)
+
+					return tableResult.Error
+				},
+			})
+			continue
+		}
+
+		tracker := newSplitTracker(len(ranges))
+		for i := range ranges {
+			rng := ranges[i] // 클로저용 복사
+			workerPool.Submit(pool.Task{
+				ID:        fmt.Sprintf("%s-%s-%05d", plan.JobID, table, rng.Index),
+				TableName: table,
+				Execute: func(taskCtx context.Context) error {
+					partResult := e.extractTableWithRetry(taskCtx, plan, table, &rng, format, func(rows, bytes int64) {
+						totalRows, totalBytes := tracker.progress(rng.Index, rows, bytes)
+						e.sendProgressEvent(plan.TransportID, plan.JobID, table, totalRows, totalBytes)
+					})
+
+					// 마지막 범위가 끝나면 테이블 결과로 병합하여 보고
+					if tableResult, done := tracker.complete(rng.Index, partResult); done {
+						if tableResult.Success() && e.gcs != nil {
+							tableResult.GCSPath = e.gcs.PartPrefix(plan.TransportID, plan.JobVersion, table)
+						}
+						tableResult.Columns = format.Columns
+						resultCh <- tableResult
+						e.sendTableEvent(plan.TransportID, plan.JobID, tableResult)
+					}
+
+					return partResult.Error
+				},
+			})
+		}
 	}
 
 	// 워커 풀 결과 수집 (별도 goroutine에서)
@@ -223,39 +502,273 @@ func (e *ParallelExecutor) Execute(ctx context.Context, plan ExecutionPlan) (*Ex
 		}
 	}
 
+	// 취소로 실행되지 못한 테이블(또는 일부 범위만 끝난 분할 테이블)도 결과에 포함
+	if ctx.Err() != nil {
+		reported := make(map[string]bool, len(result.TableResults))
+		for _, tr := range result.TableResults {
+			reported[tr.TableName] = true
+		}
+		now := time.Now()
+		for _, table := range plan.Tables {
+			if reported[table] {
+				continue
+			}
+			result.TableResults = append(result.TableResults, TableResult{TableName: table, StartTime: now, EndTime: now, Error: ctx.Err()})
+			result.FailedTables++
+		}
+	}
+
 	result.TotalRows = totalRows
 	result.TotalBytes = totalBytes
 	result.EndTime = time.Now()
+	span.SetAttributes(
+		telemetry.AttrRows.Int64(totalRows),
+		telemetry.AttrBytes.Int64(totalBytes),
+		attribute.Int("etl.failed_tables", result.FailedTables),
+	)
+
+	var execErr error
+	switch {
+	case ctx.Err() != nil:
+		// 취소 시 context 에러를 감싸서 반환 (호출자가 errors.Is로 구분)
+		execErr = fmt.Errorf("실행 취소됨: %w", ctx.Err())
+	case result.FailedTables > 0:
+		// 부분 실패 시 에러 반환
+		execErr = fmt.Errorf("%d개 테이블 추출 실패", result.FailedTables)
+	}
+
+	// 모든 테이블이 끝난 뒤 manifest와 완료 marker 기록 (취소된 경우에도 _FAILED를 남김)
+	if err := e.writeManifest(context.WithoutCancel(ctx), plan, result, execErr); err != nil && execErr == nil {
+		execErr = err
+	}
 
 	// 완료 이벤트 발송
 	if e.sse != nil {
 		e.sendCompleteEvent(result)
 	}
 
-	// 부분 실패 시 에러 반환
-	if result.FailedTables > 0 {
-		return result, fmt.Errorf("%d개 테이블 추출 실패", result.FailedTables)
+	return result, execErr
+}
+
+// trackPool은 큐 길이 메트릭과 Circuit 이벤트 대상에 워커 풀과 실행 계획을 포함합니다
+func (e *ParallelExecutor) trackPool(p *pool.WorkerPool, plan ExecutionPlan) {
+	e.poolsMu.Lock()
+	defer e.poolsMu.Unlock()
+	e.pools[p] = plan
+}
+
+// untrackPool은 종료된 워커 풀을 큐 길이 메트릭에서 제외합니다
+func (e *ParallelExecutor) untrackPool(p *pool.WorkerPool) {
+	e.poolsMu.Lock()
+	defer e.poolsMu.Unlock()
+	delete(e.pools, p)
+}
+
+// removeStaleObjects는 재시도 시 이전 시도에서 남은 테이블 객체를 삭제합니다
+// 분할 범위 수가 달라지면 이전 part 객체가 새 결과와 섞이므로 추출 전에 정리합니다
+func (e *ParallelExecutor) removeStaleObjects(ctx context.Context, plan ExecutionPlan, tableName string) error {
+	if plan.Attempt <= 1 || e.gcs == nil {
+		return nil
+	}
+	err := e.guard(ctx, dependencyGCS, func() error {
+		_, err := e.gcs.DeleteTableObjects(ctx, plan.TransportID, plan.JobVersion, tableName)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("이전 시도 객체 삭제 실패: %w", err)
+	}
+	return nil
+}
+
+// splitTable은 분할 설정에 따라 테이블의 추출 범위를 조회합니다 (분할하지 않으면 nil)
+func (e *ParallelExecutor) splitTable(ctx context.Context, plan ExecutionPlan, tableName string) ([]domain.TableRange, error) {
+	if !plan.Split.Enabled() {
+		return nil, nil
 	}
 
-	return result, nil
+	// SQL 원본은 분할하지 않고, 컬럼/조건 지정 원본은 원본 테이블 기준으로 분할
+	if src := plan.source(tableName); src != nil {
+		if src.Kind() == domain.SourceKindQuery {
+			return nil, nil
+		}
+		tableName = src.Table
+	}
+
+	var ranges []domain.TableRange
+	err := e.guard(ctx, dependencyOracle, func() (err error) {
+		ranges, err = e.oracle.SplitTable(ctx, plan.Owner, tableName, plan.Split)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("테이블 분할 실패: %w", err)
+	}
+	if len(ranges) <= 1 {
+		return nil, nil
+	}
+	return ranges, nil
 }
 
-// extractTable은 단일 테이블을 추출합니다
-func (e *ParallelExecutor) extractTable(ctx context.Context, plan ExecutionPlan, tableName string, bufferConfig buffer.Config) TableResult {
+// tableFormat은 테이블의 출력 형식 설정을 만듭니다
+// GCS로 업로드하는 경우 manifest 스키마와 Parquet 스키마 생성을 위해, 컬럼 필터가 있는 경우 조회할 컬럼 목록을 정하기 위해
+// 추출 전에 컬럼 메타데이터를 조회합니다
+func (e *ParallelExecutor) tableFormat(ctx context.Context, plan ExecutionPlan, tableName string, bufferConfig buffer.Config) (gcs.FormatOptions, error) {
+	format := gcs.FormatOptions{Format: plan.OutputFormat, LOBs: plan.LOBs[tableName], Buffer: bufferConfig}
+	_, filtered := plan.Columns[tableName]
+	if e.uploader == nil && !filtered {
+		return format, nil
+	}
+
+	var columns []domain.ColumnInfo
+	err := e.guard(ctx, dependencyOracle, func() (err error) {
+		columns, err = e.columns(ctx, plan, tableName)
+		return err
+	})
+	if err != nil {
+		return format, fmt.Errorf("컬럼 정보 조회 실패: %w", err)
+	}
+	if len(columns) == 0 && (filtered || plan.OutputFormat == domain.OutputFormatParquet) {
+		return format, fmt.Errorf("테이블 %s.%s의 추출할 컬럼 정보를 찾을 수 없습니다", plan.Owner, tableName)
+	}
+	format.Columns = columns
+	return format, nil
+}
+
+// columns는 테이블 또는 추출 원본의 출력 컬럼 정보를 조회합니다 (컬럼 필터 적용)
+func (e *ParallelExecutor) columns(ctx context.Context, plan ExecutionPlan, tableName string) ([]domain.ColumnInfo, error) {
+	var (
+		columns []domain.ColumnInfo
+		err     error
+	)
+	if src := plan.source(tableName); src != nil {
+		columns, err = e.oracle.GetSourceColumns(ctx, plan.Owner, *src)
+	} else {
+		columns, err = e.oracle.GetTableColumns(ctx, plan.Owner, tableName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan.Columns[tableName].Apply(columns), nil
+}
+
+// extractTable은 단일 테이블 또는 분할된 테이블의 한 범위(rng)를 추출합니다
+// GCS 클라이언트가 설정된 경우 청크를 출력 형식(JSONL+gzip 또는 Parquet) 인코더를 거쳐 GCS로 스트리밍하며,
+// 분할 범위는 별도의 part 객체로 업로드합니다
+// onProgress는 청크마다 이 범위의 누적 row 수와 업로드 바이트 수로 호출됩니다
+func (e *ParallelExecutor) extractTable(ctx context.Context, plan ExecutionPlan, tableName string, rng *domain.TableRange, format gcs.FormatOptions, onProgress func(rows, bytes int64)) TableResult {
+	bufferConfig := format.Buffer
 	result := TableResult{
 		TableName: tableName,
 		StartTime: time.Now(),
 	}
 
+	spanAttrs := []attribute.KeyValue{
+		telemetry.AttrTransportID.String(plan.TransportID),
+		telemetry.AttrJobID.String(plan.JobID),
+		telemetry.AttrTable.String(tableName),
+	}
+	if rng != nil {
+		spanAttrs = append(spanAttrs, telemetry.AttrPart.Int(rng.Index))
+	}
+	ctx, span := telemetry.StartSpan(ctx, "table.extract", spanAttrs...)
+	defer func() {
+		span.SetAttributes(telemetry.AttrRows.Int64(result.RowCount), telemetry.AttrBytes.Int64(result.ByteCount))
+		telemetry.EndSpan(span, result.Error)
+	}()
+
+	// 추출 커서 확보 (유지보수 시간대가 닫혀 있으면 다시 열릴 때까지,
+	// 부하 조절 상태가 paused거나 동시 커서 수 상한에 도달하면 대기)
+	// paused 상태에서 대기하는 동안에도 클라이언트가 알 수 있도록 진행률 이벤트를 먼저 보냄
+	if onProgress != nil && e.governor.Status().Mode == governor.ModePaused {
+		onProgress(0, 0)
+	}
+	var releaseCursor func()
+	acquireErr := plan.wait(ctx)
+	if acquireErr == nil {
+		releaseCursor, acquireErr = e.governor.Acquire(ctx)
+	}
+	if acquireErr != nil {
+		result.EndTime = time.Now()
+		result.Duration = result.EndTime.Sub(result.StartTime)
+		result.Error = acquireErr
+		return result
+	}
+
 	// 추출 옵션 설정
 	opts := domain.ExtractionOptions{
 		ChunkSize:      bufferConfig.ChunkSize,
 		FetchArraySize: bufferConfig.FetchArraySize,
+		AsOfSCN:        plan.SnapshotSCN,
+		Range:          rng,
+		Source:         plan.source(tableName),
+	}
+	if _, ok := plan.Columns[tableName]; ok {
+		opts.Columns = domain.ColumnNames(format.Columns)
+	}
+	opts.ChunkMaxBytes = int64(bufferConfig.ChunkMaxBytes)
+	opts.LOBMaxInline = int64(bufferConfig.LOBMaxInlineBytes)
+	var lobWriter *lobObjectWriter
+	if lobs := plan.LOBs[tableName]; len(lobs) > 0 {
+		opts.LOBs = lobs
+		if e.gcs != nil {
+			part := 0
+			if rng != nil {
+				part = rng.Index
+			}
+			lobWriter = &lobObjectWriter{client: e.gcs, transportID: plan.TransportID, jobVersion: plan.JobVersion, tableName: tableName, part: part}
+			opts.LOBWriter = lobWriter
+		}
 	}
 
-	var rowCount int64
+	// 증분 추출 범위 설정 (상한은 추출된 row에서 관측)
+	var watermark *domain.WatermarkRange
+	if r, ok := plan.Watermarks[tableName]; ok {
+		from := r
+		opts.Watermark = &from
+		watermark = domain.NewWatermarkRange(r.Column, r.From)
+	}
 
-	// 데이터 추출
+	var rowCount, streamedRows, bytesWritten int64
+
+	// 업로드 대상 객체 경로
+	var objectPath string
+	if e.gcs != nil {
+		if rng != nil {
+			objectPath = e.gcs.PartObjectPath(plan.TransportID, plan.JobVersion, tableName, rng.Index, plan.OutputFormat)
+		} else {
+			objectPath = e.gcs.ObjectPath(plan.TransportID, plan.JobVersion, tableName, plan.OutputFormat)
+		}
+	}
+
+	// 업로드 파이프라인 시작
+	var (
+		rowCh        chan rowset.Row
+		uploadDone   chan struct{}
+		cancelUpload context.CancelFunc
+		uploadResult *gcs.UploadResult
+		uploadErr    error
+	)
+	if e.uploader != nil {
+		var uploadCtx context.Context
+		uploadCtx, cancelUpload = context.WithCancel(ctx)
+		defer cancelUpload()
+
+		rowCh = make(chan rowset.Row, bufferConfig.FetchArraySize)
+		uploadDone = make(chan struct{})
+		callback := func(progress gcs.UploadProgress) {
+			atomic.StoreInt64(&bytesWritten, progress.BytesWritten)
+		}
+		go func() {
+			defer close(uploadDone)
+			if rng != nil {
+				uploadResult, uploadErr = e.uploader.UploadTablePartStream(uploadCtx, plan.TransportID, plan.JobVersion, tableName, rng.Index, format, rowCh, callback)
+			} else {
+				uploadResult, uploadErr = e.uploader.UploadTableStream(uploadCtx, plan.TransportID, plan.JobVersion, tableName, format, rowCh, callback)
+			}
+		}()
+	}
+
+	// 데이터 추출 (청크 처리 시간은 이전 청크 전달 이후부터 측정)
+	chunkStart := time.Now()
 	err := e.oracle.StreamTableData(ctx, plan.Owner, tableName, opts, func(chunk *domain.ChunkResult) error {
 		// 컨텍스트 취소 확인
 		select {
@@ -264,29 +777,141 @@ func (e *ParallelExecutor) extractTable(ctx context.Context, plan ExecutionPlan,
 		default:
 		}
 
+		// 업로더로 row 전달 (업로드가 먼저 실패하면 추출 중단)
+		// 전달 span은 인코딩/업로드 지연으로 인한 대기 시간을 보여줌
+		if rowCh != nil {
+			_, dispatchSpan := telemetry.StartSpan(ctx, "chunk.dispatch",
+				telemetry.AttrChunk.Int(chunk.ChunkNumber),
+				telemetry.AttrRows.Int(chunk.RowCount),
+			)
+			for _, row := range chunk.Rows {
+				select {
+				case rowCh <- row:
+				case <-uploadDone:
+					telemetry.EndSpan(dispatchSpan, errUploadAborted)
+					return errUploadAborted
+				case <-ctx.Done():
+					telemetry.EndSpan(dispatchSpan, ctx.Err())
+					return ctx.Err()
+				}
+			}
+			dispatchSpan.End()
+		}
+
 		atomic.AddInt64(&rowCount, int64(chunk.RowCount))
+		atomic.StoreInt64(&streamedRows, chunk.TotalRowsSent)
+		e.metrics.ObserveChunk(plan.TransportID, tableName, chunk.RowCount, time.Since(chunkStart))
+		chunkStart = time.Now()
+		if watermark != nil && chunk.WatermarkValue != nil {
+			watermark.Observe(*chunk.WatermarkValue)
+		}
 
-		// 진행률 이벤트 발송
-		if e.sse != nil {
-			e.sendProgressEvent(plan.TransportID, plan.JobID, tableName, atomic.LoadInt64(&rowCount))
+		// 진행률 보고
+		if onProgress != nil {
+			onProgress(atomic.LoadInt64(&rowCount), atomic.LoadInt64(&bytesWritten))
 		}
 
-		return nil
+		// 다음 청크 조회 전 부하 조절 (paused면 해제될 때까지, row 속도 상한을 넘으면 대기)
+		// 유지보수 시간대가 닫혀도 열린 커서(세션, 커서 슬롯, AS OF SCN 스냅샷)를 붙잡고 멈추지 않도록
+		// 시간대 대기(plan.wait)는 테이블 경계에서만 수행
+		return e.governor.Throttle(ctx, plan.JobID, chunk.RowCount)
 	})
+	releaseCursor()
+
+	// 업로드 파이프라인 종료
+	if e.uploader != nil {
+		if err == nil {
+			// 정상 종료: 채널을 닫아 인코더/GCS 객체를 확정
+			close(rowCh)
+		} else {
+			// 추출 실패: 부분 객체가 확정되지 않도록 업로드 취소
+			cancelUpload()
+		}
+		<-uploadDone
+	}
 
 	result.EndTime = time.Now()
 	result.Duration = result.EndTime.Sub(result.StartTime)
 	result.RowCount = rowCount
 
-	if err != nil {
+	if watermark != nil {
+		// 새 row가 없으면 기존 기준값 유지
+		if watermark.To == nil && watermark.From != nil {
+			to := *watermark.From
+			watermark.To = &to
+		}
+		result.Watermark = watermark
+	}
+
+	switch {
+	case err != nil && errors.Is(err, errUploadAborted) && ctx.Err() != nil:
+		result.Error = ctx.Err()
+	case err != nil && errors.Is(err, errUploadAborted):
+		result.Error = &UploadError{ObjectPath: objectPath, Err: uploadErr}
+	case err != nil:
 		result.Error = err
+	case uploadErr != nil:
+		result.Error = &UploadError{ObjectPath: objectPath, Err: uploadErr}
+	case uploadResult != nil:
+		e.metrics.ObserveUpload(plan.TransportID, tableName, uploadResult.BytesWritten, uploadResult.BytesOriginal, uploadResult.Duration)
+		result.ByteCount = uploadResult.BytesWritten
+		result.UncompressedBytes = uploadResult.BytesOriginal
+		objectURI := fmt.Sprintf("gs://%s/%s", e.gcs.BucketName(), objectPath)
+		if rng != nil {
+			result.GCSPath = objectURI
+		} else {
+			result.GCSPath = e.gcs.FullGCSPath(plan.TransportID, plan.JobVersion, tableName, plan.OutputFormat)
+		}
+		result.Objects = []domain.ObjectInfo{{
+			Path:              objectURI,
+			RowCount:          rowCount,
+			ByteCount:         uploadResult.BytesWritten,
+			UncompressedBytes: uploadResult.BytesOriginal,
+			CRC32C:            gcs.EncodeCRC32C(uploadResult.CRC32C),
+		}}
+		if lobWriter != nil {
+			result.LOBObjects = lobWriter.objects
+			result.ByteCount += lobWriter.bytes
+			result.UncompressedBytes += lobWriter.bytes
+		}
+
+		if plan.Reconcile.Enabled() {
+			result.Reconciliation = e.reconcile(ctx, plan.Owner, tableName, opts, atomic.LoadInt64(&streamedRows), uploadResult.RowsEncoded)
+			switch {
+			case result.Reconciliation.Matched:
+			case ctx.Err() != nil:
+				// 대사 중 취소된 경우 불일치가 아닌 취소로 처리
+				result.Error = ctx.Err()
+			case plan.Reconcile == domain.ReconcilePolicyFail:
+				result.Error = &ReconcileError{Reconciliation: result.Reconciliation}
+			}
+		}
 	}
 
 	return result
 }
 
+// reconcile은 추출과 같은 조건(SCN, watermark 하한, 분할 범위)의 원본 row 수를
+// 스트리밍된 row 수(ChunkResult.TotalRowsSent), 인코딩된 row 수와 비교합니다
+// 일관된 스냅샷(AsOfSCN)을 사용하지 않으면 추출 이후의 변경이 원본 row 수에 반영될 수 있습니다
+func (e *ParallelExecutor) reconcile(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, streamedRows, encodedRows int64) *domain.Reconciliation {
+	// COUNT(*)도 커서를 사용하므로 부하 조절 대상
+	var sourceRows int64
+	release, err := e.governor.Acquire(ctx)
+	if err == nil {
+		sourceRows, err = e.oracle.CountRows(ctx, owner, tableName, opts)
+		release()
+	}
+	rec := domain.NewReconciliation(sourceRows, streamedRows, encodedRows, opts.AsOfSCN)
+	if err != nil {
+		rec.Matched = false
+		rec.Error = err.Error()
+	}
+	return rec
+}
+
 // sendProgressEvent는 진행률 이벤트를 발송합니다
-func (e *ParallelExecutor) sendProgressEvent(transportID, jobID, tableName string, rowsProcessed int64) {
+func (e *ParallelExecutor) sendProgressEvent(transportID, jobID, tableName string, rowsProcessed, bytesWritten int64) {
 	if e.sse == nil {
 		return
 	}
@@ -297,6 +922,8 @@ func (e *ParallelExecutor) sendProgressEvent(transportID, jobID, tableName strin
 		Table:         tableName,
 		RowsProcessed: rowsProcessed,
 		RowsTotal:     -1, // 총 row 수 알 수 없음
+		BytesWritten:  bytesWritten,
+		Throttle:      e.throttleInfo(),
 	})
 }
 
@@ -307,17 +934,48 @@ func (e *ParallelExecutor) sendTableEvent(transportID, jobID string, result Tabl
 	}
 
 	if !result.Success() {
-		// 에러 이벤트 발송
+		// 에러 이벤트 발송 (업로드 실패, 스냅샷 만료는 일반 추출 실패와 구분)
+		code := "EXTRACTION_ERROR"
+		var (
+			uploadErr    *UploadError
+			reconcileErr *ReconcileError
+		)
+		switch {
+		case errors.Is(result.Error, resilience.ErrCircuitOpen):
+			code = "CIRCUIT_OPEN"
+		case errors.As(result.Error, &uploadErr):
+			code = "GCS_UPLOAD_ERROR"
+		case errors.As(result.Error, &reconcileErr):
+			code = "ROW_COUNT_MISMATCH"
+		case errors.Is(result.Error, oracle.ErrSnapshotTooOld):
+			code = "SNAPSHOT_TOO_OLD"
+		default:
+			// Oracle 에러는 분류된 코드로 구분 (ORACLE_TABLE_NOT_FOUND 등)
+			if resp := apperrors.ClassifyOracle(result.Error); resp != nil {
+				code = resp.Code
+			}
+		}
 		e.sse.BroadcastError(sse.ErrorEvent{
 			TransportID: transportID,
 			JobID:       jobID,
 			Table:       result.TableName,
-			Code:        "EXTRACTION_ERROR",
+			Code:        code,
 			Message:     result.Error.Error(),
 		})
 		return
 	}
 
+	// 대사 정책이 warn이면 테이블은 완료로 처리하고 불일치만 에러 이벤트로 알림
+	if rec := result.Reconciliation; rec != nil && !rec.Matched {
+		e.sse.BroadcastError(sse.ErrorEvent{
+			TransportID: transportID,
+			JobID:       jobID,
+			Table:       result.TableName,
+			Code:        "ROW_COUNT_MISMATCH",
+			Message:     fmt.Sprintf("테이블 %s row 수 불일치: %s", result.TableName, rec),
+		})
+	}
+
 	// 테이블 완료 이벤트 (상태 이벤트로 발송)
 	e.sse.BroadcastStatus(sse.StatusEvent{
 		TransportID: transportID,
@@ -334,11 +992,11 @@ func (e *ParallelExecutor) sendCompleteEvent(result *ExecutionResult) {
 	}
 
 	e.sse.BroadcastComplete(sse.CompleteEvent{
-		TransportID:   result.TransportID,
-		JobID:         result.JobID,
-		TotalRows:     result.TotalRows,
-		TotalBytes:    result.TotalBytes,
-		DurationMs:    result.Duration().Milliseconds(),
-		TablesCount:   result.SuccessfulTables + result.FailedTables,
+		TransportID: result.TransportID,
+		JobID:       result.JobID,
+		TotalRows:   result.TotalRows,
+		TotalBytes:  result.TotalBytes,
+		DurationMs:  result.Duration().Milliseconds(),
+		TablesCount: result.SuccessfulTables + result.FailedTables,
 	})
 }
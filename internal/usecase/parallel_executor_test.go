@@ -2,8 +2,14 @@
 package usecase
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -12,10 +18,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"oracle-etl/internal/adapter/gcs"
 	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/adapter/sse"
 	"oracle-etl/internal/domain"
+	"oracle-etl/internal/governor"
+	"oracle-etl/internal/resilience"
+	"oracle-etl/internal/telemetry"
 	"oracle-etl/pkg/buffer"
+	"oracle-etl/pkg/rowset"
 )
 
 func TestNewParallelExecutor(t *testing.T) {
@@ -363,6 +374,333 @@ func TestParallelExecutor_Concurrency(t *testing.T) {
 	assert.LessOrEqual(t, maxConcurrent, int32(3))
 }
 
+// mockRowSchema는 Mock 청크 row의 스키마입니다
+var mockRowSchema = rowset.NewSchema([]rowset.Column{
+	{Name: "VBELN", Kind: rowset.KindString},
+	{Name: "NETWR", Kind: rowset.KindNumber},
+})
+
+// mockRowChunks는 row 데이터가 채워진 Mock 청크를 생성합니다
+func mockRowChunks(chunkCount, rowsPerChunk int) []*domain.ChunkResult {
+	chunks := make([]*domain.ChunkResult, 0, chunkCount)
+	var sent int64
+	for c := 1; c <= chunkCount; c++ {
+		rows := make([]rowset.Row, 0, rowsPerChunk)
+		for i := 0; i < rowsPerChunk; i++ {
+			row, _ := rowset.New(mockRowSchema, fmt.Sprintf("%010d", int(sent)+i), "100.5")
+			rows = append(rows, row)
+		}
+		sent += int64(rowsPerChunk)
+		chunks = append(chunks, &domain.ChunkResult{
+			ChunkNumber:   c,
+			Rows:          rows,
+			RowCount:      rowsPerChunk,
+			IsLastChunk:   c == chunkCount,
+			TotalRowsSent: sent,
+		})
+	}
+	return chunks
+}
+
+func TestParallelExecutor_Execute_UploadToGCS(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(3, 100)
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP", "VBRK"},
+		Concurrency: 2,
+		Owner:       "SAPSR3",
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.NoError(t, err)
+	require.Len(t, result.TableResults, 2)
+
+	for _, tr := range result.TableResults {
+		assert.Equal(t, int64(300), tr.RowCount)
+		assert.Equal(t, "gs://test-bucket/TRP-001/v001/"+tr.TableName+".jsonl.gz", tr.GCSPath)
+		assert.Greater(t, tr.ByteCount, int64(0))
+		assert.Greater(t, tr.UncompressedBytes, tr.ByteCount)
+
+		// 업로드된 객체는 gzip 압축된 JSONL
+		data, ok := gcsClient.Object("TRP-001/v001/" + tr.TableName + ".jsonl.gz")
+		require.True(t, ok)
+		assert.Equal(t, tr.ByteCount, int64(len(data)))
+
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		assert.Equal(t, tr.UncompressedBytes, int64(len(decoded)))
+		assert.Equal(t, 300, bytes.Count(decoded, []byte("\n")))
+	}
+	assert.Equal(t, result.TableResults[0].ByteCount+result.TableResults[1].ByteCount, result.TotalBytes)
+}
+
+func TestParallelExecutor_Execute_UploadFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(2, 50)
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+	gcsClient.CloseError = errors.New("googleapi: Error 403: permission denied")
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 1)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.Error(t, err)
+	require.Len(t, result.TableResults, 1)
+
+	// 업로드 실패는 UploadError로 구분
+	var uploadErr *UploadError
+	require.ErrorAs(t, result.TableResults[0].Error, &uploadErr)
+	assert.Equal(t, "TRP-001/v001/VBRP.jsonl.gz", uploadErr.ObjectPath)
+	assert.Contains(t, uploadErr.Error(), "403")
+	assert.Empty(t, result.TableResults[0].GCSPath)
+	assert.Empty(t, gcsClient.ObjectPaths())
+}
+
+func TestParallelExecutor_Execute_ExtractionFailureAbortsUpload(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	chunks := mockRowChunks(1, 10)
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		if err := handler(chunks[0]); err != nil {
+			return err
+		}
+		return errors.New("ORA-03113: end-of-file on communication channel")
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 1)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.Error(t, err)
+	require.Len(t, result.TableResults, 1)
+
+	// 추출 실패는 UploadError가 아니며, 부분 객체가 확정되지 않아야 함
+	var uploadErr *UploadError
+	assert.False(t, errors.As(result.TableResults[0].Error, &uploadErr))
+	assert.Contains(t, result.TableResults[0].Error.Error(), "ORA-03113")
+	assert.Equal(t, []string{"TRP-001/v001/_FAILED", "TRP-001/v001/manifest.json"}, gcsClient.ObjectPaths())
+}
+
+func TestParallelExecutor_Execute_SplitRanges(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(2, 50)
+	mockRepo.MockRanges = map[string][]domain.TableRange{
+		"VBRP": {
+			{Index: 0, StartRowID: "AAAR3sAAEAAAACXAAA", EndRowID: "AAAR3sAAEAAAACnH//"},
+			{Index: 1, StartRowID: "AAAR3sAAEAAAACoAAA", EndRowID: "AAAR3sAAEAAAAC3H//"},
+			{Index: 2, StartRowID: "AAAR3sAAEAAAAC4AAA", EndRowID: "AAAR3sAAEAAAADHH//"},
+		},
+	}
+
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		if opts.Range != nil {
+			mu.Lock()
+			seen[opts.Range.StartRowID] = true
+			mu.Unlock()
+		}
+		for _, chunk := range mockRepo.MockChunks {
+			chunkCopy := *chunk
+			chunkCopy.TableName = tableName
+			if err := handler(&chunkCopy); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 4)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP", "VBRK"},
+		Concurrency: 4,
+		Owner:       "SAPSR3",
+		Split:       domain.SplitOptions{MinRows: 1, RowsPerRange: 1, MaxRanges: 4},
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.NoError(t, err)
+	require.Len(t, result.TableResults, 2)
+
+	byTable := make(map[string]TableResult)
+	for _, tr := range result.TableResults {
+		byTable[tr.TableName] = tr
+	}
+
+	// 분할된 테이블은 범위별 part 객체로 업로드되고 결과는 합산됨
+	vbrp := byTable["VBRP"]
+	require.True(t, vbrp.Success())
+	assert.Equal(t, 3, vbrp.Parts)
+	assert.Equal(t, int64(300), vbrp.RowCount)
+	assert.Equal(t, "gs://test-bucket/TRP-001/v001/VBRP/", vbrp.GCSPath)
+	assert.Len(t, seen, 3)
+
+	var partBytes int64
+	for i := 0; i < 3; i++ {
+		data, ok := gcsClient.Object(fmt.Sprintf("TRP-001/v001/VBRP/part-%05d.jsonl.gz", i))
+		require.True(t, ok)
+		partBytes += int64(len(data))
+	}
+	assert.Equal(t, vbrp.ByteCount, partBytes)
+
+	// 범위가 없는 테이블은 단일 객체
+	vbrk := byTable["VBRK"]
+	require.True(t, vbrk.Success())
+	assert.Zero(t, vbrk.Parts)
+	assert.Equal(t, "gs://test-bucket/TRP-001/v001/VBRK.jsonl.gz", vbrk.GCSPath)
+	assert.Equal(t, result.TotalRows, int64(400))
+}
+
+func TestParallelExecutor_Execute_SplitRangeFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	chunks := mockRowChunks(1, 10)
+	mockRepo.MockRanges = map[string][]domain.TableRange{
+		"VBRP": {
+			{Index: 0, Partition: "P2023"},
+			{Index: 1, Partition: "P2024"},
+		},
+	}
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		if opts.Range != nil && opts.Range.Partition == "P2024" {
+			return errors.New("ORA-03113: end-of-file on communication channel")
+		}
+		return handler(chunks[0])
+	}
+
+	executor := NewParallelExecutor(mockRepo, nil, nil, 2)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Split:       domain.SplitOptions{MinRows: 1, RowsPerRange: 1, MaxRanges: 4},
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.Error(t, err)
+	require.Len(t, result.TableResults, 1)
+
+	// 한 범위라도 실패하면 테이블 전체가 실패
+	tr := result.TableResults[0]
+	assert.False(t, tr.Success())
+	assert.Equal(t, 2, tr.Parts)
+	assert.Contains(t, tr.Error.Error(), "1/2")
+	assert.Contains(t, tr.Error.Error(), "ORA-03113")
+}
+
+func TestParallelExecutor_Execute_ParquetOutput(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(2, 50)
+	precision, scale := 15, 2
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "VBELN", DataType: "VARCHAR2", Position: 1},
+		{Name: "NETWR", DataType: "NUMBER", Precision: &precision, Scale: &scale, Position: 2},
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 1)
+
+	plan := ExecutionPlan{
+		TransportID:  "TRP-001",
+		JobID:        "JOB-001",
+		JobVersion:   "v001",
+		Tables:       []string{"VBRP"},
+		Owner:        "SAPSR3",
+		OutputFormat: domain.OutputFormatParquet,
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.NoError(t, err)
+	require.Len(t, result.TableResults, 1)
+
+	tr := result.TableResults[0]
+	assert.Equal(t, int64(100), tr.RowCount)
+	assert.Equal(t, "gs://test-bucket/TRP-001/v001/VBRP.parquet", tr.GCSPath)
+	assert.Greater(t, tr.UncompressedBytes, int64(0))
+
+	data, ok := gcsClient.Object("TRP-001/v001/VBRP.parquet")
+	require.True(t, ok)
+	assert.Equal(t, tr.ByteCount, int64(len(data)))
+	assert.Equal(t, "PAR1", string(data[:4]))
+	assert.Equal(t, "PAR1", string(data[len(data)-4:]))
+}
+
+func TestParallelExecutor_Execute_ParquetWithoutColumns(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockColumns = nil
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	}).(*gcs.MockClient)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 1)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID:  "TRP-001",
+		JobID:        "JOB-001",
+		JobVersion:   "v001",
+		Tables:       []string{"VBRP"},
+		Owner:        "SAPSR3",
+		OutputFormat: domain.OutputFormatParquet,
+	})
+	require.Error(t, err)
+	require.Len(t, result.TableResults, 1)
+	assert.Contains(t, result.TableResults[0].Error.Error(), "컬럼 정보")
+	assert.Equal(t, []string{"TRP-001/v001/_FAILED", "TRP-001/v001/manifest.json"}, gcsClient.ObjectPaths())
+}
+
 func TestExecutionResult_Duration(t *testing.T) {
 	startTime := time.Now().Add(-10 * time.Second)
 	endTime := time.Now()
@@ -453,3 +791,716 @@ func TestExecutionPlan_EffectiveBufferConfig(t *testing.T) {
 		assert.Equal(t, customConfig, config)
 	})
 }
+
+// waitErrorEvent는 SSE 클라이언트에서 첫 번째 에러 이벤트를 기다립니다
+func waitErrorEvent(t *testing.T, client *sse.Client) sse.ErrorEvent {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-client.Events:
+			if errEvent, ok := event.Data.(sse.ErrorEvent); ok {
+				return errEvent
+			}
+		case <-timeout:
+			t.Fatal("에러 이벤트를 수신하지 못했습니다")
+		}
+	}
+}
+
+func TestParallelExecutor_Execute_Reconcile(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(3, 10)
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		SnapshotSCN: 4815162342,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.TableResults, 1)
+
+	assert.Equal(t, &domain.Reconciliation{
+		SourceRows:   30,
+		StreamedRows: 30,
+		EncodedRows:  30,
+		SCN:          4815162342,
+		Matched:      true,
+	}, result.TableResults[0].Reconciliation)
+}
+
+func TestParallelExecutor_Execute_ReconcileMismatchWarn(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockCounts = map[string]int64{"VBRP": 12}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+
+	broadcaster := sse.NewBroadcaster()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go broadcaster.Run(ctx)
+	client := broadcaster.Register("TRP-001")
+	defer broadcaster.Unregister(client.ID)
+
+	executor := NewParallelExecutor(mockRepo, gcsClient, broadcaster, 2)
+	result, err := executor.Execute(ctx, ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Reconcile:   domain.ReconcilePolicyWarn,
+	})
+
+	// warn 정책에서는 테이블이 완료되고 불일치만 기록
+	require.NoError(t, err)
+	tr := result.TableResults[0]
+	assert.True(t, tr.Success())
+	require.NotNil(t, tr.Reconciliation)
+	assert.False(t, tr.Reconciliation.Matched)
+	assert.Equal(t, int64(12), tr.Reconciliation.SourceRows)
+	assert.Equal(t, int64(10), tr.Reconciliation.EncodedRows)
+
+	errEvent := waitErrorEvent(t, client)
+	assert.Equal(t, "ROW_COUNT_MISMATCH", errEvent.Code)
+	assert.Equal(t, "VBRP", errEvent.Table)
+	assert.Contains(t, errEvent.Message, "source=12, streamed=10, encoded=10")
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	require.Len(t, manifest.Tables, 1)
+	assert.Equal(t, tr.Reconciliation, manifest.Tables[0].Reconciliation)
+}
+
+func TestParallelExecutor_Execute_ReconcileMismatchFail(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockCounts = map[string]int64{"VBRP": 12}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP", "VBRK"},
+		Owner:       "SAPSR3",
+		Reconcile:   domain.ReconcilePolicyFail,
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, result.FailedTables)
+
+	for _, tr := range result.TableResults {
+		if tr.TableName == "VBRK" {
+			assert.True(t, tr.Success())
+			continue
+		}
+		var reconcileErr *ReconcileError
+		require.ErrorAs(t, tr.Error, &reconcileErr)
+		assert.Equal(t, int64(12), reconcileErr.Reconciliation.SourceRows)
+	}
+
+	_, ok := gcsClient.Object("TRP-001/v001/_FAILED")
+	assert.True(t, ok)
+}
+
+func TestParallelExecutor_Execute_ReconcileCountFailure(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	// 추출 이후 COUNT(*) 조회만 실패
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mockRepo.TableErrors[tableName] = errors.New("ORA-01013: user requested cancel of current operation")
+		return handler(mockRepo.MockChunks[0])
+	}
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+	})
+	require.NoError(t, err)
+
+	rec := result.TableResults[0].Reconciliation
+	require.NotNil(t, rec)
+	assert.False(t, rec.Matched)
+	assert.Contains(t, rec.Error, "ORA-01013")
+}
+
+func TestParallelExecutor_Execute_ReconcileSplitRanges(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockRanges = map[string][]domain.TableRange{
+		"VBRP": {{Index: 0, Partition: "P2023"}, {Index: 1, Partition: "P2024"}},
+	}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Split:       domain.SplitOptions{MinRows: 1, RowsPerRange: 1, MaxRanges: 4},
+	})
+	require.NoError(t, err)
+
+	// 범위별 대사 결과를 합산
+	rec := result.TableResults[0].Reconciliation
+	require.NotNil(t, rec)
+	assert.True(t, rec.Matched)
+	assert.Equal(t, int64(20), rec.SourceRows)
+	assert.Equal(t, int64(20), rec.StreamedRows)
+	assert.Equal(t, int64(20), rec.EncodedRows)
+}
+
+func TestParallelExecutor_Execute_ReconcileOff(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockCounts = map[string]int64{"VBRP": 12}
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+		Reconcile:   domain.ReconcilePolicyOff,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, result.TableResults[0].Reconciliation)
+}
+
+// TestParallelExecutor_Execute_Sources는 추출 원본 정의를 같은 파이프라인으로 추출하고
+// 출력 객체를 원본 이름으로 기록하는지 테스트합니다
+func TestParallelExecutor_Execute_Sources(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "INVOICE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "ORG_ID", DataType: "NUMBER", Nullable: true, Position: 2},
+		{Name: "AMOUNT", DataType: "NUMBER", Nullable: true, Position: 3},
+	}
+	// 컬럼/조건 지정 원본은 원본 테이블 기준으로 분할
+	mockRepo.MockRanges = map[string][]domain.TableRange{
+		"AP_INVOICES_ALL":  {{Index: 0, Partition: "P1"}, {Index: 1, Partition: "P2"}},
+		"AP_INVOICE_LINES": {{Index: 0, Partition: "P1"}, {Index: 1, Partition: "P2"}},
+	}
+
+	var mu sync.Mutex
+	received := make(map[string]*domain.Source)
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mu.Lock()
+		received[tableName] = opts.Source
+		mu.Unlock()
+		return handler(mockRepo.MockChunks[0])
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	invoices := domain.Source{
+		Name:    "OPEN_INVOICES",
+		Table:   "AP_INVOICES_ALL",
+		Columns: []string{"INVOICE_ID", "ORG_ID"},
+		Where:   "org_id = :org_id",
+		Binds:   map[string]interface{}{"org_id": float64(204)},
+	}
+	lines := domain.Source{
+		Name: "INVOICE_LINES",
+		SQL:  "SELECT l.* FROM ap_invoice_lines_all l JOIN ap_invoices_all i ON i.invoice_id = l.invoice_id",
+	}
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"OPEN_INVOICES", "INVOICE_LINES"},
+		Sources:     map[string]domain.Source{invoices.Name: invoices, lines.Name: lines},
+		Owner:       "APPS",
+		Split:       domain.SplitOptions{MinRows: 1, RowsPerRange: 1, MaxRanges: 4},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success())
+
+	// SQL 원본은 분할하지 않음
+	assert.Equal(t, []string{
+		"TRP-001/v001/INVOICE_LINES.jsonl.gz",
+		"TRP-001/v001/OPEN_INVOICES/part-00000.jsonl.gz",
+		"TRP-001/v001/OPEN_INVOICES/part-00001.jsonl.gz",
+		"TRP-001/v001/_SUCCESS",
+		"TRP-001/v001/manifest.json",
+	}, gcsClient.ObjectPaths())
+
+	require.NotNil(t, received["INVOICE_LINES"])
+	assert.Equal(t, lines, *received["INVOICE_LINES"])
+	require.NotNil(t, received["OPEN_INVOICES"])
+	assert.Equal(t, invoices, *received["OPEN_INVOICES"])
+
+	// manifest의 컬럼은 원본의 출력 컬럼
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, "INVOICE_LINES", manifest.Tables[0].TableName)
+	assert.Equal(t, mockRepo.MockColumns, manifest.Tables[0].Columns)
+	assert.Equal(t, "OPEN_INVOICES", manifest.Tables[1].TableName)
+	assert.Equal(t, []domain.ColumnInfo{
+		{Name: "INVOICE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "ORG_ID", DataType: "NUMBER", Nullable: true, Position: 2},
+	}, manifest.Tables[1].Columns)
+}
+
+// TestParallelExecutor_Execute_ColumnFilter는 컬럼 필터를 추출 쿼리 컬럼 목록과 manifest 스키마에 반영하는지 테스트합니다
+func TestParallelExecutor_Execute_ColumnFilter(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "INVOICE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "ATTRIBUTE1", DataType: "VARCHAR2", Nullable: true, Position: 2},
+		{Name: "ATTRIBUTE15", DataType: "VARCHAR2", Nullable: true, Position: 3},
+		{Name: "BANK_ACCOUNT_NUM", DataType: "VARCHAR2", Nullable: true, Position: 4},
+		{Name: "LAST_UPDATE_DATE", DataType: "DATE", Position: 5},
+	}
+
+	var mu sync.Mutex
+	received := make(map[string][]string)
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		mu.Lock()
+		received[tableName] = opts.Columns
+		mu.Unlock()
+		return handler(mockRepo.MockChunks[0])
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	_, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"AP_INVOICES_ALL", "AP_SUPPLIERS"},
+		Columns: map[string]domain.ColumnFilter{
+			"AP_INVOICES_ALL": {ExcludeColumns: []string{"attribute*", "BANK_ACCOUNT_NUM"}},
+		},
+		Owner: "AP",
+	})
+	require.NoError(t, err)
+
+	// 필터가 없는 테이블은 전체 컬럼 조회
+	assert.Equal(t, []string{"INVOICE_ID", "LAST_UPDATE_DATE"}, received["AP_INVOICES_ALL"])
+	assert.Nil(t, received["AP_SUPPLIERS"])
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, "AP_INVOICES_ALL", manifest.Tables[0].TableName)
+	assert.Equal(t, []domain.ColumnInfo{
+		{Name: "INVOICE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "LAST_UPDATE_DATE", DataType: "DATE", Position: 2},
+	}, manifest.Tables[0].Columns)
+	assert.Equal(t, mockRepo.MockColumns, manifest.Tables[1].Columns)
+}
+
+// TestParallelExecutor_Execute_ColumnFilterNoColumns는 필터 적용 후 추출할 컬럼이 없으면 테이블을 실패 처리하는지 테스트합니다
+func TestParallelExecutor_Execute_ColumnFilterNoColumns(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+	executor := NewParallelExecutor(mockRepo, nil, nil, 2)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		Tables:      []string{"VBRP"},
+		Columns:     map[string]domain.ColumnFilter{"VBRP": {IncludeColumns: []string{"ATTRIBUTE*"}}},
+		Owner:       "SAPSR3",
+	})
+	require.Error(t, err)
+	require.Len(t, result.TableResults, 1)
+	assert.Contains(t, result.TableResults[0].Error.Error(), "추출할 컬럼 정보를 찾을 수 없습니다")
+	assert.False(t, mockRepo.StreamCalled)
+}
+
+// TestParallelExecutor_Execute_LOBPolicies는 LOB 정책, 청크 바이트 상한, object 정책 LOB writer를 추출 옵션에 전달하는지 테스트합니다
+func TestParallelExecutor_Execute_LOBPolicies(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(1, 10)
+
+	var mu sync.Mutex
+	received := make(map[string]domain.ExtractionOptions)
+	lobURIs := make(map[string]string)
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		var uri string
+		if opts.LOBWriter != nil {
+			var err error
+			uri, err = opts.LOBWriter.WriteLOB(ctx, "FILE_DATA", 1, strings.NewReader("attachment"))
+			if err != nil {
+				return err
+			}
+		}
+		mu.Lock()
+		received[tableName] = opts
+		lobURIs[tableName] = uri
+		mu.Unlock()
+		return handler(mockRepo.MockChunks[0])
+	}
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{ProjectID: "test-project", BucketName: "test-bucket"}).(*gcs.MockClient)
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+
+	lowMemory := buffer.LowMemoryConfig()
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID:  "TRP-001",
+		JobID:        "JOB-001",
+		JobVersion:   "v001",
+		Tables:       []string{"FND_LOBS", "FND_USER"},
+		LOBs:         map[string]domain.LOBPolicies{"FND_LOBS": {"FILE_DATA": domain.LOBPolicyObject}},
+		Owner:        "APPLSYS",
+		BufferConfig: &lowMemory,
+	})
+	require.NoError(t, err)
+
+	lobOpts := received["FND_LOBS"]
+	assert.Equal(t, domain.LOBPolicies{"FILE_DATA": domain.LOBPolicyObject}, lobOpts.LOBs)
+	assert.Equal(t, int64(lowMemory.ChunkMaxBytes), lobOpts.ChunkMaxBytes)
+	require.NotNil(t, lobOpts.LOBWriter)
+
+	// LOB 값은 테이블의 _lobs 접두사 아래 객체로 기록되고 row에는 gs:// URI가 들어감
+	path := "TRP-001/v001/FND_LOBS/_lobs/FILE_DATA/00000-000000000001.bin"
+	assert.Equal(t, "gs://test-bucket/"+path, lobURIs["FND_LOBS"])
+	data, ok := gcsClient.Object(path)
+	require.True(t, ok)
+	assert.Equal(t, "attachment", string(data))
+
+	// LOB 객체는 테이블 결과에 경로, 크기, CRC32C로 기록되고 바이트 수에 포함
+	tables := make(map[string]TableResult)
+	for _, tr := range result.TableResults {
+		tables[tr.TableName] = tr
+	}
+	lobTable := tables["FND_LOBS"]
+	require.Equal(t, []domain.ObjectInfo{{
+		Path:              "gs://test-bucket/" + path,
+		ByteCount:         int64(len("attachment")),
+		UncompressedBytes: int64(len("attachment")),
+		CRC32C:            gcs.EncodeCRC32C(crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))),
+	}}, lobTable.LOBObjects)
+	require.Len(t, lobTable.Objects, 1)
+	assert.Equal(t, lobTable.Objects[0].ByteCount+int64(len("attachment")), lobTable.ByteCount)
+	assert.Empty(t, tables["FND_USER"].LOBObjects)
+
+	manifest := readManifest(t, gcsClient, "TRP-001/v001/manifest.json")
+	require.Len(t, manifest.Tables, 2)
+	assert.Equal(t, lobTable.LOBObjects, manifest.Tables[0].LOBObjects)
+	assert.Equal(t, lobTable.ByteCount, manifest.Tables[0].ByteCount)
+
+	// 정책이 없는 테이블은 LOB 옵션 없이 추출
+	assert.Nil(t, received["FND_USER"].LOBs)
+	assert.Nil(t, received["FND_USER"].LOBWriter)
+}
+
+func TestParallelExecutor_Execute_Metrics(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = mockRowChunks(3, 100)
+
+	gcsClient := gcs.NewMockClient(gcs.GCSConfig{
+		ProjectID:  "test-project",
+		BucketName: "test-bucket",
+	})
+
+	metrics := telemetry.NewMetrics()
+	executor := NewParallelExecutor(mockRepo, gcsClient, nil, 2)
+	executor.SetMetrics(metrics)
+
+	result, err := executor.Execute(context.Background(), ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"VBRP"},
+		Owner:       "SAPSR3",
+	})
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, metrics.Registry().Write(&sb))
+	out := sb.String()
+	assert.Contains(t, out, `oracle_etl_rows_extracted_total{table="VBRP",transport="TRP-001"} 300`)
+	assert.Contains(t, out, `oracle_etl_chunk_duration_seconds_count{table="VBRP",transport="TRP-001"} 3`)
+	assert.Contains(t, out, fmt.Sprintf(`oracle_etl_gcs_uploaded_bytes_total{table="VBRP",transport="TRP-001"} %d`, result.TotalBytes))
+
+	// 실행이 끝나면 워커 풀은 큐 길이 집계에서 제외
+	queued, pending := executor.QueueStats()
+	assert.Zero(t, queued)
+	assert.Zero(t, pending)
+}
+
+// flakyRepository는 처음 failures번의 추출을 err로 실패시키는 Mock 저장소입니다
+type flakyRepository struct {
+	*oracle.MockRepository
+	failures int32
+	err      error
+	calls    int32
+}
+
+func (r *flakyRepository) StreamTableData(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, chunkHandler func(chunk *domain.ChunkResult) error) error {
+	if atomic.AddInt32(&r.calls, 1) <= r.failures {
+		return r.err
+	}
+	return r.MockRepository.StreamTableData(ctx, owner, tableName, opts, chunkHandler)
+}
+
+func TestParallelExecutor_Execute_RetryTransient(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	repo := &flakyRepository{MockRepository: mockRepo, failures: 2, err: errors.New("ORA-03113: end-of-file on communication channel")}
+
+	broadcaster := sse.NewBroadcaster()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go broadcaster.Run(ctx)
+	client := broadcaster.Register("TRP-001")
+	time.Sleep(10 * time.Millisecond)
+
+	executor := NewParallelExecutor(repo, nil, broadcaster, 1)
+	executor.SetResilience(ResilienceConfig{
+		Retry: resilience.RetryConfig{MaxRetries: 3, InitialDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond, Multiplier: 2},
+	})
+
+	plan := ExecutionPlan{TransportID: "TRP-001", JobID: "JOB-001", JobVersion: "v001", Tables: []string{"VBRP"}, Owner: "SAPSR3"}
+
+	result, err := executor.Execute(ctx, plan)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.SuccessfulTables)
+	assert.Equal(t, int64(100), result.TotalRows)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&repo.calls))
+
+	// 재시도마다 retry 이벤트 발송
+	var retries []sse.RetryEvent
+	timeout := time.After(2 * time.Second)
+	for len(retries) < 2 {
+		select {
+		case event := <-client.Events:
+			if retryEvent, ok := event.Data.(sse.RetryEvent); ok {
+				retries = append(retries, retryEvent)
+			}
+		case <-timeout:
+			t.Fatal("retry 이벤트를 수신하지 못했습니다")
+		}
+	}
+	assert.Equal(t, "VBRP", retries[0].Table)
+	assert.Equal(t, 1, retries[0].Attempt)
+	assert.Equal(t, 3, retries[0].MaxAttempts)
+	assert.Contains(t, retries[0].Message, "ORA-03113")
+	assert.Equal(t, 2, retries[1].Attempt)
+}
+
+func TestParallelExecutor_Execute_NoRetryPermanent(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	repo := &flakyRepository{MockRepository: mockRepo, failures: 5, err: errors.New("ORA-00942: table or view does not exist")}
+
+	executor := NewParallelExecutor(repo, nil, nil, 1)
+	executor.SetResilience(ResilienceConfig{
+		Retry: resilience.RetryConfig{MaxRetries: 3, InitialDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond, Multiplier: 2},
+	})
+
+	plan := ExecutionPlan{TransportID: "TRP-001", JobID: "JOB-001", JobVersion: "v001", Tables: []string{"VBRP"}, Owner: "SAPSR3"}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.Error(t, err)
+	assert.Equal(t, 1, result.FailedTables)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&repo.calls), "영구 에러는 재시도하지 않음")
+}
+
+func TestParallelExecutor_Execute_CircuitOpen(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 10, IsLastChunk: true, TotalRowsSent: 10},
+	}
+	repo := &flakyRepository{MockRepository: mockRepo, failures: 2, err: errors.New("ORA-12541: TNS:no listener")}
+
+	broadcaster := sse.NewBroadcaster()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go broadcaster.Run(ctx)
+	client := broadcaster.Register("TRP-001")
+	time.Sleep(10 * time.Millisecond)
+
+	oracleBreaker := resilience.NewCircuitBreaker(resilience.CircuitConfig{
+		FailureThreshold: 2,
+		SuccessThreshold: 1,
+		Timeout:          time.Minute,
+		IsFailure:        resilience.IsTransient,
+	})
+	executor := NewParallelExecutor(repo, nil, broadcaster, 1)
+	executor.SetResilience(ResilienceConfig{OracleBreaker: oracleBreaker})
+	assert.Equal(t, map[string]*resilience.CircuitBreaker{"oracle": oracleBreaker}, executor.CircuitBreakers())
+
+	// 연속 두 번의 일시적 장애로 Oracle Circuit이 열리고, 세 번째 테이블은 호출 없이 실패
+	plan := ExecutionPlan{TransportID: "TRP-001", JobID: "JOB-001", JobVersion: "v001", Tables: []string{"T1", "T2", "T3"}, Owner: "SAPSR3"}
+
+	result, err := executor.Execute(ctx, plan)
+	require.Error(t, err)
+	assert.Equal(t, 3, result.FailedTables)
+	assert.Equal(t, resilience.StateOpen, oracleBreaker.State())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&repo.calls))
+
+	var rejected int
+	for _, tr := range result.TableResults {
+		if errors.Is(tr.Error, resilience.ErrCircuitOpen) {
+			rejected++
+		}
+	}
+	assert.Equal(t, 1, rejected)
+
+	// Circuit 상태 변경 이벤트와 CIRCUIT_OPEN 에러 이벤트 발송
+	var (
+		circuit     *sse.CircuitEvent
+		circuitCode bool
+	)
+	timeout := time.After(2 * time.Second)
+	for circuit == nil || !circuitCode {
+		select {
+		case event := <-client.Events:
+			switch data := event.Data.(type) {
+			case sse.CircuitEvent:
+				circuit = &data
+			case sse.ErrorEvent:
+				circuitCode = circuitCode || data.Code == "CIRCUIT_OPEN"
+			}
+		case <-timeout:
+			t.Fatal("circuit 이벤트를 수신하지 못했습니다")
+		}
+	}
+	assert.Equal(t, "oracle", circuit.Name)
+	assert.Equal(t, "open", circuit.State)
+	assert.Equal(t, "JOB-001", circuit.JobID)
+}
+
+func TestParallelExecutor_Execute_GovernorMaxCursors(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+
+	var current, maxConcurrent int32
+	mockRepo.StreamTableDataFunc = func(ctx context.Context, owner, tableName string, opts domain.ExtractionOptions, handler func(chunk *domain.ChunkResult) error) error {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			old := atomic.LoadInt32(&maxConcurrent)
+			if n <= old || atomic.CompareAndSwapInt32(&maxConcurrent, old, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		chunk := *mockRepo.MockChunks[0]
+		return handler(&chunk)
+	}
+
+	// 워커는 4개지만 모든 Job 합산 커서는 2개까지
+	g := governor.New(governor.Config{MaxCursors: 2})
+	executor := NewParallelExecutor(mockRepo, nil, nil, 4)
+	executor.SetGovernor(g)
+
+	plan := ExecutionPlan{
+		TransportID: "TRP-001",
+		JobID:       "JOB-001",
+		JobVersion:  "v001",
+		Tables:      []string{"T1", "T2", "T3", "T4", "T5", "T6"},
+		Concurrency: 4,
+		Owner:       "SAPSR3",
+	}
+
+	result, err := executor.Execute(context.Background(), plan)
+	require.NoError(t, err)
+	assert.Equal(t, 6, result.SuccessfulTables)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxConcurrent), int32(2))
+	assert.Zero(t, g.Status().ActiveCursors, "모든 커서가 반환되어야 함")
+}
+
+func TestParallelExecutor_Execute_GovernorPaused(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockChunks = []*domain.ChunkResult{
+		{ChunkNumber: 1, RowCount: 100, IsLastChunk: true, TotalRowsSent: 100},
+	}
+	mockRepo.MockLoad = &domain.LoadMetrics{HostCPUPercent: 95}
+
+	g := governor.New(governor.Config{
+		HostCPU: governor.Thresholds{Slow: 70, Pause: 85},
+		Sampler: mockRepo.GetLoadMetrics,
+	})
+	require.NoError(t, g.Sample(context.Background()))
+
+	broadcaster := sse.NewBroadcaster()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go broadcaster.Run(ctx)
+	client := broadcaster.Register("TRP-001")
+	time.Sleep(10 * time.Millisecond)
+
+	executor := NewParallelExecutor(mockRepo, nil, broadcaster, 1)
+	executor.SetGovernor(g)
+
+	plan := ExecutionPlan{TransportID: "TRP-001", JobID: "JOB-001", JobVersion: "v001", Tables: []string{"VBRP"}, Owner: "SAPSR3"}
+
+	done := make(chan *ExecutionResult, 1)
+	go func() {
+		result, _ := executor.Execute(ctx, plan)
+		done <- result
+	}()
+
+	// paused 상태에서는 커서를 열지 않고 진행률 이벤트로 상태를 알림
+	progress := waitProgressEvent(t, client)
+	require.NotNil(t, progress.Throttle)
+	assert.Equal(t, "paused", progress.Throttle.State)
+	assert.Equal(t, 95.0, progress.Throttle.HostCPUPercent)
+	assert.Contains(t, progress.Throttle.Reason, "CPU")
+	select {
+	case <-done:
+		t.Fatal("paused 상태에서 추출이 완료됨")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.False(t, mockRepo.StreamCalled)
+
+	// 부하가 내려가면 추출 재개
+	mockRepo.SetLoad(&domain.LoadMetrics{HostCPUPercent: 20})
+	require.NoError(t, g.Sample(context.Background()))
+
+	select {
+	case result := <-done:
+		require.NotNil(t, result)
+		assert.Equal(t, 1, result.SuccessfulTables)
+		assert.Equal(t, int64(100), result.TotalRows)
+	case <-time.After(2 * time.Second):
+		t.Fatal("부하가 내려간 뒤에도 추출이 재개되지 않음")
+	}
+
+	progress = waitProgressEvent(t, client)
+	require.NotNil(t, progress.Throttle)
+	assert.Equal(t, "normal", progress.Throttle.State)
+	assert.Equal(t, int64(100), progress.RowsProcessed)
+}
+
+// waitProgressEvent는 SSE 클라이언트에서 다음 progress 이벤트를 기다립니다
+func waitProgressEvent(t *testing.T, client *sse.Client) sse.ProgressEvent {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-client.Events:
+			if progress, ok := event.Data.(sse.ProgressEvent); ok {
+				return progress
+			}
+		case <-timeout:
+			t.Fatal("progress 이벤트를 수신하지 못했습니다")
+		}
+	}
+}
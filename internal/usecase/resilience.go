@@ -0,0 +1,201 @@
+// Package usecase는 비즈니스 로직을 구현합니다
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/adapter/sse"
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/resilience"
+)
+
+// 의존성 이름 (Circuit Breaker, SSE circuit 이벤트, /api/health 응답에 사용)
+const (
+	dependencyOracle = "oracle"
+	dependencyGCS    = "gcs"
+)
+
+// ResilienceConfig는 ParallelExecutor의 재시도 및 Circuit Breaker 설정입니다
+type ResilienceConfig struct {
+	// Retry는 테이블 단위 재시도 설정입니다 (MaxRetries는 첫 시도를 포함한 최대 시도 횟수, 1 이하면 재시도하지 않음)
+	// 일시적 장애(resilience.IsTransient)만 재시도하며 RetryableFunc와 OnRetry는 실행기가 설정합니다
+	Retry resilience.RetryConfig
+	// OracleBreaker는 Oracle 호출(추출, 분할 범위/컬럼 조회)을 보호합니다 (nil이면 차단하지 않음)
+	OracleBreaker *resilience.CircuitBreaker
+	// GCSBreaker는 GCS 호출(업로드, 이전 시도 객체 삭제)을 보호합니다 (nil이면 차단하지 않음)
+	GCSBreaker *resilience.CircuitBreaker
+}
+
+// SetResilience는 재시도 및 Circuit Breaker 설정을 적용합니다
+// Circuit Breaker 상태가 바뀌면 실행 중인 모든 Transport에 SSE circuit 이벤트를 발송합니다
+func (e *ParallelExecutor) SetResilience(cfg ResilienceConfig) {
+	e.resilience = cfg
+	for name, cb := range e.CircuitBreakers() {
+		name := name
+		cb.SetOnStateChange(func(from, to resilience.State) {
+			e.sendCircuitEvent(name, to)
+		})
+	}
+}
+
+// CircuitBreakers는 설정된 의존성별 Circuit Breaker를 반환합니다
+func (e *ParallelExecutor) CircuitBreakers() map[string]*resilience.CircuitBreaker {
+	breakers := make(map[string]*resilience.CircuitBreaker, 2)
+	if e.resilience.OracleBreaker != nil {
+		breakers[dependencyOracle] = e.resilience.OracleBreaker
+	}
+	if e.resilience.GCSBreaker != nil {
+		breakers[dependencyGCS] = e.resilience.GCSBreaker
+	}
+	return breakers
+}
+
+// breaker는 의존성의 Circuit Breaker를 반환합니다 (없으면 nil)
+func (e *ParallelExecutor) breaker(dependency string) *resilience.CircuitBreaker {
+	switch dependency {
+	case dependencyOracle:
+		return e.resilience.OracleBreaker
+	case dependencyGCS:
+		return e.resilience.GCSBreaker
+	default:
+		return nil
+	}
+}
+
+// guard는 의존성 호출을 Circuit Breaker로 보호합니다
+// Open 상태면 fn을 호출하지 않고 resilience.ErrCircuitOpen을 감싼 에러를 반환하며,
+// 취소로 끝난 호출은 의존성 장애로 집계하지 않습니다
+func (e *ParallelExecutor) guard(ctx context.Context, dependency string, fn func() error) error {
+	cb := e.breaker(dependency)
+	if cb == nil {
+		return fn()
+	}
+	if err := cb.Allow(); err != nil {
+		return fmt.Errorf("%s 호출 차단: %w", dependency, err)
+	}
+	err := fn()
+	if ctx.Err() == nil {
+		cb.Record(err)
+	}
+	return err
+}
+
+// withRetry는 일시적 장애로 실패한 테이블 작업을 지수 백오프로 재시도합니다
+// 재시도 전마다 SSE retry 이벤트를 발송합니다
+func (e *ParallelExecutor) withRetry(ctx context.Context, plan ExecutionPlan, tableName string, fn func() error) error {
+	cfg := e.resilience.Retry
+	if cfg.MaxRetries <= 1 {
+		return fn()
+	}
+	cfg.RetryableFunc = resilience.IsTransient
+	cfg.OnRetry = func(attempt int, err error, delay time.Duration) {
+		e.sendRetryEvent(plan, tableName, attempt, cfg.MaxRetries, delay, err)
+	}
+	return resilience.Retry(ctx, cfg, fn)
+}
+
+// extractTableWithRetry는 Circuit Breaker로 보호된 extractTable을 일시적 장애 시 재시도합니다
+// 같은 객체 경로에 다시 업로드하므로 이전 시도의 결과는 덮어써집니다
+func (e *ParallelExecutor) extractTableWithRetry(ctx context.Context, plan ExecutionPlan, tableName string, rng *domain.TableRange, format gcs.FormatOptions, onProgress func(rows, bytes int64)) TableResult {
+	var result TableResult
+	_ = e.withRetry(ctx, plan, tableName, func() error {
+		if err := e.allowExtraction(); err != nil {
+			now := time.Now()
+			result = TableResult{TableName: tableName, StartTime: now, EndTime: now, Error: err}
+			return err
+		}
+		result = e.extractTable(ctx, plan, tableName, rng, format, onProgress)
+		e.recordExtraction(ctx, result.Error)
+		return result.Error
+	})
+	return result
+}
+
+// allowExtraction은 추출에 필요한 의존성(Oracle, 업로드 시 GCS)의 Circuit Breaker 허용 여부를 확인합니다
+func (e *ParallelExecutor) allowExtraction() error {
+	if cb := e.resilience.OracleBreaker; cb != nil {
+		if err := cb.Allow(); err != nil {
+			return fmt.Errorf("%s 호출 차단: %w", dependencyOracle, err)
+		}
+	}
+	if cb := e.resilience.GCSBreaker; cb != nil && e.uploader != nil {
+		if err := cb.Allow(); err != nil {
+			return fmt.Errorf("%s 호출 차단: %w", dependencyGCS, err)
+		}
+	}
+	return nil
+}
+
+// recordExtraction은 추출 결과를 장애가 발생한 의존성의 Circuit Breaker에 기록합니다
+// 업로드 실패는 GCS에, 그 외 추출 실패는 Oracle에 집계하며 성공(또는 대사 불일치)은 양쪽 모두 성공으로 기록합니다
+func (e *ParallelExecutor) recordExtraction(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	oracleCB, gcsCB := e.resilience.OracleBreaker, e.resilience.GCSBreaker
+	if e.uploader == nil {
+		gcsCB = nil
+	}
+
+	var (
+		uploadErr    *UploadError
+		reconcileErr *ReconcileError
+	)
+	switch {
+	case err == nil, errors.As(err, &reconcileErr):
+		if oracleCB != nil {
+			oracleCB.Record(nil)
+		}
+		if gcsCB != nil {
+			gcsCB.Record(nil)
+		}
+	case errors.As(err, &uploadErr):
+		if gcsCB != nil {
+			gcsCB.Record(err)
+		}
+	default:
+		if oracleCB != nil {
+			oracleCB.Record(err)
+		}
+	}
+}
+
+// sendRetryEvent는 테이블 재시도 이벤트를 발송합니다
+func (e *ParallelExecutor) sendRetryEvent(plan ExecutionPlan, tableName string, attempt, maxAttempts int, delay time.Duration, err error) {
+	if e.sse == nil {
+		return
+	}
+	e.sse.BroadcastRetry(*sse.NewRetryEvent(plan.TransportID, plan.JobID, tableName, attempt, maxAttempts, delay, err.Error()))
+}
+
+// sendCircuitEvent는 Circuit Breaker 상태 변경을 실행 중인 모든 Transport에 알립니다
+func (e *ParallelExecutor) sendCircuitEvent(dependency string, state resilience.State) {
+	if e.sse == nil {
+		return
+	}
+
+	var message string
+	switch state {
+	case resilience.StateOpen:
+		message = fmt.Sprintf("%s 일시적 장애가 반복되어 호출을 차단합니다", dependency)
+	case resilience.StateHalfOpen:
+		message = fmt.Sprintf("%s 호출 재개를 시험합니다", dependency)
+	default:
+		message = fmt.Sprintf("%s 호출이 정상화되었습니다", dependency)
+	}
+
+	e.poolsMu.Lock()
+	plans := make([]ExecutionPlan, 0, len(e.pools))
+	for _, plan := range e.pools {
+		plans = append(plans, plan)
+	}
+	e.poolsMu.Unlock()
+
+	for _, plan := range plans {
+		e.sse.BroadcastCircuit(*sse.NewCircuitEvent(plan.TransportID, plan.JobID, dependency, state.String(), message))
+	}
+}
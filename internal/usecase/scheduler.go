@@ -0,0 +1,251 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/pkg/cron"
+)
+
+// OverlapPolicy는 예정 시각에 Transport가 이미 실행 중일 때의 처리 방식입니다
+type OverlapPolicy string
+
+const (
+	// OverlapSkip은 실행 중이면 이번 예정 실행을 건너뜁니다
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapQueue는 실행 중이면 현재 실행이 끝난 뒤 한 번 실행합니다
+	OverlapQueue OverlapPolicy = "queue"
+)
+
+// 스케줄러 기본값
+const (
+	defaultSchedulerTickInterval = 10 * time.Second
+	schedulerListPageSize        = 100
+)
+
+// SchedulerConfig는 Scheduler 설정입니다
+type SchedulerConfig struct {
+	Timezone      string         // 시간대 미지정 스케줄의 기본 시간대 (기본값: Asia/Seoul)
+	OverlapPolicy OverlapPolicy  // 중복 실행 처리 방식 (기본값: skip)
+	TickInterval  time.Duration  // 스케줄 평가 주기 (기본값: 10s)
+	MisfireGrace  time.Duration  // 재시작 시 놓친 예정 실행을 허용하는 범위 (0이면 놓친 실행 무시)
+	Logger        zerolog.Logger // 스케줄러 로거
+}
+
+// scheduleEntry는 Transport별 스케줄 평가 상태입니다
+type scheduleEntry struct {
+	expression string
+	timezone   string
+	schedule   *cron.Schedule
+	location   *time.Location
+	next       time.Time // 다음 실행 예정 시각
+	queued     bool      // 실행 중이어서 대기 중인 실행이 있는지 여부
+}
+
+// Scheduler는 Transport의 cron 스케줄을 평가하여 JobRunner로 Job을 실행합니다
+type Scheduler struct {
+	transportSvc *TransportService
+	runner       *JobRunner
+	config       SchedulerConfig
+
+	entries map[string]*scheduleEntry // Tick에서만 접근 (단일 goroutine)
+}
+
+// NewScheduler는 새로운 Scheduler를 생성합니다
+func NewScheduler(transportSvc *TransportService, runner *JobRunner, cfg SchedulerConfig) (*Scheduler, error) {
+	if cfg.Timezone == "" {
+		cfg.Timezone = domain.DefaultScheduleTimezone
+	}
+	if _, err := time.LoadLocation(cfg.Timezone); err != nil {
+		return nil, fmt.Errorf("스케줄러 시간대 로드 실패: %w", err)
+	}
+
+	switch cfg.OverlapPolicy {
+	case "":
+		cfg.OverlapPolicy = OverlapSkip
+	case OverlapSkip, OverlapQueue:
+	default:
+		return nil, fmt.Errorf("지원하지 않는 overlap 정책: %s", cfg.OverlapPolicy)
+	}
+
+	if cfg.TickInterval <= 0 {
+		cfg.TickInterval = defaultSchedulerTickInterval
+	}
+	if cfg.MisfireGrace < 0 {
+		cfg.MisfireGrace = 0
+	}
+
+	return &Scheduler{
+		transportSvc: transportSvc,
+		runner:       runner,
+		config:       cfg,
+		entries:      make(map[string]*scheduleEntry),
+	}, nil
+}
+
+// Run은 컨텍스트가 취소될 때까지 주기적으로 스케줄을 평가합니다
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.TickInterval)
+	defer ticker.Stop()
+
+	s.config.Logger.Info().
+		Str("timezone", s.config.Timezone).
+		Str("overlap_policy", string(s.config.OverlapPolicy)).
+		Dur("tick_interval", s.config.TickInterval).
+		Msg("스케줄러 시작")
+
+	s.Tick(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			s.config.Logger.Info().Msg("스케줄러 종료")
+			return
+		case now := <-ticker.C:
+			s.Tick(ctx, now)
+		}
+	}
+}
+
+// Tick은 now 기준으로 실행 예정 시각이 지난 Transport를 실행합니다
+// Run에서 주기적으로 호출되며, 동시에 여러 goroutine에서 호출하면 안 됩니다
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) {
+	transports, err := s.listScheduled(ctx)
+	if err != nil {
+		s.config.Logger.Error().Err(err).Msg("스케줄 대상 transport 조회 실패")
+		return
+	}
+
+	seen := make(map[string]bool, len(transports))
+	for i := range transports {
+		transport := &transports[i]
+		seen[transport.ID] = true
+
+		entry, err := s.entryFor(transport, now)
+		if err != nil {
+			s.config.Logger.Warn().Err(err).Str("transport_id", transport.ID).Msg("스케줄 파싱 실패")
+			delete(s.entries, transport.ID)
+			continue
+		}
+
+		s.evaluate(ctx, transport.ID, entry, now)
+	}
+
+	// 삭제되었거나 스케줄이 해제된 Transport 정리
+	for id := range s.entries {
+		if !seen[id] {
+			delete(s.entries, id)
+		}
+	}
+}
+
+// evaluate는 단일 Transport의 예정 실행 여부를 판단하고 실행합니다
+func (s *Scheduler) evaluate(ctx context.Context, transportID string, entry *scheduleEntry, now time.Time) {
+	logger := s.config.Logger.With().Str("transport_id", transportID).Logger()
+
+	due := !entry.next.IsZero() && !now.Before(entry.next)
+	if due {
+		slot := entry.next
+		entry.next = entry.schedule.Next(now.In(entry.location))
+
+		// 실행 전에 예정 시각을 기록하여 재시작 시 중복 실행 방지
+		if err := s.transportSvc.RecordScheduledRun(ctx, transportID, slot); err != nil {
+			logger.Error().Err(err).Time("scheduled_at", slot).Msg("예정 실행 기록 실패, 실행을 건너뜁니다")
+			return
+		}
+		logger = logger.With().Time("scheduled_at", slot).Logger()
+	}
+
+	if !due && !entry.queued {
+		return
+	}
+
+	job, err := s.runner.Trigger(ctx, transportID)
+	switch {
+	case errors.Is(err, ErrTransportNotExecutable):
+		if s.config.OverlapPolicy == OverlapQueue {
+			if !entry.queued {
+				logger.Info().Msg("transport 실행 중, 종료 후 실행 대기")
+			}
+			entry.queued = true
+			return
+		}
+		logger.Warn().Msg("transport 실행 중, 예정 실행 건너뜀")
+	case errors.Is(err, ErrOutsideMaintenanceWindow):
+		logger.Info().Err(err).Msg("유지보수 시간대 밖, 예정 실행 건너뜀")
+	case err != nil:
+		logger.Error().Err(err).Msg("예정 실행 시작 실패")
+	default:
+		logger.Info().Str("job_id", job.ID).Bool("queued", entry.queued).Msg("예정 실행 시작")
+	}
+	entry.queued = false
+}
+
+// entryFor는 Transport의 스케줄 평가 상태를 반환하며, 스케줄이 변경되면 새로 계산합니다
+func (s *Scheduler) entryFor(transport *domain.Transport, now time.Time) (*scheduleEntry, error) {
+	timezone := transport.Schedule.Timezone
+	if timezone == "" {
+		timezone = s.config.Timezone
+	}
+
+	entry, ok := s.entries[transport.ID]
+	if ok && entry.expression == transport.Schedule.Expression && entry.timezone == timezone {
+		return entry, nil
+	}
+
+	schedule := &domain.CronSchedule{Expression: transport.Schedule.Expression, Timezone: timezone}
+	parsed, loc, err := schedule.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	// 기준 시각: 유예 범위 내에서 놓친 실행은 한 번 실행하되,
+	// 생성 시각 및 이미 기록된 예정 시각 이전으로는 돌아가지 않음
+	base := now.Add(-s.config.MisfireGrace)
+	if transport.CreatedAt.After(base) {
+		base = transport.CreatedAt
+	}
+	if last := transport.Schedule.LastFiredAt; last != nil && !last.Before(base) {
+		base = *last
+	}
+
+	entry = &scheduleEntry{
+		expression: transport.Schedule.Expression,
+		timezone:   timezone,
+		schedule:   parsed,
+		location:   loc,
+		next:       parsed.Next(base.In(loc)),
+	}
+	if ok {
+		// 스케줄 변경 시 대기 상태 유지
+		entry.queued = s.entries[transport.ID].queued
+	}
+	s.entries[transport.ID] = entry
+	return entry, nil
+}
+
+// listScheduled는 활성화되어 있고 스케줄이 있는 모든 Transport를 조회합니다
+func (s *Scheduler) listScheduled(ctx context.Context) ([]domain.Transport, error) {
+	var scheduled []domain.Transport
+	for offset := 0; ; offset += schedulerListPageSize {
+		resp, err := s.transportSvc.List(ctx, offset, schedulerListPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range resp.Transports {
+			if t.Enabled && t.Schedule != nil {
+				scheduled = append(scheduled, t)
+			}
+		}
+
+		if offset+len(resp.Transports) >= resp.Total || len(resp.Transports) == 0 {
+			return scheduled, nil
+		}
+	}
+}
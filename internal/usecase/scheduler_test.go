@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/adapter/oracle"
+	"oracle-etl/internal/domain"
+)
+
+// setupScheduledTransport는 매분 실행되는 스케줄의 Transport를 생성합니다
+func setupScheduledTransport(t *testing.T, transportSvc *TransportService) *domain.Transport {
+	t.Helper()
+
+	transport, err := transportSvc.Create(context.Background(), domain.CreateTransportRequest{
+		Name:     "Scheduled",
+		Tables:   []string{"VBRP"},
+		Schedule: &domain.CronSchedule{Expression: "* * * * *", Timezone: "Asia/Seoul"},
+	})
+	require.NoError(t, err)
+	return transport
+}
+
+// jobCount는 Transport의 Job 수를 반환합니다
+func jobCount(t *testing.T, jobSvc *JobService, transportID string) int {
+	t.Helper()
+
+	jobs, err := jobSvc.GetJobsByTransportID(context.Background(), transportID)
+	require.NoError(t, err)
+	return len(jobs)
+}
+
+// TestNewScheduler_InvalidConfig는 잘못된 설정 거부를 테스트합니다
+func TestNewScheduler_InvalidConfig(t *testing.T) {
+	runner, transportSvc, _ := setupJobRunner(t, oracle.NewMockRepository())
+
+	_, err := NewScheduler(transportSvc, runner, SchedulerConfig{Timezone: "Mars/Olympus"})
+	assert.Error(t, err)
+
+	_, err = NewScheduler(transportSvc, runner, SchedulerConfig{OverlapPolicy: "parallel"})
+	assert.Error(t, err)
+
+	s, err := NewScheduler(transportSvc, runner, SchedulerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Seoul", s.config.Timezone)
+	assert.Equal(t, OverlapSkip, s.config.OverlapPolicy)
+}
+
+// TestScheduler_Tick은 예정 시각 도달 시 Job 실행 및 실행 시각 기록을 테스트합니다
+func TestScheduler_Tick(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	transport := setupScheduledTransport(t, transportSvc)
+	ctx := context.Background()
+
+	scheduler, err := NewScheduler(transportSvc, runner, SchedulerConfig{})
+	require.NoError(t, err)
+
+	// 생성 시각 이후 첫 예정 시각 전에는 실행하지 않음
+	scheduler.Tick(ctx, transport.CreatedAt)
+	assert.Equal(t, 0, jobCount(t, jobSvc, transport.ID))
+
+	slot := scheduler.entries[transport.ID].next
+	require.False(t, slot.IsZero())
+
+	scheduler.Tick(ctx, slot.Add(5*time.Second))
+	runner.Wait()
+	assert.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+
+	found, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.Schedule.LastFiredAt)
+	assert.True(t, slot.Equal(*found.Schedule.LastFiredAt))
+
+	// 같은 예정 시각에 대해 다시 실행하지 않음
+	scheduler.Tick(ctx, slot.Add(30*time.Second))
+	runner.Wait()
+	assert.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+}
+
+// TestScheduler_Restart는 재시작 후 중복 실행 방지와 유예 범위 내 놓친 실행을 테스트합니다
+func TestScheduler_Restart(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	transport := setupScheduledTransport(t, transportSvc)
+	ctx := context.Background()
+
+	first, err := NewScheduler(transportSvc, runner, SchedulerConfig{MisfireGrace: time.Minute})
+	require.NoError(t, err)
+	first.Tick(ctx, transport.CreatedAt)
+	slot := first.entries[transport.ID].next
+	first.Tick(ctx, slot)
+	runner.Wait()
+	require.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+
+	// 같은 분 안에 재시작: 이미 기록된 예정 시각은 다시 실행하지 않음
+	restarted, err := NewScheduler(transportSvc, runner, SchedulerConfig{MisfireGrace: time.Minute})
+	require.NoError(t, err)
+	restarted.Tick(ctx, slot.Add(30*time.Second))
+	runner.Wait()
+	assert.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+
+	// 다음 예정 시각을 놓친 뒤 유예 범위 내 재시작: 한 번만 실행
+	restarted, err = NewScheduler(transportSvc, runner, SchedulerConfig{MisfireGrace: time.Minute})
+	require.NoError(t, err)
+	restarted.Tick(ctx, slot.Add(90*time.Second))
+	runner.Wait()
+	assert.Equal(t, 2, jobCount(t, jobSvc, transport.ID))
+
+	restarted.Tick(ctx, slot.Add(100*time.Second))
+	runner.Wait()
+	assert.Equal(t, 2, jobCount(t, jobSvc, transport.ID))
+}
+
+// TestScheduler_OverlapSkip은 실행 중인 Transport의 예정 실행 건너뛰기를 테스트합니다
+func TestScheduler_OverlapSkip(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	transport := setupScheduledTransport(t, transportSvc)
+	ctx := context.Background()
+
+	scheduler, err := NewScheduler(transportSvc, runner, SchedulerConfig{OverlapPolicy: OverlapSkip})
+	require.NoError(t, err)
+	scheduler.Tick(ctx, transport.CreatedAt)
+	slot := scheduler.entries[transport.ID].next
+
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+	scheduler.Tick(ctx, slot)
+	assert.Equal(t, 0, jobCount(t, jobSvc, transport.ID))
+
+	// 실행 종료 후 다음 예정 시각 전에는 실행하지 않음
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusIdle))
+	scheduler.Tick(ctx, slot.Add(10*time.Second))
+	runner.Wait()
+	assert.Equal(t, 0, jobCount(t, jobSvc, transport.ID))
+}
+
+// TestScheduler_OverlapQueue는 실행 중인 Transport의 예정 실행 대기를 테스트합니다
+func TestScheduler_OverlapQueue(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	transport := setupScheduledTransport(t, transportSvc)
+	ctx := context.Background()
+
+	scheduler, err := NewScheduler(transportSvc, runner, SchedulerConfig{OverlapPolicy: OverlapQueue})
+	require.NoError(t, err)
+	scheduler.Tick(ctx, transport.CreatedAt)
+	slot := scheduler.entries[transport.ID].next
+
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusRunning))
+	scheduler.Tick(ctx, slot)
+	assert.Equal(t, 0, jobCount(t, jobSvc, transport.ID))
+	assert.True(t, scheduler.entries[transport.ID].queued)
+
+	// 실행 종료 후 대기 중인 실행을 한 번 수행
+	require.NoError(t, transportSvc.UpdateStatus(ctx, transport.ID, domain.TransportStatusIdle))
+	scheduler.Tick(ctx, slot.Add(10*time.Second))
+	runner.Wait()
+	assert.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+	assert.False(t, scheduler.entries[transport.ID].queued)
+
+	scheduler.Tick(ctx, slot.Add(20*time.Second))
+	runner.Wait()
+	assert.Equal(t, 1, jobCount(t, jobSvc, transport.ID))
+}
+
+// TestScheduler_IgnoresDisabledAndDeleted는 비활성/삭제된 Transport 처리를 테스트합니다
+func TestScheduler_IgnoresDisabledAndDeleted(t *testing.T) {
+	runner, transportSvc, jobSvc := setupJobRunner(t, oracle.NewMockRepository())
+	transport := setupScheduledTransport(t, transportSvc)
+	ctx := context.Background()
+
+	scheduler, err := NewScheduler(transportSvc, runner, SchedulerConfig{})
+	require.NoError(t, err)
+	scheduler.Tick(ctx, transport.CreatedAt)
+	slot := scheduler.entries[transport.ID].next
+
+	// 비활성화된 Transport는 실행하지 않음
+	disabled, err := transportSvc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	disabled.Enabled = false
+	require.NoError(t, transportSvc.Update(ctx, disabled))
+
+	scheduler.Tick(ctx, slot)
+	runner.Wait()
+	assert.Equal(t, 0, jobCount(t, jobSvc, transport.ID))
+	assert.NotContains(t, scheduler.entries, transport.ID)
+
+	// 삭제된 Transport는 평가 대상에서 제거
+	require.NoError(t, transportSvc.Delete(ctx, transport.ID))
+	scheduler.Tick(ctx, slot.Add(time.Minute))
+	assert.Empty(t, scheduler.entries)
+}
@@ -3,6 +3,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,18 +13,67 @@ import (
 	"oracle-etl/internal/repository"
 )
 
+var (
+	// ErrInvalidSource는 추출 원본의 SQL 구문 검증(dry parse)이 실패한 경우 반환됩니다
+	ErrInvalidSource = errors.New("추출 원본 검증 실패")
+	// ErrInvalidColumns는 컬럼 필터가 테이블 컬럼과 맞지 않는 경우 반환됩니다
+	ErrInvalidColumns = errors.New("컬럼 필터 검증 실패")
+	// ErrInvalidPatch는 PATCH 요청 본문을 Transport 정의에 적용할 수 없는 경우 반환됩니다
+	ErrInvalidPatch = errors.New("patch 적용 실패")
+	// ErrTransportModified는 If-Match로 지정한 버전 이후 Transport가 수정된 경우 반환됩니다
+	ErrTransportModified = repository.ErrTransportModified
+	// ErrRevisionNotFound는 조회/롤백 대상 리비전이 없을 때 반환됩니다
+	ErrRevisionNotFound = errors.New("리비전을 찾을 수 없습니다")
+)
+
+// SchemaValidator는 Transport 생성 시 추출 대상을 Oracle 스키마와 대조하여 검증합니다
+type SchemaValidator interface {
+	// ValidateSource는 추출 원본 SQL을 실행하지 않고 구문을 검증합니다
+	ValidateSource(ctx context.Context, owner string, source domain.Source) error
+	// GetTableColumns는 테이블의 컬럼 정보를 반환합니다
+	GetTableColumns(ctx context.Context, owner, tableName string) ([]domain.ColumnInfo, error)
+	// GetSourceColumns는 추출 원본의 출력 컬럼 정보를 반환합니다
+	GetSourceColumns(ctx context.Context, owner string, source domain.Source) ([]domain.ColumnInfo, error)
+}
+
 // TransportService는 Transport 비즈니스 로직을 처리합니다
 type TransportService struct {
-	repo repository.TransportRepository
+	repo             repository.TransportRepository
+	scheduleTimezone string // 시간대 미지정 스케줄에 적용할 기본 시간대
+	schemaValidator  SchemaValidator
+	schemaOwner      string            // 스키마 검증 시 사용할 스키마 소유자
+	maintenance      MaintenanceConfig // 유지보수 시간대 (실행 가능 상태 계산과 시간대 이름 검증)
+	now              func() time.Time
 }
 
 // NewTransportService는 새로운 TransportService를 생성합니다
 func NewTransportService(repo repository.TransportRepository) *TransportService {
 	return &TransportService{
-		repo: repo,
+		repo:             repo,
+		scheduleTimezone: domain.DefaultScheduleTimezone,
+		now:              time.Now,
+	}
+}
+
+// SetScheduleTimezone은 시간대가 지정되지 않은 스케줄에 적용할 기본 시간대를 설정합니다
+func (s *TransportService) SetScheduleTimezone(tz string) {
+	if tz != "" {
+		s.scheduleTimezone = tz
 	}
 }
 
+// SetSchemaValidator는 Transport 생성 시 추출 원본과 컬럼 필터를 검증할 validator를 설정합니다
+// 설정하지 않으면 원본 정의와 컬럼 패턴의 형식만 검사합니다
+func (s *TransportService) SetSchemaValidator(validator SchemaValidator, owner string) {
+	s.schemaValidator = validator
+	s.schemaOwner = owner
+}
+
+// SetMaintenance는 Transport 조회 시 실행 가능 상태를 계산하고 생성/수정 시 시간대 이름을 검증할 유지보수 시간대를 설정합니다
+func (s *TransportService) SetMaintenance(cfg MaintenanceConfig) {
+	s.maintenance = cfg
+}
+
 // Create는 새로운 Transport를 생성합니다
 func (s *TransportService) Create(ctx context.Context, req domain.CreateTransportRequest) (*domain.Transport, error) {
 	// 유효성 검사
@@ -33,19 +85,122 @@ func (s *TransportService) Create(ctx context.Context, req domain.CreateTranspor
 	id := domain.GenerateTransportID(uuid.New().String())
 
 	// Transport 엔티티 생성
-	transport := domain.NewTransport(id, req.Name, req.Description, req.Tables)
+	transport := domain.NewTransport(id, req.Name, req.Description, req.SourceNames())
+	transport.ConsistentSnapshot = req.ConsistentSnapshot
+	transport.OutputFormat = req.OutputFormat
+	transport.ReconcilePolicy = req.ReconcilePolicy
+	transport.Maintenance = req.Maintenance.Clone()
+	if len(req.TableOptions) > 0 {
+		transport.TableOptions = make(map[string]domain.TableOptions, len(req.TableOptions))
+		for table, opts := range req.TableOptions {
+			transport.TableOptions[table] = opts.Clone()
+		}
+	}
+	if len(req.Sources) > 0 {
+		transport.Sources = make([]domain.Source, len(req.Sources))
+		for i, src := range req.Sources {
+			transport.Sources[i] = src.Clone()
+		}
+	}
+	if req.Schedule != nil {
+		transport.Schedule = &domain.CronSchedule{
+			Expression: req.Schedule.Expression,
+			Timezone:   req.Schedule.Timezone,
+		}
+		if transport.Schedule.Timezone == "" {
+			transport.Schedule.Timezone = s.scheduleTimezone
+		}
+		if err := transport.Schedule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.maintenance.validate(transport); err != nil {
+		return nil, err
+	}
+	if err := validateReconcilePolicy(transport); err != nil {
+		return nil, err
+	}
+	if err := s.validateSources(ctx, transport.Sources); err != nil {
+		return nil, err
+	}
+	if err := s.validateColumns(ctx, transport); err != nil {
+		return nil, err
+	}
 
 	// 저장
 	if err := s.repo.Create(ctx, transport); err != nil {
 		return nil, err
 	}
 
+	s.refreshSchedule(transport)
 	return transport, nil
 }
 
+// validateReconcilePolicy는 fail 대사 정책이 일관된 스냅샷과 함께 설정되었는지 검사합니다
+// 대사 COUNT(*)는 객체 업로드가 끝난 뒤 조회되므로, 같은 SCN으로 조회하지 않으면 그 사이의 변경만으로 Job이 실패할 수 있습니다
+func validateReconcilePolicy(transport *domain.Transport) error {
+	if transport.ReconcilePolicy == domain.ReconcilePolicyFail && !transport.ConsistentSnapshot {
+		return fmt.Errorf("reconcile_policy가 fail이면 consistent_snapshot이 필요합니다 (스냅샷 없이 조회한 row 수는 추출 이후 변경을 포함함)")
+	}
+	return nil
+}
+
+// validateSources는 추출 원본 SQL을 Oracle에서 구문 검증합니다
+func (s *TransportService) validateSources(ctx context.Context, sources []domain.Source) error {
+	if s.schemaValidator == nil {
+		return nil
+	}
+	for _, src := range sources {
+		if err := s.schemaValidator.ValidateSource(ctx, s.schemaOwner, src); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidSource, src.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateColumns는 테이블별 컬럼 필터, LOB 정책, watermark 컬럼을 테이블(또는 추출 원본)의 컬럼 정보와 대조합니다
+func (s *TransportService) validateColumns(ctx context.Context, transport *domain.Transport) error {
+	if s.schemaValidator == nil {
+		return nil
+	}
+	sources := transport.SourceMap()
+	for table, opts := range transport.TableOptions {
+		if opts.ColumnFilter.Empty() && len(opts.LOBColumns) == 0 && opts.WatermarkColumn == "" {
+			continue
+		}
+
+		var (
+			columns []domain.ColumnInfo
+			err     error
+		)
+		if src, ok := sources[table]; ok {
+			columns, err = s.schemaValidator.GetSourceColumns(ctx, s.schemaOwner, src)
+		} else {
+			columns, err = s.schemaValidator.GetTableColumns(ctx, s.schemaOwner, table)
+		}
+		if err != nil {
+			return fmt.Errorf("테이블 %s 컬럼 정보 조회 실패: %w", table, err)
+		}
+		if len(columns) == 0 {
+			return fmt.Errorf("%w: %s: 테이블 %s.%s을(를) 찾을 수 없습니다", ErrInvalidColumns, table, s.schemaOwner, table)
+		}
+		if err := opts.ValidateColumns(columns); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidColumns, table, err)
+		}
+	}
+	return nil
+}
+
 // GetByID는 ID로 Transport를 조회합니다
 func (s *TransportService) GetByID(ctx context.Context, id string) (*domain.Transport, error) {
-	return s.repo.GetByID(ctx, id)
+	transport, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.refreshSchedule(transport)
+	return transport, nil
 }
 
 // List는 Transport 목록을 조회합니다
@@ -61,6 +216,9 @@ func (s *TransportService) List(ctx context.Context, offset, limit int) (*domain
 	if err != nil {
 		return nil, err
 	}
+	for i := range transports {
+		s.refreshSchedule(&transports[i])
+	}
 
 	return &domain.TransportListResponse{
 		Transports: transports,
@@ -70,6 +228,149 @@ func (s *TransportService) List(ctx context.Context, offset, limit int) (*domain
 	}, nil
 }
 
+// Replace는 Transport 정의를 요청 내용으로 교체하고 새 리비전을 기록합니다
+// expectedRevision이 0이 아니면 그 사이 정의가 수정되었을 때 ErrTransportModified를 반환합니다
+// (Job 실행에 따른 상태 변경은 정의 수정이 아니므로 검사하지 않음)
+// 정의가 바뀌지 않으면 리비전을 만들지 않고 현재 Transport를 반환합니다
+func (s *TransportService) Replace(ctx context.Context, id string, req domain.UpdateTransportRequest, expectedRevision int) (*domain.Transport, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+
+	def := req.Definition(current.Enabled)
+	if def.Schedule != nil && def.Schedule.Timezone == "" {
+		schedule := *def.Schedule
+		schedule.Timezone = s.scheduleTimezone
+		def.Schedule = &schedule
+	}
+	return s.applyDefinition(ctx, current, def, domain.RevisionActionUpdate, 0, expectedRevision)
+}
+
+// Patch는 JSON Merge Patch(RFC 7386)를 현재 정의에 적용하여 Transport를 수정합니다
+func (s *TransportService) Patch(ctx context.Context, id string, patch []byte, expectedRevision int) (*domain.Transport, error) {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+
+	req, err := current.Definition().MergePatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	// patch 대상 정의와 저장 시점의 정의가 같아야 하므로 조회한 리비전을 조건으로 사용
+	if expectedRevision == 0 {
+		expectedRevision = current.Revision
+	}
+	return s.Replace(ctx, id, req, expectedRevision)
+}
+
+// Rollback은 지정한 리비전의 정의를 새 리비전으로 복원합니다
+func (s *TransportService) Rollback(ctx context.Context, id string, revision int, expectedRevision int) (*domain.Transport, error) {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+	target, err := s.repo.GetRevision(ctx, id, revision)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRevisionNotFound, err)
+	}
+	return s.applyDefinition(ctx, current, target.Definition, domain.RevisionActionRollback, revision, expectedRevision)
+}
+
+// applyDefinition은 정의를 검증한 뒤 변경이 있으면 새 리비전으로 저장합니다
+func (s *TransportService) applyDefinition(ctx context.Context, current *domain.Transport, def domain.TransportDefinition, action domain.RevisionAction, sourceRevision int, expectedRevision int) (*domain.Transport, error) {
+	if expectedRevision != 0 && current.Revision != expectedRevision {
+		return nil, ErrTransportModified
+	}
+
+	updated := current.Clone()
+	updated.ApplyDefinition(def)
+	if updated.Schedule != nil {
+		if err := updated.Schedule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.maintenance.validate(updated); err != nil {
+		return nil, err
+	}
+	if err := validateReconcilePolicy(updated); err != nil {
+		return nil, err
+	}
+	if err := s.validateSources(ctx, updated.Sources); err != nil {
+		return nil, err
+	}
+	if err := s.validateColumns(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	if len(domain.DiffDefinitions(current.Definition(), updated.Definition())) == 0 {
+		s.refreshSchedule(current)
+		return current, nil
+	}
+
+	revision := domain.NewTransportRevision(updated, action)
+	revision.SourceRevision = sourceRevision
+	transport, err := s.repo.ApplyRevision(ctx, revision, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.refreshSchedule(transport)
+	return transport, nil
+}
+
+// ListRevisions는 Transport의 리비전 이력을 최신순으로 조회합니다
+func (s *TransportService) ListRevisions(ctx context.Context, id string) (*domain.TransportRevisionListResponse, error) {
+	transport, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TransportRevisionListResponse{
+		TransportID:     id,
+		CurrentRevision: transport.Revision,
+		Revisions:       revisions,
+	}, nil
+}
+
+// GetRevision은 Transport의 특정 리비전을 조회합니다
+func (s *TransportService) GetRevision(ctx context.Context, id string, revision int) (*domain.TransportRevision, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTransportNotFound, err)
+	}
+	rev, err := s.repo.GetRevision(ctx, id, revision)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRevisionNotFound, err)
+	}
+	return rev, nil
+}
+
+// DiffRevisions는 from 리비전에서 to 리비전으로 바뀐 정의 필드를 반환합니다
+func (s *TransportService) DiffRevisions(ctx context.Context, id string, from, to int) (*domain.TransportRevisionDiff, error) {
+	fromRev, err := s.GetRevision(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.repo.GetRevision(ctx, id, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRevisionNotFound, err)
+	}
+	return &domain.TransportRevisionDiff{
+		TransportID:  id,
+		FromRevision: from,
+		ToRevision:   to,
+		Changes:      domain.DiffDefinitions(fromRev.Definition, toRev.Definition),
+	}, nil
+}
+
 // Delete는 Transport를 삭제합니다
 func (s *TransportService) Delete(ctx context.Context, id string) error {
 	return s.repo.Delete(ctx, id)
@@ -84,3 +385,17 @@ func (s *TransportService) UpdateStatus(ctx context.Context, id string, status d
 func (s *TransportService) Update(ctx context.Context, transport *domain.Transport) error {
 	return s.repo.Update(ctx, transport)
 }
+
+// RecordScheduledRun은 스케줄러가 실행한 예정 시각을 기록합니다
+func (s *TransportService) RecordScheduledRun(ctx context.Context, id string, firedAt time.Time) error {
+	return s.repo.RecordScheduledRun(ctx, id, firedAt)
+}
+
+// refreshSchedule은 응답용 다음/직전 실행 예정 시각과 유지보수 시간대 기준 실행 가능 상태를 계산합니다
+func (s *TransportService) refreshSchedule(transport *domain.Transport) {
+	now := s.now()
+	if transport.Schedule != nil {
+		transport.Schedule.RefreshRunTimes(now)
+	}
+	transport.ExecutionWindow = s.maintenance.executionWindow(transport, now)
+}
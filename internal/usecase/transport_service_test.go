@@ -2,11 +2,14 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"oracle-etl/internal/adapter/oracle"
 	"oracle-etl/internal/domain"
 	"oracle-etl/internal/repository/memory"
 )
@@ -33,6 +36,240 @@ func TestTransportService_Create(t *testing.T) {
 	assert.Equal(t, domain.TransportStatusIdle, transport.Status)
 }
 
+// TestTransportService_CreateWithOutputFormat은 출력 형식 설정을 테스트합니다
+func TestTransportService_CreateWithOutputFormat(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+
+	transport, err := svc.Create(context.Background(), domain.CreateTransportRequest{
+		Name:         "Parquet Transport",
+		Tables:       []string{"VBRP"},
+		OutputFormat: domain.OutputFormatParquet,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.OutputFormatParquet, transport.OutputFormat)
+}
+
+// TestTransportService_CreateWithSources는 추출 원본 정의와 구문 검증(dry parse)을 테스트합니다
+func TestTransportService_CreateWithSources(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.SourceErrors = map[string]error{
+		"BAD_JOIN": errors.New("ORA-00904: \"X\".\"ORG_ID\": invalid identifier"),
+	}
+	repo := memory.NewTransportRepository()
+	svc := NewTransportService(repo)
+	svc.SetSchemaValidator(mockRepo, "APPS")
+	ctx := context.Background()
+
+	transport, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "EBS Transport",
+		Tables: []string{"FND_USER"},
+		Sources: []domain.Source{
+			{Name: "OPEN_INVOICES", Table: "AP_INVOICES_ALL", Columns: []string{"INVOICE_ID", "ORG_ID"}, Where: "org_id = :org_id", Binds: map[string]interface{}{"org_id": float64(204)}},
+			{Name: "INVOICE_LINES", SQL: "SELECT l.* FROM ap_invoice_lines_all l JOIN ap_invoices_all i ON i.invoice_id = l.invoice_id"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FND_USER", "OPEN_INVOICES", "INVOICE_LINES"}, transport.Tables)
+	require.Len(t, transport.Sources, 2)
+	assert.Equal(t, domain.SourceKindTable, transport.Sources[0].Kind())
+	assert.Equal(t, domain.SourceKindQuery, transport.Sources[1].Kind())
+
+	// 구문 검증 실패 시 저장하지 않음
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:    "Bad Transport",
+		Sources: []domain.Source{{Name: "BAD_JOIN", SQL: "SELECT x.org_id FROM ap_invoices_all"}},
+	})
+	require.ErrorIs(t, err, ErrInvalidSource)
+	assert.Contains(t, err.Error(), "ORA-00904")
+
+	// 단일 SELECT 문이 아니면 Oracle 검증 전에 거부
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:    "DDL Transport",
+		Sources: []domain.Source{{Name: "DROP_IT", SQL: "DROP TABLE ap_invoices_all"}},
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidSource)
+
+	_, total, err := repo.List(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
+
+// TestTransportService_CreateWithSources_WhereCondition은 table 원본의 WHERE 조건이 괄호 밖으로 벗어나지 않는지 검사하는지 테스트합니다
+func TestTransportService_CreateWithSources_WhereCondition(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.SourceErrors = map[string]error{
+		"BAD_WHERE": errors.New("ORA-00920: invalid relational operator"),
+	}
+	svc := NewTransportService(memory.NewTransportRepository())
+	svc.SetSchemaValidator(mockRepo, "APPS")
+	ctx := context.Background()
+
+	create := func(name, where string) error {
+		_, err := svc.Create(ctx, domain.CreateTransportRequest{
+			Name:    "Where Transport",
+			Sources: []domain.Source{{Name: name, Table: "AP_INVOICES_ALL", Where: where}},
+		})
+		return err
+	}
+
+	// 문자열 리터럴 안의 괄호와 이스케이프된 따옴표는 허용
+	require.NoError(t, create("LITERALS", "status IN ('A)', 'B''(') AND (org_id = 204 OR org_id IS NULL)"))
+
+	invalid := []struct {
+		name    string
+		where   string
+		message string
+	}{
+		{"괄호를 닫고 다른 조회를 연결", "1 = 1) UNION ALL SELECT username FROM dba_users WHERE (1 = 1", "닫는 괄호"},
+		{"닫히지 않은 괄호", "(org_id = 204", "닫히지 않은 괄호"},
+		{"행 주석", "org_id = 204 --", "주석"},
+		{"블록 주석", "org_id = 204 /* x */", "주석"},
+		{"대체 인용 문자열", "status = q'[)]'", "대체 인용"},
+		{"닫히지 않은 인용 부호", "status = 'A", "인용 부호"},
+		{"세미콜론", "org_id = 204; DROP TABLE x", "세미콜론"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			err := create("INJECTED", tt.where)
+			require.Error(t, err)
+			assert.NotErrorIs(t, err, ErrInvalidSource, "Oracle 검증 전에 거부해야 함")
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+
+	// 조건 구문 오류는 Oracle 검증에서 거부
+	err := create("BAD_WHERE", "org_id 204")
+	require.ErrorIs(t, err, ErrInvalidSource)
+	assert.Contains(t, err.Error(), "ORA-00920")
+}
+
+// TestTransportService_CreateWithColumnFilter는 컬럼 필터를 테이블 컬럼 정보와 대조하는지 테스트합니다
+func TestTransportService_CreateWithColumnFilter(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "INVOICE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "ATTRIBUTE1", DataType: "VARCHAR2", Nullable: true, Position: 2},
+		{Name: "GLOBAL_ATTRIBUTE1", DataType: "VARCHAR2", Nullable: true, Position: 3},
+		{Name: "LAST_UPDATE_DATE", DataType: "DATE", Position: 4},
+	}
+	svc := NewTransportService(memory.NewTransportRepository())
+	svc.SetSchemaValidator(mockRepo, "AP")
+	ctx := context.Background()
+
+	transport, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "AP Transport",
+		Tables: []string{"AP_INVOICES_ALL"},
+		TableOptions: map[string]domain.TableOptions{
+			"AP_INVOICES_ALL": {
+				WatermarkColumn: "LAST_UPDATE_DATE",
+				ColumnFilter:    domain.ColumnFilter{ExcludeColumns: []string{"ATTRIBUTE*", "GLOBAL_ATTRIBUTE*"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]domain.ColumnFilter{
+		"AP_INVOICES_ALL": {ExcludeColumns: []string{"ATTRIBUTE*", "GLOBAL_ATTRIBUTE*"}},
+	}, transport.ColumnFilters())
+
+	invalid := []struct {
+		name    string
+		options domain.TableOptions
+		message string
+	}{
+		{"일치하는 컬럼이 없는 패턴", domain.TableOptions{ColumnFilter: domain.ColumnFilter{ExcludeColumns: []string{"BANK_ACCOUNT*"}}}, "BANK_ACCOUNT*"},
+		{"모든 컬럼 제외", domain.TableOptions{ColumnFilter: domain.ColumnFilter{ExcludeColumns: []string{"*"}}}, "추출할 컬럼이 없습니다"},
+		{"watermark 컬럼 제외", domain.TableOptions{WatermarkColumn: "LAST_UPDATE_DATE", ColumnFilter: domain.ColumnFilter{IncludeColumns: []string{"INVOICE_ID"}}}, "watermark_column"},
+		{"watermark 컬럼 타입", domain.TableOptions{WatermarkColumn: "INVOICE_ID"}, "DATE/TIMESTAMP"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(ctx, domain.CreateTransportRequest{
+				Name:         "Bad Transport",
+				Tables:       []string{"AP_INVOICES_ALL"},
+				TableOptions: map[string]domain.TableOptions{"AP_INVOICES_ALL": tt.options},
+			})
+			require.ErrorIs(t, err, ErrInvalidColumns)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+
+	// 잘못된 패턴 형식은 Oracle 조회 전에 거부
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Bad Transport",
+		Tables: []string{"AP_INVOICES_ALL"},
+		TableOptions: map[string]domain.TableOptions{
+			"AP_INVOICES_ALL": {ColumnFilter: domain.ColumnFilter{IncludeColumns: []string{"INVOICE_ID) FROM DUAL"}}},
+		},
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidColumns)
+}
+
+// TestTransportService_CreateWithLOBColumns는 LOB 정책을 테이블 컬럼 타입과 대조하는지 테스트합니다
+func TestTransportService_CreateWithLOBColumns(t *testing.T) {
+	mockRepo := oracle.NewMockRepository()
+	mockRepo.MockColumns = []domain.ColumnInfo{
+		{Name: "FILE_ID", DataType: "NUMBER", Position: 1},
+		{Name: "FILE_NAME", DataType: "VARCHAR2", Nullable: true, Position: 2},
+		{Name: "FILE_DATA", DataType: "BLOB", Nullable: true, Position: 3},
+		{Name: "FILE_TEXT", DataType: "CLOB", Nullable: true, Position: 4},
+	}
+	svc := NewTransportService(memory.NewTransportRepository())
+	svc.SetSchemaValidator(mockRepo, "APPLSYS")
+	ctx := context.Background()
+
+	transport, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "LOB Transport",
+		Tables: []string{"FND_LOBS"},
+		TableOptions: map[string]domain.TableOptions{
+			"FND_LOBS": {LOBColumns: domain.LOBPolicies{"FILE_DATA": domain.LOBPolicyObject, "file_text": domain.LOBPolicySkip}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]domain.LOBPolicies{
+		"FND_LOBS": {"FILE_DATA": domain.LOBPolicyObject, "file_text": domain.LOBPolicySkip},
+	}, transport.LOBPolicies())
+
+	invalid := []struct {
+		name    string
+		options domain.TableOptions
+		message string
+	}{
+		{"없는 컬럼", domain.TableOptions{LOBColumns: domain.LOBPolicies{"FILE_BODY": domain.LOBPolicySkip}}, "FILE_BODY"},
+		{"LOB이 아닌 컬럼", domain.TableOptions{LOBColumns: domain.LOBPolicies{"FILE_NAME": domain.LOBPolicySkip}}, "LOB 컬럼이 아닙니다"},
+		{"BLOB에 text 정책", domain.TableOptions{LOBColumns: domain.LOBPolicies{"FILE_DATA": domain.LOBPolicyText}}, "text 정책"},
+		{"CLOB에 base64 정책", domain.TableOptions{LOBColumns: domain.LOBPolicies{"FILE_TEXT": domain.LOBPolicyBase64}}, "base64 정책"},
+		{"필터로 제외된 컬럼", domain.TableOptions{
+			ColumnFilter: domain.ColumnFilter{ExcludeColumns: []string{"FILE_DATA"}},
+			LOBColumns:   domain.LOBPolicies{"FILE_DATA": domain.LOBPolicyObject},
+		}, "FILE_DATA"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(ctx, domain.CreateTransportRequest{
+				Name:         "Bad Transport",
+				Tables:       []string{"FND_LOBS"},
+				TableOptions: map[string]domain.TableOptions{"FND_LOBS": tt.options},
+			})
+			require.ErrorIs(t, err, ErrInvalidColumns)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+
+	// 지원하지 않는 정책 값은 Oracle 조회 전에 거부
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:   "Bad Transport",
+		Tables: []string{"FND_LOBS"},
+		TableOptions: map[string]domain.TableOptions{
+			"FND_LOBS": {LOBColumns: domain.LOBPolicies{"FILE_DATA": "inline"}},
+		},
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidColumns)
+	assert.Contains(t, err.Error(), "지원하지 않는 LOB 정책")
+}
+
 // TestTransportService_CreateValidation은 유효성 검사를 테스트합니다
 func TestTransportService_CreateValidation(t *testing.T) {
 	repo := memory.NewTransportRepository()
@@ -52,6 +289,45 @@ func TestTransportService_CreateValidation(t *testing.T) {
 		Tables: []string{},
 	})
 	assert.Error(t, err)
+
+	// 지원하지 않는 출력 형식
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:         "Test",
+		Tables:       []string{"TABLE1"},
+		OutputFormat: "csv",
+	})
+	assert.Error(t, err)
+}
+
+// TestTransportService_ReconcilePolicyFail은 fail 대사 정책에 일관된 스냅샷이 필요한지 테스트합니다
+func TestTransportService_ReconcilePolicyFail(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:            "Billing",
+		Tables:          []string{"VBRK"},
+		ReconcilePolicy: domain.ReconcilePolicyFail,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consistent_snapshot")
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:               "Billing",
+		Tables:             []string{"VBRK"},
+		ConsistentSnapshot: true,
+		ReconcilePolicy:    domain.ReconcilePolicyFail,
+	})
+	require.NoError(t, err)
+
+	// 수정으로 스냅샷을 끄는 것도 거부
+	_, err = svc.Patch(ctx, created.ID, []byte(`{"consistent_snapshot":false}`), 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consistent_snapshot")
+
+	updated, err := svc.Patch(ctx, created.ID, []byte(`{"consistent_snapshot":false,"reconcile_policy":"warn"}`), 0)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReconcilePolicyWarn, updated.ReconcilePolicy)
 }
 
 // TestTransportService_GetByID는 ID로 Transport 조회를 테스트합니다
@@ -149,3 +425,211 @@ func TestTransportService_CanExecute(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, found.CanExecute())
 }
+
+// TestTransportService_CreateWithSchedule은 스케줄 포함 Transport 생성을 테스트합니다
+func TestTransportService_CreateWithSchedule(t *testing.T) {
+	repo := memory.NewTransportRepository()
+	svc := NewTransportService(repo)
+	svc.now = func() time.Time { return time.Date(2024, 1, 15, 1, 30, 0, 0, time.UTC) } // KST 10:30
+	ctx := context.Background()
+
+	transport, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:     "Nightly",
+		Tables:   []string{"VBRP"},
+		Schedule: &domain.CronSchedule{Expression: "0 2 * * *"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, transport.Schedule)
+
+	// 시간대 미지정 시 기본 시간대 적용
+	assert.Equal(t, "Asia/Seoul", transport.Schedule.Timezone)
+	require.NotNil(t, transport.Schedule.NextRunAt)
+	require.NotNil(t, transport.Schedule.PrevRunAt)
+	assert.Equal(t, time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC), transport.Schedule.NextRunAt.UTC())
+	assert.Equal(t, time.Date(2024, 1, 14, 17, 0, 0, 0, time.UTC), transport.Schedule.PrevRunAt.UTC())
+
+	// 조회 시에도 계산됨
+	found, err := svc.GetByID(ctx, transport.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.Schedule.NextRunAt)
+
+	list, err := svc.List(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, list.Transports, 1)
+	require.NotNil(t, list.Transports[0].Schedule.NextRunAt)
+}
+
+// TestTransportService_CreateWithInvalidSchedule은 잘못된 스케줄 거부를 테스트합니다
+func TestTransportService_CreateWithInvalidSchedule(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:     "Bad",
+		Tables:   []string{"VBRP"},
+		Schedule: &domain.CronSchedule{Expression: "0 25 * * *"},
+	})
+	assert.Error(t, err)
+
+	_, err = svc.Create(ctx, domain.CreateTransportRequest{
+		Name:     "Bad",
+		Tables:   []string{"VBRP"},
+		Schedule: &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "Mars/Olympus"},
+	})
+	assert.Error(t, err)
+}
+
+// TestTransportService_Replace는 Transport 정의 교체와 리비전 기록을 테스트합니다
+func TestTransportService_Replace(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{Name: "Billing", Tables: []string{"VBRK"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Revision)
+
+	disabled := false
+	updated, err := svc.Replace(ctx, created.ID, domain.UpdateTransportRequest{
+		CreateTransportRequest: domain.CreateTransportRequest{
+			Name:     "Billing v2",
+			Tables:   []string{"VBRK", "VBRP"},
+			Schedule: &domain.CronSchedule{Expression: "0 2 * * *"},
+		},
+		Enabled: &disabled,
+	}, created.Revision)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, "Billing v2", updated.Name)
+	assert.Equal(t, []string{"VBRK", "VBRP"}, updated.Tables)
+	assert.False(t, updated.Enabled)
+	assert.Equal(t, domain.DefaultScheduleTimezone, updated.Schedule.Timezone)
+	assert.NotNil(t, updated.Schedule.NextRunAt)
+	assert.Equal(t, domain.TransportStatusIdle, updated.Status)
+
+	// 같은 정의로 다시 교체하면 리비전을 만들지 않음
+	same, err := svc.Replace(ctx, created.ID, domain.UpdateTransportRequest{
+		CreateTransportRequest: domain.CreateTransportRequest{
+			Name:     "Billing v2",
+			Tables:   []string{"VBRK", "VBRP"},
+			Schedule: &domain.CronSchedule{Expression: "0 2 * * *"},
+		},
+	}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, same.Revision)
+	assert.Equal(t, updated.UpdatedAt, same.UpdatedAt)
+
+	resp, err := svc.ListRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.CurrentRevision)
+	require.Len(t, resp.Revisions, 2)
+	assert.Equal(t, domain.RevisionActionUpdate, resp.Revisions[0].Action)
+	assert.Equal(t, domain.RevisionActionCreate, resp.Revisions[1].Action)
+}
+
+// TestTransportService_ReplaceConflict는 If-Match 불일치와 검증 실패를 테스트합니다
+func TestTransportService_ReplaceConflict(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{Name: "Billing", Tables: []string{"VBRK"}})
+	require.NoError(t, err)
+
+	req := domain.UpdateTransportRequest{CreateTransportRequest: domain.CreateTransportRequest{Name: "Billing v2", Tables: []string{"VBRP"}}}
+	_, err = svc.Replace(ctx, created.ID, req, created.Revision+1)
+	assert.ErrorIs(t, err, ErrTransportModified)
+
+	_, err = svc.Replace(ctx, created.ID, domain.UpdateTransportRequest{CreateTransportRequest: domain.CreateTransportRequest{Name: "Billing"}}, 0)
+	assert.Error(t, err)
+
+	_, err = svc.Replace(ctx, "TRP-MISSING", req, 0)
+	assert.ErrorIs(t, err, ErrTransportNotFound)
+
+	current, err := svc.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, current.Revision)
+	assert.Equal(t, []string{"VBRK"}, current.Tables)
+}
+
+// TestTransportService_Patch는 JSON Merge Patch 적용을 테스트합니다
+func TestTransportService_Patch(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{
+		Name:     "Billing",
+		Tables:   []string{"VBRK"},
+		Schedule: &domain.CronSchedule{Expression: "0 2 * * *", Timezone: "UTC"},
+	})
+	require.NoError(t, err)
+
+	updated, err := svc.Patch(ctx, created.ID, []byte(`{"description":"야간 적재","schedule":null,"enabled":false}`), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, "Billing", updated.Name)
+	assert.Equal(t, "야간 적재", updated.Description)
+	assert.Equal(t, []string{"VBRK"}, updated.Tables)
+	assert.Nil(t, updated.Schedule)
+	assert.False(t, updated.Enabled)
+
+	_, err = svc.Patch(ctx, created.ID, []byte(`{"unknown_field":1}`), 0)
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = svc.Patch(ctx, created.ID, []byte(`[1,2]`), 0)
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = svc.Patch(ctx, created.ID, []byte(`{"name":"Billing v3"}`), created.Revision)
+	assert.ErrorIs(t, err, ErrTransportModified)
+}
+
+// TestTransportService_PatchAfterStatusChange는 Job 실행에 따른 상태 변경이 정의 수정 조건에 영향을 주지 않는지 테스트합니다
+func TestTransportService_PatchAfterStatusChange(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{Name: "Billing", Tables: []string{"VBRK"}})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	require.NoError(t, svc.UpdateStatus(ctx, created.ID, domain.TransportStatusRunning))
+
+	updated, err := svc.Patch(ctx, created.ID, []byte(`{"description":"야간 적재"}`), created.Revision)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Revision)
+	assert.Equal(t, domain.TransportStatusRunning, updated.Status)
+}
+
+// TestTransportService_RollbackAndDiff는 리비전 비교와 롤백을 테스트합니다
+func TestTransportService_RollbackAndDiff(t *testing.T) {
+	svc := NewTransportService(memory.NewTransportRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, domain.CreateTransportRequest{Name: "Billing", Tables: []string{"VBRK"}})
+	require.NoError(t, err)
+	_, err = svc.Patch(ctx, created.ID, []byte(`{"tables":["VBRK","VBRP"],"output_format":"parquet"}`), 0)
+	require.NoError(t, err)
+
+	diff, err := svc.DiffRevisions(ctx, created.ID, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 2)
+	assert.Equal(t, "output_format", diff.Changes[0].Field)
+	assert.Nil(t, diff.Changes[0].From)
+	assert.JSONEq(t, `"parquet"`, string(diff.Changes[0].To))
+	assert.Equal(t, "tables", diff.Changes[1].Field)
+	assert.JSONEq(t, `["VBRK"]`, string(diff.Changes[1].From))
+	assert.JSONEq(t, `["VBRK","VBRP"]`, string(diff.Changes[1].To))
+
+	rolledBack, err := svc.Rollback(ctx, created.ID, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rolledBack.Revision)
+	assert.Equal(t, []string{"VBRK"}, rolledBack.Tables)
+	assert.Empty(t, rolledBack.OutputFormat)
+
+	rev, err := svc.GetRevision(ctx, created.ID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RevisionActionRollback, rev.Action)
+	assert.Equal(t, 1, rev.SourceRevision)
+
+	_, err = svc.Rollback(ctx, created.ID, 9, 0)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+	_, err = svc.DiffRevisions(ctx, created.ID, 1, 9)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+	_, err = svc.ListRevisions(ctx, "TRP-MISSING")
+	assert.ErrorIs(t, err, ErrTransportNotFound)
+}
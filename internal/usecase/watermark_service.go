@@ -0,0 +1,90 @@
+// Package usecase는 비즈니스 로직을 구현하는 서비스 레이어입니다.
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository"
+)
+
+// WatermarkService는 테이블별 증분 추출 기준값을 관리합니다
+type WatermarkService struct {
+	repo repository.WatermarkRepository
+}
+
+// NewWatermarkService는 새로운 WatermarkService를 생성합니다
+func NewWatermarkService(repo repository.WatermarkRepository) *WatermarkService {
+	return &WatermarkService{
+		repo: repo,
+	}
+}
+
+// List는 Transport의 Watermark 목록을 조회합니다
+func (s *WatermarkService) List(ctx context.Context, transportID string) (*domain.WatermarkListResponse, error) {
+	watermarks, err := s.repo.ListByTransportID(ctx, transportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WatermarkListResponse{
+		TransportID: transportID,
+		Watermarks:  watermarks,
+	}, nil
+}
+
+// Ranges는 Transport의 watermark 컬럼이 설정된 테이블별 추출 범위를 계산합니다
+// 저장된 기준값이 없거나, 기준 컬럼이 변경되었거나, fullReload이면 전체 추출(From=nil)입니다
+func (s *WatermarkService) Ranges(ctx context.Context, transport *domain.Transport, fullReload bool) (map[string]domain.WatermarkRange, error) {
+	ranges := make(map[string]domain.WatermarkRange)
+	for _, table := range transport.Tables {
+		column := transport.WatermarkColumn(table)
+		if column == "" {
+			continue
+		}
+
+		r := domain.WatermarkRange{Column: column}
+		if !fullReload {
+			watermark, err := s.repo.Get(ctx, transport.ID, table)
+			if err != nil {
+				return nil, fmt.Errorf("watermark 조회 실패 (%s): %w", table, err)
+			}
+			if watermark != nil && watermark.Column == column {
+				from := watermark.Value
+				r.From = &from
+			}
+		}
+		ranges[table] = r
+	}
+	return ranges, nil
+}
+
+// Advance는 성공한 Job의 추출 범위로 기준값을 갱신합니다
+// 추출된 row가 없어 상한이 없는 테이블은 기존 기준값을 유지합니다
+func (s *WatermarkService) Advance(ctx context.Context, transportID, jobID string, extractions []domain.Extraction) error {
+	now := time.Now().UTC()
+	for _, ext := range extractions {
+		if ext.Watermark == nil || ext.Watermark.To == nil {
+			continue
+		}
+
+		if err := s.repo.Save(ctx, &domain.Watermark{
+			TransportID: transportID,
+			TableName:   ext.TableName,
+			Column:      ext.Watermark.Column,
+			Value:       *ext.Watermark.To,
+			JobID:       jobID,
+			UpdatedAt:   now,
+		}); err != nil {
+			return fmt.Errorf("watermark 저장 실패 (%s): %w", ext.TableName, err)
+		}
+	}
+	return nil
+}
+
+// Reset은 Transport의 모든 기준값을 삭제하여 다음 실행을 전체 추출로 만듭니다
+func (s *WatermarkService) Reset(ctx context.Context, transportID string) error {
+	return s.repo.DeleteByTransportID(ctx, transportID)
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oracle-etl/internal/domain"
+	"oracle-etl/internal/repository/memory"
+)
+
+// TestWatermarkService_Ranges는 테이블별 추출 범위 계산을 테스트합니다
+func TestWatermarkService_Ranges(t *testing.T) {
+	repo := memory.NewWatermarkRepository()
+	svc := NewWatermarkService(repo)
+	ctx := context.Background()
+
+	transport := &domain.Transport{
+		ID:     "TRPID-12345678",
+		Tables: []string{"VBRP", "VBRK", "MARA"},
+		TableOptions: map[string]domain.TableOptions{
+			"VBRP": {WatermarkColumn: "LAST_UPDATE_DATE"},
+			"VBRK": {WatermarkColumn: "AEDAT"},
+		},
+	}
+
+	value := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Save(ctx, &domain.Watermark{
+		TransportID: transport.ID, TableName: "VBRP", Column: "LAST_UPDATE_DATE", Value: value,
+	}))
+	// 기준 컬럼이 변경된 테이블은 전체 추출
+	require.NoError(t, repo.Save(ctx, &domain.Watermark{
+		TransportID: transport.ID, TableName: "VBRK", Column: "ERDAT", Value: value,
+	}))
+
+	ranges, err := svc.Ranges(ctx, transport, false)
+	require.NoError(t, err)
+	require.Len(t, ranges, 2)
+	assert.NotContains(t, ranges, "MARA")
+
+	require.NotNil(t, ranges["VBRP"].From)
+	assert.True(t, value.Equal(*ranges["VBRP"].From))
+	assert.Nil(t, ranges["VBRK"].From)
+	assert.Equal(t, "AEDAT", ranges["VBRK"].Column)
+
+	// full reload는 저장된 기준값 무시
+	ranges, err = svc.Ranges(ctx, transport, true)
+	require.NoError(t, err)
+	assert.Nil(t, ranges["VBRP"].From)
+}
+
+// TestWatermarkService_Advance는 추출 결과로 기준값 갱신을 테스트합니다
+func TestWatermarkService_Advance(t *testing.T) {
+	svc := NewWatermarkService(memory.NewWatermarkRepository())
+	ctx := context.Background()
+
+	from := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC)
+	extractions := []domain.Extraction{
+		{TableName: "VBRP", Watermark: &domain.WatermarkRange{Column: "LAST_UPDATE_DATE", From: &from, To: &to}},
+		{TableName: "VBRK", Watermark: &domain.WatermarkRange{Column: "AEDAT"}}, // 추출된 row 없음
+		{TableName: "MARA"}, // watermark 미설정
+	}
+
+	require.NoError(t, svc.Advance(ctx, "TRPID-12345678", "JOB-001", extractions))
+
+	resp, err := svc.List(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	require.Len(t, resp.Watermarks, 1)
+	assert.Equal(t, "VBRP", resp.Watermarks[0].TableName)
+	assert.Equal(t, "JOB-001", resp.Watermarks[0].JobID)
+	assert.True(t, to.Equal(resp.Watermarks[0].Value))
+
+	require.NoError(t, svc.Reset(ctx, "TRPID-12345678"))
+	resp, err = svc.List(ctx, "TRPID-12345678")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Watermarks)
+}
@@ -26,6 +26,10 @@ const (
 
 	// GzipBufferSize는 gzip 압축 버퍼 크기입니다 (32KB).
 	GzipBufferSize = 32 * 1024
+
+	// ParquetRowGroupSize는 Parquet row group 크기입니다 (압축 전 64MB).
+	// row group 단위로 메모리에 버퍼링한 뒤 업로드하므로 테이블당 메모리 사용량을 결정합니다.
+	ParquetRowGroupSize = 64 * 1024 * 1024
 )
 
 // GCS 관련 상수
@@ -43,6 +47,14 @@ const (
 	// DefaultChunkSize는 스트리밍 시 청크당 row 수입니다.
 	DefaultChunkSize = 10000
 
+	// DefaultChunkMaxBytes는 스트리밍 시 청크당 최대 row 데이터 크기입니다 (64MB).
+	// 큰 LOB 값이 많은 테이블에서 ChunkSize개의 row가 메모리에 쌓이지 않도록 청크를 먼저 넘깁니다.
+	DefaultChunkMaxBytes = 64 * 1024 * 1024
+
+	// DefaultLOBMaxInlineBytes는 row에 포함(text/base64)하는 LOB 값 하나의 최대 크기입니다 (16MB).
+	// 이보다 큰 LOB은 메모리에 모두 읽지 않고 실패 처리하므로 object 정책으로 추출해야 합니다.
+	DefaultLOBMaxInlineBytes = 16 * 1024 * 1024
+
 	// DefaultParallelism은 기본 병렬 처리 수입니다.
 	DefaultParallelism = 4
 
@@ -57,14 +69,17 @@ type Config struct {
 	PrefetchCount  int // prefetch 버퍼 row 수
 
 	// IO 버퍼 설정
-	JSONLBufferSize int // JSONL 버퍼 크기 (bytes)
-	GzipBufferSize  int // Gzip 버퍼 크기 (bytes)
+	JSONLBufferSize     int // JSONL 버퍼 크기 (bytes)
+	GzipBufferSize      int // Gzip 버퍼 크기 (bytes)
+	ParquetRowGroupSize int // Parquet row group 크기 (압축 전 bytes, 0이면 기본값)
 
 	// GCS 설정
 	GCSChunkSize int // GCS 업로드 청크 크기 (bytes)
 
 	// ETL 설정
-	ChunkSize int // 스트리밍 청크 row 수
+	ChunkSize         int // 스트리밍 청크 row 수
+	ChunkMaxBytes     int // 스트리밍 청크 최대 row 데이터 크기 (bytes, 0이면 row 수로만 제한)
+	LOBMaxInlineBytes int // row에 포함하는 LOB 값 하나의 최대 크기 (bytes, 0이면 제한 없음)
 }
 
 // DefaultConfig는 기본 설정을 반환합니다
@@ -77,6 +92,10 @@ func DefaultConfig() Config {
 		GzipBufferSize:  GzipBufferSize,
 		GCSChunkSize:    GCSChunkSize,
 		ChunkSize:       DefaultChunkSize,
+		ChunkMaxBytes:   DefaultChunkMaxBytes,
+
+		LOBMaxInlineBytes:   DefaultLOBMaxInlineBytes,
+		ParquetRowGroupSize: ParquetRowGroupSize,
 	}
 }
 
@@ -90,6 +109,10 @@ func HighPerformanceConfig() Config {
 		GzipBufferSize:  64 * 1024,         // 64KB
 		GCSChunkSize:    32 * 1024 * 1024,  // 32MB
 		ChunkSize:       20000,             // 더 큰 청크
+		ChunkMaxBytes:   128 * 1024 * 1024, // 128MB
+
+		LOBMaxInlineBytes:   32 * 1024 * 1024,  // 32MB
+		ParquetRowGroupSize: 128 * 1024 * 1024, // 128MB
 	}
 }
 
@@ -103,6 +126,10 @@ func LowMemoryConfig() Config {
 		GzipBufferSize:  16 * 1024,         // 16KB
 		GCSChunkSize:    8 * 1024 * 1024,   // 8MB
 		ChunkSize:       5000,              // 작은 청크
+		ChunkMaxBytes:   16 * 1024 * 1024,  // 16MB
+
+		LOBMaxInlineBytes:   4 * 1024 * 1024,  // 4MB
+		ParquetRowGroupSize: 16 * 1024 * 1024, // 16MB
 	}
 }
 
@@ -126,6 +153,15 @@ func (c Config) Validate() error {
 	if c.ChunkSize <= 0 {
 		return errors.New("ChunkSize는 양수여야 합니다")
 	}
+	if c.ParquetRowGroupSize < 0 {
+		return errors.New("ParquetRowGroupSize는 음수일 수 없습니다")
+	}
+	if c.ChunkMaxBytes < 0 {
+		return errors.New("ChunkMaxBytes는 음수일 수 없습니다")
+	}
+	if c.LOBMaxInlineBytes < 0 {
+		return errors.New("LOBMaxInlineBytes는 음수일 수 없습니다")
+	}
 	return nil
 }
 
@@ -165,6 +201,24 @@ func (c Config) WithChunkSize(size int) Config {
 	return c
 }
 
+// WithChunkMaxBytes는 ChunkMaxBytes를 설정한 새 Config를 반환합니다
+func (c Config) WithChunkMaxBytes(size int) Config {
+	c.ChunkMaxBytes = size
+	return c
+}
+
+// WithLOBMaxInlineBytes는 LOBMaxInlineBytes를 설정한 새 Config를 반환합니다
+func (c Config) WithLOBMaxInlineBytes(size int) Config {
+	c.LOBMaxInlineBytes = size
+	return c
+}
+
+// WithParquetRowGroupSize는 ParquetRowGroupSize를 설정한 새 Config를 반환합니다
+func (c Config) WithParquetRowGroupSize(size int) Config {
+	c.ParquetRowGroupSize = size
+	return c
+}
+
 // EstimatedMemoryUsage는 이 설정으로 예상되는 메모리 사용량을 반환합니다
 // 단위: bytes
 func (c Config) EstimatedMemoryUsage() int64 {
@@ -20,6 +20,8 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, 32*1024, config.GzipBufferSize, "Gzip 버퍼는 32KB여야 함")
 	assert.Equal(t, 16*1024*1024, config.GCSChunkSize, "GCS chunk size는 16MB여야 함")
 	assert.Equal(t, 10000, config.ChunkSize, "청크 row 수는 10000이어야 함")
+	assert.Equal(t, 64*1024*1024, config.ChunkMaxBytes, "청크 바이트 상한은 64MB여야 함")
+	assert.Equal(t, 16*1024*1024, config.LOBMaxInlineBytes, "인라인 LOB 상한은 16MB여야 함")
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -73,6 +75,18 @@ func TestConfig_Validate(t *testing.T) {
 			expectError: true,
 			errorField:  "GCSChunkSize",
 		},
+		{
+			name:        "음수 청크 바이트 상한 유효하지 않음",
+			config:      DefaultConfig().WithChunkMaxBytes(-1),
+			expectError: true,
+			errorField:  "ChunkMaxBytes",
+		},
+		{
+			name:        "음수 인라인 LOB 상한 유효하지 않음",
+			config:      DefaultConfig().WithLOBMaxInlineBytes(-1),
+			expectError: true,
+			errorField:  "LOBMaxInlineBytes",
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,6 +139,12 @@ func TestConfig_WithOptions(t *testing.T) {
 
 	config = config.WithJSONLBufferSize(128 * 1024)
 	assert.Equal(t, 128*1024, config.JSONLBufferSize)
+
+	config = config.WithChunkMaxBytes(0)
+	assert.Equal(t, 0, config.ChunkMaxBytes)
+
+	config = config.WithLOBMaxInlineBytes(1024)
+	assert.Equal(t, 1024, config.LOBMaxInlineBytes)
 }
 
 func TestConfig_EstimatedMemoryUsage(t *testing.T) {
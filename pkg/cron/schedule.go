@@ -0,0 +1,82 @@
+// Package cron은 github.com/robfig/cron/v3의 표준 5필드 파서 위에 이전 실행 시각 계산을 더한 얇은 계층입니다.
+// Transport 스케줄러와 유지보수 윈도우에서 다음/이전 실행 시각을 계산하기 위해 사용됩니다.
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	robfig "github.com/robfig/cron/v3"
+)
+
+// Prev 탐색 범위 상한 (robfig/cron의 Next 탐색 범위와 같은 5년)
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// Schedule은 파싱된 cron 표현식입니다
+// 실행 시각은 입력 시각의 Location 기준으로 계산됩니다
+type Schedule struct {
+	expression string
+	spec       robfig.Schedule
+}
+
+// Parse는 5필드 cron 표현식(분 시 일 월 요일) 또는 @daily 등의 별칭을 파싱합니다
+// 시간대는 CronSchedule.Timezone으로 지정하므로 TZ= 접두사와 간격 기반 @every는 허용하지 않습니다
+func Parse(expression string) (*Schedule, error) {
+	expr := strings.TrimSpace(expression)
+	if expr == "" {
+		return nil, fmt.Errorf("cron 표현식이 비어있습니다")
+	}
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		return nil, fmt.Errorf("cron 표현식에 시간대를 지정할 수 없습니다 (timezone 필드 사용): %s", expr)
+	}
+	if strings.HasPrefix(expr, "@every") {
+		return nil, fmt.Errorf("지원하지 않는 cron 별칭입니다: %s", expr)
+	}
+
+	spec, err := robfig.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("cron 표현식 파싱 실패: %w", err)
+	}
+	return &Schedule{expression: expr, spec: spec}, nil
+}
+
+// String은 원본 표현식을 반환합니다
+func (s *Schedule) String() string {
+	return s.expression
+}
+
+// Next는 t 이후(t 제외)의 다음 실행 시각을 반환합니다 (5년 내 없으면 zero time)
+func (s *Schedule) Next(t time.Time) time.Time {
+	return s.spec.Next(t)
+}
+
+// Prev는 t 이전(t 제외)의 가장 최근 실행 시각을 반환합니다 (5년 내 없으면 zero time)
+// robfig/cron은 Next만 제공하므로 Next(x) < t를 만족하는 가장 늦은 x를 찾아 계산합니다
+func (s *Schedule) Prev(t time.Time) time.Time {
+	before := func(x time.Time) bool {
+		next := s.spec.Next(x)
+		return !next.IsZero() && next.Before(t)
+	}
+
+	// 실행 시각이 포함될 때까지 탐색 구간을 두 배씩 넓힘
+	span := time.Minute
+	for !before(t.Add(-span)) {
+		if span >= searchLimit {
+			return time.Time{}
+		}
+		span *= 2
+	}
+
+	// before(lo)는 참, before(hi)는 거짓을 유지하며 초 단위까지 좁힘
+	lo, hi := t.Add(-span), t
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if before(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return s.spec.Next(lo)
+}
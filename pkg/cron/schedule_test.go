@@ -0,0 +1,126 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadSeoul(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	return loc
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"빈 표현식", ""},
+		{"필드 부족", "0 2 * *"},
+		{"필드 초과", "0 0 2 * * *"},
+		{"분 범위 초과", "60 * * * *"},
+		{"시 범위 초과", "0 24 * * *"},
+		{"일 0", "0 0 0 * *"},
+		{"잘못된 범위", "0 5-2 * * *"},
+		{"잘못된 step", "*/0 * * * *"},
+		{"알 수 없는 별칭", "@every5m"},
+		{"간격 별칭", "@every 5m"},
+		{"시간대 접두사", "CRON_TZ=Asia/Seoul 0 2 * * *"},
+		{"알 수 없는 이름", "0 0 * FOO *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	seoul := mustLoadSeoul(t)
+	base := time.Date(2024, 1, 15, 10, 30, 0, 0, seoul) // 월요일
+
+	tests := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"매시 정각", "0 * * * *", base, time.Date(2024, 1, 15, 11, 0, 0, 0, seoul)},
+		{"매일 02시", "0 2 * * *", base, time.Date(2024, 1, 16, 2, 0, 0, 0, seoul)},
+		{"15분 간격", "*/15 * * * *", base, time.Date(2024, 1, 15, 10, 45, 0, 0, seoul)},
+		{"정각 입력은 제외", "30 10 * * *", base, time.Date(2024, 1, 16, 10, 30, 0, 0, seoul)},
+		{"평일 범위", "0 9 * * MON-FRI", time.Date(2024, 1, 19, 10, 0, 0, 0, seoul), time.Date(2024, 1, 22, 9, 0, 0, 0, seoul)},
+		{"일요일 이름", "0 0 * * SUN", base, time.Date(2024, 1, 21, 0, 0, 0, 0, seoul)},
+		{"월말 건너뛰기", "0 0 31 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, seoul), time.Date(2024, 3, 31, 0, 0, 0, 0, seoul)},
+		{"윤년", "0 0 29 2 *", base, time.Date(2024, 2, 29, 0, 0, 0, 0, seoul)},
+		{"일/요일 OR", "0 0 1 * MON", base, time.Date(2024, 1, 22, 0, 0, 0, 0, seoul)},
+		{"목록", "0 6,18 * * *", base, time.Date(2024, 1, 15, 18, 0, 0, 0, seoul)},
+		{"별칭", "@monthly", base, time.Date(2024, 2, 1, 0, 0, 0, 0, seoul)},
+		{"연말 넘김", "0 0 1 JAN *", base, time.Date(2025, 1, 1, 0, 0, 0, 0, seoul)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, s.Next(tt.from))
+		})
+	}
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	seoul := mustLoadSeoul(t)
+	base := time.Date(2024, 1, 15, 10, 30, 0, 0, seoul)
+
+	tests := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"매시 정각", "0 * * * *", base, time.Date(2024, 1, 15, 10, 0, 0, 0, seoul)},
+		{"매일 02시", "0 2 * * *", base, time.Date(2024, 1, 15, 2, 0, 0, 0, seoul)},
+		{"정각 입력은 제외", "30 10 * * *", base, time.Date(2024, 1, 14, 10, 30, 0, 0, seoul)},
+		{"초 단위 입력", "30 10 * * *", base.Add(5 * time.Second), base},
+		{"이전 달", "0 0 31 * *", base, time.Date(2023, 12, 31, 0, 0, 0, 0, seoul)},
+		{"평일 범위", "0 9 * * MON-FRI", time.Date(2024, 1, 21, 10, 0, 0, 0, seoul), time.Date(2024, 1, 19, 9, 0, 0, 0, seoul)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, s.Prev(tt.from))
+		})
+	}
+}
+
+func TestSchedule_Timezone(t *testing.T) {
+	seoul := mustLoadSeoul(t)
+	s, err := Parse("0 2 * * *")
+	require.NoError(t, err)
+
+	// UTC 17:00 = KST 02:00 (다음날)
+	from := time.Date(2024, 1, 15, 16, 0, 0, 0, time.UTC)
+	next := s.Next(from.In(seoul))
+	assert.Equal(t, time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC), next.UTC())
+
+	// UTC 기준으로 계산하면 02:00 UTC
+	assert.Equal(t, time.Date(2024, 1, 16, 2, 0, 0, 0, time.UTC), s.Next(from))
+}
+
+func TestSchedule_NoMatch(t *testing.T) {
+	s, err := Parse("0 0 31 2 *")
+	require.NoError(t, err)
+
+	assert.True(t, s.Next(time.Now()).IsZero())
+	assert.True(t, s.Prev(time.Now()).IsZero())
+	assert.Equal(t, "0 0 31 2 *", s.String())
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"io"
 	"sync/atomic"
+
+	"oracle-etl/pkg/rowset"
 )
 
 // Encoder는 JSON Lines 형식으로 데이터를 인코딩하는 인터페이스입니다
@@ -15,6 +17,9 @@ type Encoder interface {
 	// Encode는 단일 객체를 JSON Lines 형식으로 인코딩합니다
 	Encode(v interface{}) error
 
+	// EncodeRow는 typed row를 컬럼 순서의 JSON 객체 한 줄로 인코딩합니다
+	EncodeRow(row rowset.Row) error
+
 	// Flush는 버퍼에 남은 데이터를 출력합니다
 	Flush() error
 
@@ -32,6 +37,7 @@ type encoder struct {
 	bytesWritten int64
 	rowsEncoded  int64
 	countWriter  *countingWriter
+	line         []byte // EncodeRow가 재사용하는 줄 버퍼
 }
 
 // countingWriter는 기록된 바이트 수를 추적하는 io.Writer 래퍼입니다
@@ -79,6 +85,16 @@ func (e *encoder) Encode(v interface{}) error {
 	return nil
 }
 
+// EncodeRow는 typed row를 reflection 없이 JSON Lines 한 줄로 인코딩합니다
+func (e *encoder) EncodeRow(row rowset.Row) error {
+	e.line = append(row.AppendJSON(e.line[:0]), '\n')
+	if _, err := e.writer.Write(e.line); err != nil {
+		return err
+	}
+	atomic.AddInt64(&e.rowsEncoded, 1)
+	return nil
+}
+
 // Flush는 버퍼에 남은 데이터를 출력합니다
 func (e *encoder) Flush() error {
 	return e.writer.Flush()
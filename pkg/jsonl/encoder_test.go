@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"oracle-etl/pkg/rowset"
 )
 
 func TestEncoder_Encode_Map(t *testing.T) {
@@ -238,6 +240,31 @@ func TestEncoder_RowsEncoded(t *testing.T) {
 	assert.Equal(t, int64(5), encoder.RowsEncoded())
 }
 
+func TestEncoder_EncodeRow(t *testing.T) {
+	schema := rowset.NewSchema([]rowset.Column{
+		{Name: "VBELN", Kind: rowset.KindString},
+		{Name: "NETWR", Kind: rowset.KindNumber},
+		{Name: "ERDAT", Kind: rowset.KindTime},
+	})
+	first, err := rowset.New(schema, "0090000001", "12345678901234567890.12", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	second, err := rowset.New(schema, "0090000002", nil, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+	require.NoError(t, encoder.EncodeRow(first))
+	require.NoError(t, encoder.EncodeRow(second))
+	require.NoError(t, encoder.Flush())
+
+	// 컬럼 순서 유지, NUMBER는 정밀도 손실 없이 숫자로 출력
+	assert.Equal(t,
+		`{"VBELN":"0090000001","NETWR":12345678901234567890.12,"ERDAT":"2024-01-15T10:30:00Z"}`+"\n"+
+			`{"VBELN":"0090000002","NETWR":null,"ERDAT":null}`+"\n",
+		buf.String())
+	assert.Equal(t, int64(2), encoder.RowsEncoded())
+}
+
 func TestStreamEncoder_EncodeRows(t *testing.T) {
 	var buf bytes.Buffer
 	encoder := NewStreamEncoder(&buf)
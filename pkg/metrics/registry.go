@@ -0,0 +1,163 @@
+// Package metrics는 prometheus/client_golang 위에 메트릭 등록과 이름 규칙을 위한 얇은 계층을 제공합니다.
+// counter, gauge, histogram과 수집 시점에 값을 읽는 함수형 메트릭을 label 값 가변 인자로 기록할 수 있습니다.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+)
+
+// DefBuckets는 기본 histogram 버킷입니다 (초 단위 지연 시간용)
+var DefBuckets = prometheus.DefBuckets
+
+// Emit은 함수형 메트릭이 수집 시점에 값을 기록하는 콜백입니다
+// labelValues는 메트릭 등록 시 지정한 label 순서와 같아야 합니다
+type Emit func(value float64, labelValues ...string)
+
+// Registry는 메트릭을 등록하고 노출합니다
+type Registry struct {
+	registry *prometheus.Registry
+}
+
+// NewRegistry는 새로운 Registry를 생성합니다
+func NewRegistry() *Registry {
+	return &Registry{registry: prometheus.NewRegistry()}
+}
+
+// Handler는 등록된 메트릭을 노출하는 HTTP 핸들러를 반환합니다 (promhttp, Accept 헤더에 따른 형식 협상 포함)
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
+
+// Write는 등록된 모든 메트릭을 Prometheus 텍스트 형식으로 출력합니다
+func (r *Registry) Write(w io.Writer) error {
+	families, err := r.registry.Gather()
+	if err != nil {
+		return fmt.Errorf("메트릭 수집 실패: %w", err)
+	}
+	enc := expfmt.NewEncoder(w, expfmt.NewFormat(expfmt.TypeTextPlain))
+	for _, family := range families {
+		if err := enc.Encode(family); err != nil {
+			return fmt.Errorf("메트릭 출력 실패: %w", err)
+		}
+	}
+	return nil
+}
+
+// CounterVec은 label 값 조합별로 증가만 하는 counter입니다
+type CounterVec struct {
+	vec *prometheus.CounterVec
+}
+
+// NewCounterVec은 counter를 생성하여 등록합니다 (같은 이름이 이미 있으면 panic)
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	r.registry.MustRegister(vec)
+	return &CounterVec{vec: vec}
+}
+
+// Add는 counter를 delta만큼 증가시킵니다 (음수는 무시)
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.vec.WithLabelValues(labelValues...).Add(delta)
+}
+
+// Inc는 counter를 1 증가시킵니다
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.vec.WithLabelValues(labelValues...).Inc()
+}
+
+// GaugeVec은 label 값 조합별로 증감하는 gauge입니다
+type GaugeVec struct {
+	vec *prometheus.GaugeVec
+}
+
+// NewGaugeVec은 gauge를 생성하여 등록합니다 (같은 이름이 이미 있으면 panic)
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)
+	r.registry.MustRegister(vec)
+	return &GaugeVec{vec: vec}
+}
+
+// Set은 gauge 값을 설정합니다
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.vec.WithLabelValues(labelValues...).Set(value)
+}
+
+// Add는 gauge 값을 delta만큼 변경합니다
+func (g *GaugeVec) Add(delta float64, labelValues ...string) {
+	g.vec.WithLabelValues(labelValues...).Add(delta)
+}
+
+// HistogramVec은 label 값 조합별 관측값 분포입니다
+type HistogramVec struct {
+	vec *prometheus.HistogramVec
+}
+
+// NewHistogramVec은 histogram을 생성하여 등록합니다 (buckets가 비어 있으면 DefBuckets, 순서는 정렬하여 사용)
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)
+	r.registry.MustRegister(vec)
+	return &HistogramVec{vec: vec}
+}
+
+// Observe는 관측값을 기록합니다
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	h.vec.WithLabelValues(labelValues...).Observe(value)
+}
+
+// NewGaugeFunc은 수집 시점에 collect로 값을 읽는 gauge를 등록합니다
+// 커넥션 풀 통계, 큐 길이처럼 다른 컴포넌트가 이미 관리하는 값을 노출할 때 사용합니다
+func (r *Registry) NewGaugeFunc(name, help string, labels []string, collect func(emit Emit)) {
+	r.registry.MustRegister(newFuncCollector(name, help, prometheus.GaugeValue, labels, collect))
+}
+
+// NewCounterFunc은 수집 시점에 collect로 누적값을 읽는 counter를 등록합니다
+func (r *Registry) NewCounterFunc(name, help string, labels []string, collect func(emit Emit)) {
+	r.registry.MustRegister(newFuncCollector(name, help, prometheus.CounterValue, labels, collect))
+}
+
+// funcCollector는 수집 시점에 콜백으로 값을 읽는 prometheus.Collector입니다
+type funcCollector struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	collect   func(emit Emit)
+}
+
+func newFuncCollector(name, help string, valueType prometheus.ValueType, labels []string, collect func(emit Emit)) *funcCollector {
+	return &funcCollector{
+		desc:      prometheus.NewDesc(name, help, labels, nil),
+		valueType: valueType,
+		collect:   collect,
+	}
+}
+
+// Describe는 prometheus.Collector 인터페이스를 구현합니다
+func (f *funcCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- f.desc
+}
+
+// Collect는 prometheus.Collector 인터페이스를 구현합니다
+// label 값 개수가 맞지 않는 sample은 수집 오류로 보고됩니다
+func (f *funcCollector) Collect(ch chan<- prometheus.Metric) {
+	f.collect(func(value float64, labelValues ...string) {
+		metric, err := prometheus.NewConstMetric(f.desc, f.valueType, value, labelValues...)
+		if err != nil {
+			metric = prometheus.NewInvalidMetric(f.desc, err)
+		}
+		ch <- metric
+	})
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, r *Registry) string {
+	t.Helper()
+	var sb strings.Builder
+	require.NoError(t, r.Write(&sb))
+	return sb.String()
+}
+
+func TestCounterVec(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("etl_rows_total", "추출된 row 수", "transport", "table")
+
+	c.Add(10, "TRP-001", "MARA")
+	c.Add(5, "TRP-001", "MARA")
+	c.Inc("TRP-001", "BKPF")
+	c.Add(-3, "TRP-001", "BKPF") // 음수는 무시
+
+	expected := `# HELP etl_rows_total 추출된 row 수
+# TYPE etl_rows_total counter
+etl_rows_total{table="BKPF",transport="TRP-001"} 1
+etl_rows_total{table="MARA",transport="TRP-001"} 15
+`
+	assert.Equal(t, expected, render(t, r))
+}
+
+func TestGaugeVec_NoLabels(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGaugeVec("etl_running_jobs", "실행 중인 Job 수")
+
+	g.Set(3)
+	g.Add(-1)
+
+	assert.Contains(t, render(t, r), "# TYPE etl_running_jobs gauge\netl_running_jobs 2\n")
+}
+
+func TestHistogramVec(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogramVec("etl_chunk_duration_seconds", "청크 처리 시간", []float64{1, 0.1}, "table")
+
+	h.Observe(0.05, "MARA")
+	h.Observe(0.1, "MARA") // 경계값은 해당 버킷에 포함
+	h.Observe(3, "MARA")
+
+	expected := `# HELP etl_chunk_duration_seconds 청크 처리 시간
+# TYPE etl_chunk_duration_seconds histogram
+etl_chunk_duration_seconds_bucket{table="MARA",le="0.1"} 2
+etl_chunk_duration_seconds_bucket{table="MARA",le="1"} 2
+etl_chunk_duration_seconds_bucket{table="MARA",le="+Inf"} 3
+etl_chunk_duration_seconds_sum{table="MARA"} 3.15
+etl_chunk_duration_seconds_count{table="MARA"} 3
+`
+	assert.Equal(t, expected, render(t, r))
+}
+
+func TestFuncMetrics(t *testing.T) {
+	r := NewRegistry()
+	r.NewGaugeFunc("oracle_pool_connections", "커넥션 수", []string{"state"}, func(emit Emit) {
+		emit(4, "in_use")
+		emit(2, "idle")
+	})
+	r.NewCounterFunc("oracle_pool_wait_total", "대기 횟수", nil, func(emit Emit) {
+		emit(7)
+	})
+
+	out := render(t, r)
+	// 이름순 출력
+	assert.Less(t, strings.Index(out, "oracle_pool_connections"), strings.Index(out, "oracle_pool_wait_total"))
+	assert.Contains(t, out, "oracle_pool_connections{state=\"idle\"} 2\noracle_pool_connections{state=\"in_use\"} 4\n")
+	assert.Contains(t, out, "# TYPE oracle_pool_wait_total counter\noracle_pool_wait_total 7\n")
+}
+
+func TestEscaping(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("etl_errors_total", "줄바꿈\n과 역슬래시\\", "message")
+	c.Inc("a \"quoted\"\nvalue\\")
+
+	out := render(t, r)
+	assert.Contains(t, out, `# HELP etl_errors_total 줄바꿈\n과 역슬래시\\`)
+	assert.Contains(t, out, `etl_errors_total{message="a \"quoted\"\nvalue\\"} 1`)
+}
+
+func TestRegistry_Panics(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("etl_dup_total", "중복", "table")
+
+	assert.Panics(t, func() { r.NewGaugeVec("etl_dup_total", "중복") })
+	assert.Panics(t, func() { c.Inc() })
+}
@@ -0,0 +1,422 @@
+// Package parquet은 Apache Parquet 파일 쓰기 기능을 제공합니다.
+// 인코딩과 파일 구조는 parquet-go 라이브러리가 담당하며, 이 패키지는
+// Oracle 추출 데이터를 타입을 보존한 평면(flat) 스키마로 기록하기 위한 컬럼 정의와 값 변환을 제공합니다.
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	pq "github.com/parquet-go/parquet-go"
+)
+
+// Type은 Parquet 물리 타입입니다
+type Type int32
+
+const (
+	// TypeInt32는 32비트 정수입니다
+	TypeInt32 Type = 1
+	// TypeInt64는 64비트 정수입니다
+	TypeInt64 Type = 2
+	// TypeDouble은 64비트 부동소수점입니다
+	TypeDouble Type = 5
+	// TypeByteArray는 가변 길이 바이트 배열입니다
+	TypeByteArray Type = 6
+	// TypeFixedLenByteArray는 고정 길이 바이트 배열입니다
+	TypeFixedLenByteArray Type = 7
+)
+
+// LogicalType은 물리 타입 값의 해석 방법입니다
+type LogicalType int
+
+const (
+	// LogicalNone은 논리 타입이 없는 값입니다 (바이너리, 정수, 실수)
+	LogicalNone LogicalType = iota
+	// LogicalString은 UTF-8 문자열입니다
+	LogicalString
+	// LogicalDecimal은 고정 소수점 숫자입니다
+	LogicalDecimal
+	// LogicalTimestamp는 epoch 기준 시각입니다
+	LogicalTimestamp
+)
+
+// TimeUnit은 TIMESTAMP 값의 단위입니다
+type TimeUnit int
+
+const (
+	// TimeUnitMillis는 밀리초 단위입니다
+	TimeUnitMillis TimeUnit = iota
+	// TimeUnitMicros는 마이크로초 단위입니다
+	TimeUnitMicros
+)
+
+// MaxDecimalPrecision은 지원하는 DECIMAL 최대 자릿수입니다 (Oracle NUMBER 최대 정밀도)
+const MaxDecimalPrecision = 38
+
+// Field는 Parquet 스키마의 컬럼 정의입니다
+type Field struct {
+	Name          string      // 컬럼 이름
+	Type          Type        // 물리 타입
+	TypeLength    int         // FIXED_LEN_BYTE_ARRAY 바이트 길이
+	Logical       LogicalType // 논리 타입
+	Precision     int         // DECIMAL 전체 자릿수
+	Scale         int         // DECIMAL 소수점 이하 자릿수
+	Unit          TimeUnit    // TIMESTAMP 단위
+	AdjustedToUTC bool        // TIMESTAMP가 UTC 기준 시각인지 (false면 시간대 없는 로컬 시각)
+	Required      bool        // NULL 불허 여부
+}
+
+// String은 UTF-8 문자열 컬럼을 정의합니다
+func String(name string) Field {
+	return Field{Name: name, Type: TypeByteArray, Logical: LogicalString}
+}
+
+// Bytes는 바이너리 컬럼을 정의합니다
+func Bytes(name string) Field {
+	return Field{Name: name, Type: TypeByteArray}
+}
+
+// Double은 64비트 부동소수점 컬럼을 정의합니다
+func Double(name string) Field {
+	return Field{Name: name, Type: TypeDouble}
+}
+
+// Int64는 64비트 정수 컬럼을 정의합니다
+func Int64(name string) Field {
+	return Field{Name: name, Type: TypeInt64}
+}
+
+// Decimal은 고정 소수점 컬럼을 정의합니다
+// 자릿수에 따라 INT32(9 이하), INT64(18 이하), FIXED_LEN_BYTE_ARRAY 물리 타입을 사용합니다
+func Decimal(name string, precision, scale int) Field {
+	f := Field{Name: name, Logical: LogicalDecimal, Precision: precision, Scale: scale}
+	switch {
+	case precision <= 9:
+		f.Type = TypeInt32
+	case precision <= 18:
+		f.Type = TypeInt64
+	default:
+		f.Type = TypeFixedLenByteArray
+		f.TypeLength = decimalLength(precision)
+	}
+	return f
+}
+
+// Timestamp는 epoch 기준 시각 컬럼을 정의합니다
+func Timestamp(name string, unit TimeUnit, adjustedToUTC bool) Field {
+	return Field{Name: name, Type: TypeInt64, Logical: LogicalTimestamp, Unit: unit, AdjustedToUTC: adjustedToUTC}
+}
+
+// validate는 컬럼 정의의 유효성을 검사합니다
+func (f Field) validate() error {
+	if f.Name == "" {
+		return errors.New("컬럼 이름이 비어있습니다")
+	}
+	switch f.Type {
+	case TypeInt32, TypeInt64, TypeDouble, TypeByteArray:
+	case TypeFixedLenByteArray:
+		if f.TypeLength <= 0 {
+			return fmt.Errorf("컬럼 %s: FIXED_LEN_BYTE_ARRAY 길이가 필요합니다", f.Name)
+		}
+	default:
+		return fmt.Errorf("컬럼 %s: 지원하지 않는 물리 타입 %d", f.Name, f.Type)
+	}
+	switch f.Logical {
+	case LogicalDecimal:
+		if f.Precision < 1 || f.Precision > MaxDecimalPrecision || f.Scale < 0 || f.Scale > f.Precision {
+			return fmt.Errorf("컬럼 %s: 잘못된 DECIMAL(%d,%d)", f.Name, f.Precision, f.Scale)
+		}
+		if f.Type == TypeByteArray || f.Type == TypeDouble {
+			return fmt.Errorf("컬럼 %s: DECIMAL은 INT32, INT64, FIXED_LEN_BYTE_ARRAY여야 합니다", f.Name)
+		}
+	case LogicalTimestamp:
+		if f.Type != TypeInt64 {
+			return fmt.Errorf("컬럼 %s: TIMESTAMP는 INT64여야 합니다", f.Name)
+		}
+	case LogicalString:
+		if f.Type != TypeByteArray {
+			return fmt.Errorf("컬럼 %s: STRING은 BYTE_ARRAY여야 합니다", f.Name)
+		}
+	}
+	return nil
+}
+
+// node는 컬럼 정의를 parquet-go 스키마 노드로 변환합니다
+func (f Field) node() pq.Node {
+	var node pq.Node
+	switch f.Logical {
+	case LogicalString:
+		node = pq.String()
+	case LogicalDecimal:
+		node = pq.Decimal(f.Scale, f.Precision, f.physicalType())
+	case LogicalTimestamp:
+		unit := pq.Millisecond
+		if f.Unit == TimeUnitMicros {
+			unit = pq.Microsecond
+		}
+		node = pq.TimestampAdjusted(unit, f.AdjustedToUTC)
+	default:
+		node = pq.Leaf(f.physicalType())
+	}
+	if f.Required {
+		return pq.Required(node)
+	}
+	return pq.Optional(node)
+}
+
+// physicalType은 물리 타입에 해당하는 parquet-go 타입을 반환합니다
+func (f Field) physicalType() pq.Type {
+	switch f.Type {
+	case TypeInt32:
+		return pq.Int32Type
+	case TypeInt64:
+		return pq.Int64Type
+	case TypeDouble:
+		return pq.DoubleType
+	case TypeFixedLenByteArray:
+		return pq.FixedLenByteArrayType(f.TypeLength)
+	default:
+		return pq.ByteArrayType
+	}
+}
+
+// value는 Go 값을 컬럼 물리 타입의 Parquet 값으로 변환하고 압축 전 크기를 함께 반환합니다
+func (f Field) value(v interface{}) (pq.Value, int, error) {
+	switch f.Logical {
+	case LogicalDecimal:
+		unscaled, err := toUnscaled(v, f.Scale)
+		if err != nil {
+			return pq.Value{}, 0, err
+		}
+		if unscaled.CmpAbs(pow10(f.Precision)) >= 0 {
+			return pq.Value{}, 0, fmt.Errorf("값 %v이(가) DECIMAL(%d,%d) 범위를 벗어났습니다", v, f.Precision, f.Scale)
+		}
+		switch f.Type {
+		case TypeInt32:
+			return pq.Int32Value(int32(unscaled.Int64())), 4, nil
+		case TypeInt64:
+			return pq.Int64Value(unscaled.Int64()), 8, nil
+		default:
+			return pq.FixedLenByteArrayValue(appendTwosComplement(nil, unscaled, f.TypeLength)), f.TypeLength, nil
+		}
+
+	case LogicalTimestamp:
+		t, err := toTime(v)
+		if err != nil {
+			return pq.Value{}, 0, err
+		}
+		if !f.AdjustedToUTC {
+			// 시간대 없는 값은 로컬 시각(wall clock)을 그대로 UTC 기준으로 기록
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		}
+		if f.Unit == TimeUnitMicros {
+			return pq.Int64Value(t.UnixMicro()), 8, nil
+		}
+		return pq.Int64Value(t.UnixMilli()), 8, nil
+	}
+
+	switch f.Type {
+	case TypeInt32:
+		n, err := toInt64(v)
+		if err != nil {
+			return pq.Value{}, 0, err
+		}
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return pq.Value{}, 0, fmt.Errorf("값 %d이(가) INT32 범위를 벗어났습니다", n)
+		}
+		return pq.Int32Value(int32(n)), 4, nil
+	case TypeInt64:
+		n, err := toInt64(v)
+		if err != nil {
+			return pq.Value{}, 0, err
+		}
+		return pq.Int64Value(n), 8, nil
+	case TypeDouble:
+		n, err := toFloat64(v)
+		if err != nil {
+			return pq.Value{}, 0, err
+		}
+		return pq.DoubleValue(n), 8, nil
+	case TypeByteArray:
+		b := toBytes(v)
+		return pq.ByteArrayValue(b), 4 + len(b), nil
+	default:
+		b := toBytes(v)
+		if len(b) != f.TypeLength {
+			return pq.Value{}, 0, fmt.Errorf("값 길이 %d이(가) FIXED_LEN_BYTE_ARRAY(%d)와 다릅니다", len(b), f.TypeLength)
+		}
+		return pq.FixedLenByteArrayValue(b), len(b), nil
+	}
+}
+
+// toBytes는 값을 바이트 배열로 변환합니다
+func toBytes(v interface{}) []byte {
+	switch val := v.(type) {
+	case []byte:
+		return val
+	case string:
+		return []byte(val)
+	case fmt.Stringer:
+		return []byte(val.String())
+	default:
+		return []byte(fmt.Sprint(val))
+	}
+}
+
+// numberString은 숫자로 해석할 값의 문자열 표현을 반환합니다
+// (godror.Number 등 fmt.Stringer 구현체 포함)
+func numberString(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return strings.TrimSpace(val), true
+	case []byte:
+		return strings.TrimSpace(string(val)), true
+	case fmt.Stringer:
+		return strings.TrimSpace(val.String()), true
+	}
+	return "", false
+}
+
+// toInt64는 값을 정수로 변환합니다
+func toInt64(v interface{}) (int64, error) {
+	switch val := v.(type) {
+	case int:
+		return int64(val), nil
+	case int32:
+		return int64(val), nil
+	case int64:
+		return val, nil
+	case uint32:
+		return int64(val), nil
+	case float64:
+		if val != math.Trunc(val) {
+			return 0, fmt.Errorf("값 %v은(는) 정수가 아닙니다", val)
+		}
+		return int64(val), nil
+	}
+	if s, ok := numberString(v); ok {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("정수 변환 실패: %w", err)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("정수로 변환할 수 없는 타입 %T", v)
+}
+
+// toFloat64는 값을 부동소수점으로 변환합니다
+func toFloat64(v interface{}) (float64, error) {
+	switch val := v.(type) {
+	case float64:
+		return val, nil
+	case float32:
+		return float64(val), nil
+	case int:
+		return float64(val), nil
+	case int32:
+		return float64(val), nil
+	case int64:
+		return float64(val), nil
+	}
+	if s, ok := numberString(v); ok {
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("실수 변환 실패: %w", err)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("실수로 변환할 수 없는 타입 %T", v)
+}
+
+// toUnscaled는 값을 10^scale을 곱한 정수(unscaled value)로 변환합니다
+func toUnscaled(v interface{}, scale int) (*big.Int, error) {
+	r := new(big.Rat)
+	switch val := v.(type) {
+	case int:
+		r.SetInt64(int64(val))
+	case int32:
+		r.SetInt64(int64(val))
+	case int64:
+		r.SetInt64(val)
+	case float64:
+		if _, ok := r.SetString(strconv.FormatFloat(val, 'f', -1, 64)); !ok {
+			return nil, fmt.Errorf("DECIMAL 변환 실패: %v", val)
+		}
+	default:
+		s, ok := numberString(v)
+		if !ok {
+			return nil, fmt.Errorf("DECIMAL로 변환할 수 없는 타입 %T", v)
+		}
+		if _, ok := r.SetString(s); !ok {
+			return nil, fmt.Errorf("DECIMAL 변환 실패: %q", s)
+		}
+	}
+
+	r.Mul(r, new(big.Rat).SetInt(pow10(scale)))
+	if !r.IsInt() {
+		return nil, fmt.Errorf("값 %v의 소수 자릿수가 scale %d를 초과합니다", v, scale)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// timeLayouts는 문자열 시각 파싱에 사용하는 형식입니다
+// (추출 row는 time.Time을 그대로 전달하며, 문자열 값은 RFC3339가 우선)
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// toTime은 값을 시각으로 변환합니다
+func toTime(v interface{}) (time.Time, error) {
+	switch val := v.(type) {
+	case time.Time:
+		return val, nil
+	case *time.Time:
+		if val != nil {
+			return *val, nil
+		}
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, val); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("시각 변환 실패: %q", val)
+	}
+	return time.Time{}, fmt.Errorf("시각으로 변환할 수 없는 타입 %T", v)
+}
+
+// pow10은 10^n을 반환합니다
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// decimalLength는 precision 자릿수 DECIMAL을 저장할 최소 바이트 수를 반환합니다
+func decimalLength(precision int) int {
+	limit := pow10(precision)
+	for n := 1; ; n++ {
+		// 부호 비트를 제외한 8n-1 비트로 표현 가능한지 확인
+		if new(big.Int).Lsh(big.NewInt(1), uint(8*n-1)).Cmp(limit) >= 0 {
+			return n
+		}
+	}
+}
+
+// appendTwosComplement는 정수를 n바이트 big-endian 2의 보수로 추가합니다
+func appendTwosComplement(dst []byte, v *big.Int, n int) []byte {
+	x := v
+	if v.Sign() < 0 {
+		x = new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), uint(8*n)), v)
+	}
+	b := x.Bytes()
+	for i := len(b); i < n; i++ {
+		dst = append(dst, 0)
+	}
+	return append(dst, b...)
+}
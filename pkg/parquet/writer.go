@@ -0,0 +1,250 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync/atomic"
+
+	pq "github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	"github.com/parquet-go/parquet-go/encoding"
+)
+
+// Codec은 data page 압축 코덱입니다
+type Codec int32
+
+const (
+	// CodecUncompressed는 압축하지 않습니다
+	CodecUncompressed Codec = 0
+	// CodecSnappy는 Snappy 압축입니다 (Parquet 기본 코덱)
+	CodecSnappy Codec = 1
+	// CodecGzip은 gzip 압축입니다
+	CodecGzip Codec = 2
+)
+
+// 크기 관련 기본값
+const (
+	// DefaultRowGroupSize는 row group 목표 크기입니다 (압축 전 64MB)
+	DefaultRowGroupSize = 64 * 1024 * 1024
+
+	// DefaultPageSize는 data page 목표 크기입니다 (압축 전 1MB)
+	DefaultPageSize = 1024 * 1024
+)
+
+// WriterOptions는 Parquet writer 설정입니다
+type WriterOptions struct {
+	RowGroupSize int64  // row group 목표 크기 (압축 전 bytes, 0이면 기본값)
+	PageSize     int    // data page 목표 크기 (압축 전 bytes, 0이면 기본값)
+	Codec        Codec  // 페이지 압축 코덱
+	CreatedBy    string // 파일 메타데이터의 created_by
+}
+
+// Writer는 row 단위 입력을 Parquet 파일로 기록하는 스트리밍 writer입니다
+// row group 단위로 메모리에 버퍼링한 뒤 출력하므로 메모리 사용량은 RowGroupSize에 비례합니다
+type Writer struct {
+	out    *countingWriter
+	writer *pq.Writer
+	fields []Field
+	opts   WriterOptions
+	row    pq.Row // Write 호출 간 재사용하는 row 버퍼
+
+	numRows       int64 // 기록된 전체 row 수
+	groupBytes    int64 // 현재 row group의 압축 전 크기
+	bytesOriginal int64 // 압축 전 값 크기 합계
+	closed        bool
+}
+
+// countingWriter는 기록된 바이트 수를 추적하는 io.Writer 래퍼입니다
+type countingWriter struct {
+	writer io.Writer
+	n      int64
+}
+
+// Write는 io.Writer 인터페이스를 구현합니다
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.writer.Write(p)
+	atomic.AddInt64(&cw.n, int64(n))
+	return n, err
+}
+
+// NewWriter는 새로운 Parquet writer를 생성합니다
+func NewWriter(w io.Writer, fields []Field, opts WriterOptions) (*Writer, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("컬럼이 최소 1개 이상이어야 합니다")
+	}
+	seen := make(map[string]bool, len(fields))
+	root := make(columnGroup, len(fields))
+	for i, f := range fields {
+		if err := f.validate(); err != nil {
+			return nil, err
+		}
+		if seen[f.Name] {
+			return nil, fmt.Errorf("중복된 컬럼 이름: %s", f.Name)
+		}
+		seen[f.Name] = true
+		root[i] = &column{Node: f.node(), name: f.Name}
+	}
+
+	var codec compress.Codec
+	switch opts.Codec {
+	case CodecUncompressed:
+		codec = &pq.Uncompressed
+	case CodecSnappy:
+		codec = &pq.Snappy
+	case CodecGzip:
+		codec = &pq.Gzip
+	default:
+		return nil, fmt.Errorf("지원하지 않는 압축 코덱: %d", opts.Codec)
+	}
+	if opts.RowGroupSize <= 0 {
+		opts.RowGroupSize = DefaultRowGroupSize
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+
+	out := &countingWriter{writer: w}
+	options := []pq.WriterOption{
+		pq.NewSchema("schema", root),
+		pq.Compression(codec),
+		pq.PageBufferSize(opts.PageSize),
+		// parquet-go v0.25의 Data Page V1 writer는 optional 컬럼에 빈 repetition level 구간을 기록하므로
+		// (v0.26+는 Go 1.24 필요) 기본값인 Data Page V2를 사용
+		pq.DataPageVersion(2),
+	}
+	if opts.CreatedBy != "" {
+		options = append(options, &pq.WriterConfig{CreatedBy: opts.CreatedBy})
+	}
+
+	return &Writer{
+		out:    out,
+		writer: pq.NewWriter(out, options...),
+		fields: fields,
+		opts:   opts,
+		row:    make(pq.Row, len(fields)),
+	}, nil
+}
+
+// Write는 한 row를 기록합니다. values는 스키마 컬럼 순서와 같아야 하며 nil은 NULL입니다
+// 변환에 실패한 row는 기록되지 않습니다
+func (w *Writer) Write(values []interface{}) error {
+	if w.closed {
+		return errors.New("이미 닫힌 writer입니다")
+	}
+	if len(values) != len(w.fields) {
+		return fmt.Errorf("값 개수 %d이(가) 컬럼 수 %d와 다릅니다", len(values), len(w.fields))
+	}
+
+	var rowBytes int64
+	for i, f := range w.fields {
+		if values[i] == nil {
+			if f.Required {
+				return fmt.Errorf("컬럼 %s: NULL을 허용하지 않는 컬럼입니다", f.Name)
+			}
+			w.row[i] = pq.NullValue().Level(0, 0, i)
+			rowBytes++
+			continue
+		}
+		v, size, err := f.value(values[i])
+		if err != nil {
+			return fmt.Errorf("컬럼 %s: %w", f.Name, err)
+		}
+		definition := 1
+		if f.Required {
+			definition = 0
+		}
+		w.row[i] = v.Level(0, definition, i)
+		rowBytes += int64(size)
+	}
+
+	if _, err := w.writer.WriteRows([]pq.Row{w.row}); err != nil {
+		return fmt.Errorf("parquet row 기록 실패: %w", err)
+	}
+	w.numRows++
+	w.groupBytes += rowBytes
+	atomic.AddInt64(&w.bytesOriginal, rowBytes)
+
+	if w.groupBytes >= w.opts.RowGroupSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush는 버퍼링된 row를 row group으로 출력합니다
+func (w *Writer) Flush() error {
+	if w.groupBytes == 0 {
+		return nil
+	}
+	if err := w.writer.Flush(); err != nil {
+		return fmt.Errorf("parquet row group 기록 실패: %w", err)
+	}
+	w.groupBytes = 0
+	return nil
+}
+
+// Close는 남은 row group과 파일 footer를 기록합니다. 하위 writer는 닫지 않습니다
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("parquet footer 기록 실패: %w", err)
+	}
+	return nil
+}
+
+// BytesWritten은 지금까지 출력된 바이트 수를 반환합니다
+func (w *Writer) BytesWritten() int64 {
+	return atomic.LoadInt64(&w.out.n)
+}
+
+// BytesOriginal은 압축 전 값 크기 합계를 반환합니다 (PLAIN 인코딩 기준 추정치)
+func (w *Writer) BytesOriginal() int64 {
+	return atomic.LoadInt64(&w.bytesOriginal)
+}
+
+// RowsWritten은 기록된 row 수를 반환합니다
+func (w *Writer) RowsWritten() int64 {
+	return w.numRows
+}
+
+// columnGroup은 컬럼 정의 순서를 유지하는 루트 그룹입니다
+// (parquet-go의 Group은 map이어서 컬럼을 이름순으로 정렬하므로 Oracle 컬럼 순서를 보존하기 위해 사용)
+type columnGroup []pq.Field
+
+func (g columnGroup) ID() int                     { return 0 }
+func (g columnGroup) String() string              { return pq.NewSchema("schema", g).String() }
+func (g columnGroup) Type() pq.Type               { return pq.Group{}.Type() }
+func (g columnGroup) Optional() bool              { return false }
+func (g columnGroup) Repeated() bool              { return false }
+func (g columnGroup) Required() bool              { return true }
+func (g columnGroup) Leaf() bool                  { return false }
+func (g columnGroup) Fields() []pq.Field          { return g }
+func (g columnGroup) Encoding() encoding.Encoding { return nil }
+func (g columnGroup) Compression() compress.Codec { return nil }
+
+// GoType은 row를 컬럼 이름별 map으로 표현합니다 (Writer는 pq.Row를 직접 기록하므로 reflection을 사용하지 않음)
+func (g columnGroup) GoType() reflect.Type { return reflect.TypeOf(map[string]interface{}(nil)) }
+
+// column은 columnGroup의 컬럼입니다
+type column struct {
+	pq.Node
+	name string
+}
+
+// Name은 컬럼 이름을 반환합니다
+func (c *column) Name() string { return c.name }
+
+// Value는 컬럼 이름별 map에서 컬럼 값을 반환합니다
+func (c *column) Value(base reflect.Value) reflect.Value {
+	if base.Kind() == reflect.Interface {
+		if base.IsNil() {
+			return reflect.ValueOf(nil)
+		}
+		base = base.Elem()
+	}
+	return base.MapIndex(reflect.ValueOf(c.name))
+}
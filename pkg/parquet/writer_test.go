@@ -0,0 +1,232 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	pq "github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readFile은 기록된 Parquet 파일을 parquet-go reader로 열고 모든 row를 읽습니다
+func readFile(t *testing.T, data []byte) (*pq.File, []pq.Row) {
+	t.Helper()
+	f, err := pq.OpenFile(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	r := pq.NewReader(f)
+	defer r.Close()
+	rows := make([]pq.Row, 0, f.NumRows())
+	buf := make([]pq.Row, 64)
+	for {
+		n, err := r.ReadRows(buf)
+		for _, row := range buf[:n] {
+			rows = append(rows, row.Clone())
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+	}
+	require.Len(t, rows, int(f.NumRows()))
+	return f, rows
+}
+
+// stringer는 godror.Number처럼 문자열로 숫자를 표현하는 타입입니다
+type stringer string
+
+func (s stringer) String() string { return string(s) }
+
+func TestWriter_RoundTrip(t *testing.T) {
+	fields := []Field{
+		String("VBELN"),
+		Decimal("NETWR", 15, 2),
+		Decimal("BIG", 30, 4),
+		Double("RATE"),
+		Timestamp("ERDAT", TimeUnitMillis, false),
+		Timestamp("CHANGED_AT", TimeUnitMicros, true),
+		Bytes("RAW_ID"),
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, fields, WriterOptions{Codec: CodecSnappy, CreatedBy: "oracle-etl"})
+	require.NoError(t, err)
+
+	created := time.Date(2024, 1, 15, 10, 30, 0, 0, time.FixedZone("KST", 9*3600))
+	changed := time.Date(2024, 1, 15, 1, 30, 0, 123456000, time.UTC)
+
+	require.NoError(t, w.Write([]interface{}{"0090000001", "1234.50", stringer("-12345678901234567890.1234"), 0.5, created.Format(time.RFC3339), changed, []byte{0xDE, 0xAD}}))
+	require.NoError(t, w.Write([]interface{}{"0090000002", nil, nil, nil, nil, nil, nil}))
+	require.NoError(t, w.Write([]interface{}{"0090000003", int64(7), "0", "2.25", created, changed.Format(time.RFC3339Nano), "\x01"}))
+	require.NoError(t, w.Close())
+	assert.Equal(t, int64(buf.Len()), w.BytesWritten())
+
+	f, rows := readFile(t, buf.Bytes())
+	meta := f.Metadata()
+	assert.Equal(t, int64(3), meta.NumRows)
+	assert.Equal(t, "oracle-etl", meta.CreatedBy)
+
+	// 스키마: 루트 + 컬럼 (Oracle 컬럼 순서 유지)
+	require.Len(t, meta.Schema, len(fields)+1)
+	for i, field := range fields {
+		assert.Equal(t, field.Name, meta.Schema[i+1].Name)
+		assert.Equal(t, format.Optional, *meta.Schema[i+1].RepetitionType)
+	}
+
+	netwr := meta.Schema[2]
+	assert.Equal(t, format.Int64, *netwr.Type)
+	require.NotNil(t, netwr.LogicalType.Decimal)
+	assert.Equal(t, int32(2), netwr.LogicalType.Decimal.Scale)
+	assert.Equal(t, int32(15), netwr.LogicalType.Decimal.Precision)
+
+	big30 := meta.Schema[3]
+	assert.Equal(t, format.FixedLenByteArray, *big30.Type)
+	assert.Equal(t, int32(13), *big30.TypeLength)
+	assert.Equal(t, int32(30), big30.LogicalType.Decimal.Precision)
+
+	erdat := meta.Schema[5].LogicalType.Timestamp
+	require.NotNil(t, erdat)
+	assert.False(t, erdat.IsAdjustedToUTC)
+	assert.NotNil(t, erdat.Unit.Millis)
+
+	changedAt := meta.Schema[6].LogicalType.Timestamp
+	require.NotNil(t, changedAt)
+	assert.True(t, changedAt.IsAdjustedToUTC)
+	assert.NotNil(t, changedAt.Unit.Micros)
+
+	// 값 검증
+	assert.Equal(t, "0090000001", rows[0][0].String())
+	assert.Equal(t, "0090000003", rows[2][0].String())
+
+	assert.Equal(t, int64(123450), rows[0][1].Int64())
+	assert.True(t, rows[1][1].IsNull())
+	assert.Equal(t, int64(700), rows[2][1].Int64())
+
+	expected, _ := new(big.Int).SetString("-123456789012345678901234", 10)
+	assert.Equal(t, 0, expected.Cmp(fromTwosComplement(rows[0][2].ByteArray())))
+	assert.Equal(t, 0, fromTwosComplement(rows[2][2].ByteArray()).Sign())
+
+	assert.Equal(t, 0.5, rows[0][3].Double())
+	assert.Equal(t, 2.25, rows[2][3].Double())
+
+	// 시간대 없는 TIMESTAMP는 로컬 시각을 그대로 기록
+	wall := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, wall.UnixMilli(), rows[0][4].Int64())
+	assert.Equal(t, wall.UnixMilli(), rows[2][4].Int64())
+
+	assert.Equal(t, changed.UnixMicro(), rows[0][5].Int64())
+	assert.Equal(t, changed.UnixMicro(), rows[2][5].Int64())
+
+	assert.Equal(t, []byte{0xDE, 0xAD}, rows[0][6].ByteArray())
+	assert.Equal(t, []byte{0x01}, rows[2][6].ByteArray())
+	for _, v := range rows[1][1:] {
+		assert.True(t, v.IsNull())
+	}
+}
+
+func fromTwosComplement(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return v
+}
+
+func TestWriter_RowGroupsAndPages(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Field{Int64("ID"), String("NAME")}, WriterOptions{
+		RowGroupSize: 4 * 1024,
+		PageSize:     512,
+		Codec:        CodecGzip,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		var name interface{} = "name"
+		if i%10 == 0 {
+			name = nil
+		}
+		require.NoError(t, w.Write([]interface{}{i, name}))
+	}
+	require.NoError(t, w.Close())
+	assert.Equal(t, int64(1000), w.RowsWritten())
+	assert.Equal(t, int64(buf.Len()), w.BytesWritten())
+	assert.Greater(t, w.BytesOriginal(), int64(0))
+
+	f, rows := readFile(t, buf.Bytes())
+	assert.Greater(t, len(f.RowGroups()), 1, "row group 크기에 따라 여러 row group으로 나뉘어야 함")
+	assert.Equal(t, format.Gzip, f.Metadata().RowGroups[0].Columns[0].MetaData.Codec)
+
+	for i, row := range rows {
+		require.Equal(t, int64(i), row[0].Int64())
+	}
+	assert.True(t, rows[0][1].IsNull())
+	assert.Equal(t, "name", rows[1][1].String())
+}
+
+func TestWriter_InvalidRowIsNotWritten(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Field{String("NAME"), Decimal("AMOUNT", 5, 2)}, WriterOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, w.Write([]interface{}{"ok", "1.5"}))
+	assert.Error(t, w.Write([]interface{}{"too-big", "12345.67"}))
+	assert.Error(t, w.Write([]interface{}{"bad-scale", "1.234"}))
+	assert.Error(t, w.Write([]interface{}{"short"}))
+	require.NoError(t, w.Write([]interface{}{"ok2", nil}))
+	require.NoError(t, w.Close())
+
+	_, rows := readFile(t, buf.Bytes())
+	require.Len(t, rows, 2)
+	assert.Equal(t, "ok", rows[0][0].String())
+	assert.Equal(t, int32(150), rows[0][1].Int32())
+	assert.Equal(t, "ok2", rows[1][0].String())
+	assert.True(t, rows[1][1].IsNull())
+}
+
+func TestWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Field{String("NAME")}, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	f, rows := readFile(t, buf.Bytes())
+	assert.Empty(t, rows)
+	assert.Empty(t, f.Metadata().RowGroups)
+	require.Len(t, f.Metadata().Schema, 2)
+	assert.Equal(t, "NAME", f.Metadata().Schema[1].Name)
+}
+
+func TestNewWriter_InvalidSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []Field
+	}{
+		{name: "컬럼 없음", fields: nil},
+		{name: "중복 컬럼", fields: []Field{String("A"), String("A")}},
+		{name: "잘못된 DECIMAL", fields: []Field{Decimal("A", 0, 0)}},
+		{name: "scale이 precision 초과", fields: []Field{{Name: "A", Type: TypeInt64, Logical: LogicalDecimal, Precision: 2, Scale: 3}}},
+		{name: "이름 없음", fields: []Field{String("")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWriter(io.Discard, tt.fields, WriterOptions{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDecimalLength(t *testing.T) {
+	// parquet-format 명세의 precision별 최소 바이트 수
+	assert.Equal(t, 4, decimalLength(9))
+	assert.Equal(t, 8, decimalLength(18))
+	assert.Equal(t, 9, decimalLength(19))
+	assert.Equal(t, 16, decimalLength(38))
+}
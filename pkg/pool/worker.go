@@ -182,3 +182,8 @@ func (p *WorkerPool) Stop() {
 func (p *WorkerPool) PendingTasks() int {
 	return int(atomic.LoadInt32(&p.taskCount))
 }
+
+// QueuedTasks는 큐에서 워커를 기다리는 작업 수를 반환합니다 (실행 중인 작업 제외)
+func (p *WorkerPool) QueuedTasks() int {
+	return len(p.taskQueue)
+}
@@ -0,0 +1,162 @@
+package rowset
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// hexDigits는 JSON \u 이스케이프에 사용하는 16진수 문자입니다
+const hexDigits = "0123456789abcdef"
+
+// AppendJSON은 row를 컬럼 순서의 JSON 객체로 dst에 추가합니다
+// encoding/json의 reflection과 map 정렬 없이 미리 인코딩한 키를 사용합니다
+func (r Row) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '{')
+	for i := range r.Values {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, r.Schema.keys[i]...)
+		dst = r.Values[i].AppendJSON(dst, r.Schema.columns[i].Kind)
+	}
+	return append(dst, '}')
+}
+
+// AppendJSON은 값을 kind 표현의 JSON 값으로 dst에 추가합니다
+//   - NUMBER: 10진 문자열 그대로의 JSON 숫자 (숫자 형식이 아니면 문자열)
+//   - BINARY_FLOAT/DOUBLE: JSON 숫자 (NaN, ±Inf는 "NaN", "Infinity", "-Infinity" 문자열)
+//   - 일시: RFC3339 (나노초 포함) 문자열
+//   - 바이너리: base64 문자열
+func (v Value) AppendJSON(dst []byte, kind Kind) []byte {
+	if !v.Valid {
+		return append(dst, "null"...)
+	}
+	switch kind {
+	case KindNumber:
+		if isJSONNumber(v.Str) {
+			return append(dst, v.Str...)
+		}
+		return appendString(dst, v.Str)
+	case KindDouble:
+		return appendFloat(dst, v.Float)
+	case KindTime, KindTimeTZ:
+		dst = append(dst, '"')
+		dst = v.Time.AppendFormat(dst, time.RFC3339Nano)
+		return append(dst, '"')
+	case KindBytes:
+		dst = append(dst, '"')
+		dst = base64.StdEncoding.AppendEncode(dst, v.Bytes)
+		return append(dst, '"')
+	default:
+		return appendString(dst, v.Str)
+	}
+}
+
+// appendFloat는 encoding/json과 같은 형식으로 부동소수점을 추가합니다
+func appendFloat(dst []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(dst, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(dst, `"Infinity"`...)
+	case math.IsInf(f, -1):
+		return append(dst, `"-Infinity"`...)
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	return strconv.AppendFloat(dst, f, format, -1, 64)
+}
+
+// appendString은 s를 JSON 문자열로 추가합니다
+// encoding/json(SetEscapeHTML(false))과 같이 제어 문자, 잘못된 UTF-8, U+2028/U+2029를 이스케이프합니다
+func appendString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch b {
+			case '"', '\\':
+				dst = append(dst, '\\', b)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		if c == '\u2028' || c == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// isJSONNumber는 s가 JSON 숫자 문법(RFC 8259)을 따르는지 검사합니다
+func isJSONNumber(s string) bool {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && s[i] >= '1' && s[i] <= '9':
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	default:
+		return false
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		if i >= len(s) || s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		if i >= len(s) || s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	return i == len(s)
+}
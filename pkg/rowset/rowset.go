@@ -0,0 +1,384 @@
+// Package rowset은 추출한 row의 타입 지정(typed) 표현을 제공합니다.
+// 컬럼 스키마는 쿼리마다 한 번 만들고, 청크의 row 값은 하나의 연속된 슬랩(slab)에 저장하여
+// row마다 map과 interface{} 슬라이스를 할당하지 않습니다.
+package rowset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrValueTooLarge는 값이 값당 크기 상한을 넘을 때 반환됩니다
+var ErrValueTooLarge = errors.New("값 크기 상한 초과")
+
+// Kind는 컬럼 값의 표현 종류입니다
+type Kind uint8
+
+const (
+	// KindString은 문자열 값입니다 (VARCHAR2, CHAR, CLOB, ROWID 등)
+	KindString Kind = iota
+	// KindNumber는 10진 숫자 문자열입니다 (NUMBER). 정밀도 손실 없이 JSON 숫자로 출력합니다
+	KindNumber
+	// KindDouble은 IEEE 754 부동소수점 값입니다 (BINARY_FLOAT, BINARY_DOUBLE)
+	KindDouble
+	// KindTime은 시간대가 없는 일시입니다 (DATE, TIMESTAMP)
+	KindTime
+	// KindTimeTZ는 시간대가 있는 일시입니다 (TIMESTAMP WITH [LOCAL] TIME ZONE)
+	KindTimeTZ
+	// KindBytes는 바이너리 값입니다 (RAW, BLOB). JSON에서는 base64 문자열로 출력합니다
+	KindBytes
+)
+
+// String은 종류 이름을 반환합니다
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindNumber:
+		return "number"
+	case KindDouble:
+		return "double"
+	case KindTime:
+		return "time"
+	case KindTimeTZ:
+		return "time_tz"
+	case KindBytes:
+		return "bytes"
+	default:
+		return fmt.Sprintf("kind(%d)", uint8(k))
+	}
+}
+
+// Column은 row 컬럼의 이름과 값 종류입니다
+type Column struct {
+	Name string
+	Kind Kind
+}
+
+// Schema는 row의 컬럼 구성입니다. 같은 쿼리의 모든 row가 하나의 Schema를 공유합니다
+type Schema struct {
+	columns []Column
+	index   map[string]int
+	keys    [][]byte // 미리 인코딩한 JSON 객체 키 ("NAME":)
+}
+
+// NewSchema는 컬럼 목록으로 Schema를 생성합니다
+func NewSchema(columns []Column) *Schema {
+	s := &Schema{
+		columns: append([]Column(nil), columns...),
+		index:   make(map[string]int, len(columns)),
+		keys:    make([][]byte, len(columns)),
+	}
+	for i, col := range columns {
+		s.index[col.Name] = i
+		key := appendString(nil, col.Name)
+		s.keys[i] = append(key, ':')
+	}
+	return s
+}
+
+// Len은 컬럼 수를 반환합니다
+func (s *Schema) Len() int {
+	return len(s.columns)
+}
+
+// Column은 i번째 컬럼을 반환합니다
+func (s *Schema) Column(i int) Column {
+	return s.columns[i]
+}
+
+// Index는 컬럼 이름의 위치를 반환합니다 (없으면 -1)
+func (s *Schema) Index(name string) int {
+	if i, ok := s.index[name]; ok {
+		return i
+	}
+	return -1
+}
+
+// Names는 컬럼 이름 목록을 반환합니다
+func (s *Schema) Names() []string {
+	names := make([]string, len(s.columns))
+	for i, col := range s.columns {
+		names[i] = col.Name
+	}
+	return names
+}
+
+// Value는 타입이 지정된 단일 컬럼 값입니다 (Valid가 false이면 NULL)
+// 값은 컬럼 종류에 해당하는 필드 하나에만 저장됩니다
+type Value struct {
+	Valid bool
+	Str   string    // KindString, KindNumber
+	Float float64   // KindDouble
+	Time  time.Time // KindTime, KindTimeTZ
+	Bytes []byte    // KindBytes
+}
+
+// Set은 드라이버가 반환한 값을 kind 표현으로 변환하여 저장합니다
+// Oracle은 빈 문자열을 NULL로 취급하므로 빈 문자열과 zero time은 NULL로 저장합니다
+func (v *Value) Set(kind Kind, src interface{}) error {
+	return v.SetLimit(kind, src, 0)
+}
+
+// SetLimit은 Set과 같지만 LOB reader, []byte, string 값이 limit bytes를 넘으면 ErrValueTooLarge를 반환합니다
+// reader는 limit+1 bytes까지만 읽으므로 상한을 넘는 LOB 전체를 메모리에 올리지 않습니다 (limit이 0 이하이면 제한 없음)
+func (v *Value) SetLimit(kind Kind, src interface{}, limit int64) error {
+	*v = Value{}
+	if src == nil {
+		return nil
+	}
+	if r, ok := src.(io.Reader); ok {
+		if limit > 0 {
+			r = io.LimitReader(r, limit+1)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("LOB 읽기 실패: %w", err)
+		}
+		if kind == KindBytes {
+			src = data
+		} else {
+			src = string(data)
+		}
+	}
+	if limit > 0 {
+		var size int
+		switch val := src.(type) {
+		case []byte:
+			size = len(val)
+		case string:
+			size = len(val)
+		}
+		if int64(size) > limit {
+			return fmt.Errorf("%w: %d bytes를 넘습니다", ErrValueTooLarge, limit)
+		}
+	}
+
+	switch kind {
+	case KindNumber:
+		s, err := numberText(src)
+		if err != nil {
+			return err
+		}
+		v.Str, v.Valid = s, s != ""
+	case KindDouble:
+		f, err := doubleValue(src)
+		if err != nil {
+			return err
+		}
+		v.Float, v.Valid = f, true
+	case KindTime, KindTimeTZ:
+		t, ok := src.(time.Time)
+		if !ok {
+			return fmt.Errorf("일시로 변환할 수 없는 타입 %T", src)
+		}
+		v.Time, v.Valid = t, !t.IsZero()
+	case KindBytes:
+		switch b := src.(type) {
+		case []byte:
+			v.Bytes, v.Valid = b, true
+		case string:
+			v.Bytes, v.Valid = []byte(b), true
+		default:
+			return fmt.Errorf("바이너리로 변환할 수 없는 타입 %T", src)
+		}
+	default:
+		var s string
+		switch val := src.(type) {
+		case string:
+			s = val
+		case []byte:
+			s = string(val)
+		case time.Time:
+			s = val.Format(time.RFC3339Nano)
+		default:
+			s = fmt.Sprint(val)
+		}
+		v.Str, v.Valid = s, s != ""
+	}
+	return nil
+}
+
+// Interface는 값을 Go 값으로 반환합니다
+// NUMBER는 json.Number, 일시는 time.Time, 바이너리는 []byte, NULL은 nil입니다
+func (v Value) Interface(kind Kind) interface{} {
+	if !v.Valid {
+		return nil
+	}
+	switch kind {
+	case KindNumber:
+		return json.Number(v.Str)
+	case KindDouble:
+		return v.Float
+	case KindTime, KindTimeTZ:
+		return v.Time
+	case KindBytes:
+		return v.Bytes
+	default:
+		return v.Str
+	}
+}
+
+// Row는 Schema를 공유하는 row입니다. Values는 청크 슬랩의 일부를 가리킵니다
+type Row struct {
+	Schema *Schema
+	Values []Value
+}
+
+// New는 Go 값 목록으로 row를 생성합니다 (테스트와 Mock 데이터 생성용)
+func New(schema *Schema, values ...interface{}) (Row, error) {
+	if len(values) != schema.Len() {
+		return Row{}, fmt.Errorf("값 개수 %d이(가) 컬럼 수 %d와 다릅니다", len(values), schema.Len())
+	}
+	row := Row{Schema: schema, Values: make([]Value, len(values))}
+	for i, val := range values {
+		col := schema.Column(i)
+		if err := row.Values[i].Set(col.Kind, val); err != nil {
+			return Row{}, fmt.Errorf("컬럼 %s: %w", col.Name, err)
+		}
+	}
+	return row, nil
+}
+
+// FromMap은 컬럼 이름별 값으로 row를 생성합니다 (map에 없는 컬럼은 NULL)
+func FromMap(schema *Schema, values map[string]interface{}) (Row, error) {
+	ordered := make([]interface{}, schema.Len())
+	for i := range ordered {
+		ordered[i] = values[schema.Column(i).Name]
+	}
+	return New(schema, ordered...)
+}
+
+// Interface는 i번째 컬럼 값을 Go 값으로 반환합니다
+func (r Row) Interface(i int) interface{} {
+	return r.Values[i].Interface(r.Schema.Column(i).Kind)
+}
+
+// Get은 컬럼 이름의 값을 반환합니다 (컬럼이 없으면 false)
+func (r Row) Get(name string) (interface{}, bool) {
+	i := r.Schema.Index(name)
+	if i < 0 {
+		return nil, false
+	}
+	return r.Interface(i), true
+}
+
+// Map은 row를 컬럼 이름별 map으로 변환합니다 (미리보기 API 응답 등 row 수가 적은 경우용)
+func (r Row) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(r.Values))
+	for i := range r.Values {
+		m[r.Schema.Column(i).Name] = r.Interface(i)
+	}
+	return m
+}
+
+// valueOverhead는 Value 하나의 고정 크기 추정치입니다 (bytes)
+const valueOverhead = 80
+
+// Size는 row가 차지하는 메모리 크기 추정치를 반환합니다 (문자열/바이너리 데이터 포함, bytes)
+func (r Row) Size() int {
+	size := len(r.Values) * valueOverhead
+	for i := range r.Values {
+		size += len(r.Values[i].Str) + len(r.Values[i].Bytes)
+	}
+	return size
+}
+
+// MarshalJSON은 row를 컬럼 순서의 JSON 객체로 인코딩합니다
+func (r Row) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(nil), nil
+}
+
+// Buffer는 청크 하나의 row 값을 연속된 슬랩에 할당합니다
+// 청크를 핸들러에 넘긴 뒤에는 row가 계속 참조될 수 있으므로 다음 청크는 새 Buffer를 사용합니다
+type Buffer struct {
+	schema *Schema
+	values []Value
+}
+
+// NewBuffer는 rows개의 row를 담을 슬랩을 할당합니다
+func NewBuffer(schema *Schema, rows int) *Buffer {
+	if rows <= 0 {
+		rows = 1
+	}
+	return &Buffer{schema: schema, values: make([]Value, 0, rows*schema.Len())}
+}
+
+// Next는 슬랩에서 다음 row를 할당합니다 (슬랩이 가득 차면 새 슬랩을 할당)
+func (b *Buffer) Next() Row {
+	n := b.schema.Len()
+	if len(b.values)+n > cap(b.values) {
+		b.values = make([]Value, 0, cap(b.values))
+		if cap(b.values) < n {
+			b.values = make([]Value, 0, n)
+		}
+	}
+	start := len(b.values)
+	b.values = b.values[:start+n]
+	return Row{Schema: b.schema, Values: b.values[start : start+n : start+n]}
+}
+
+// numberText는 숫자 값을 10진 문자열로 변환합니다 (godror.Number 등 문자열 기반 타입 포함)
+func numberText(src interface{}) (string, error) {
+	switch val := src.(type) {
+	case string:
+		return normalizeNumber(val), nil
+	case []byte:
+		return normalizeNumber(string(val)), nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case int:
+		return strconv.Itoa(val), nil
+	case int32:
+		return strconv.FormatInt(int64(val), 10), nil
+	case uint64:
+		return strconv.FormatUint(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32), nil
+	case fmt.Stringer:
+		return normalizeNumber(val.String()), nil
+	}
+	return "", fmt.Errorf("숫자로 변환할 수 없는 타입 %T", src)
+}
+
+// normalizeNumber는 Oracle 숫자 텍스트를 JSON 숫자 형식으로 정규화합니다 (".5" -> "0.5")
+func normalizeNumber(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, "."):
+		return "0" + s
+	case strings.HasPrefix(s, "-."):
+		return "-0" + s[1:]
+	}
+	return s
+}
+
+// doubleValue는 값을 부동소수점으로 변환합니다
+func doubleValue(src interface{}) (float64, error) {
+	switch val := src.(type) {
+	case float64:
+		return val, nil
+	case float32:
+		return float64(val), nil
+	case int64:
+		return float64(val), nil
+	case int:
+		return float64(val), nil
+	}
+	s, err := numberText(src)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("실수 변환 실패: %w", err)
+	}
+	return f, nil
+}
@@ -0,0 +1,172 @@
+package rowset
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// number는 godror.Number처럼 fmt.Stringer를 구현하는 문자열 기반 숫자 타입입니다
+type number string
+
+func (n number) String() string { return string(n) }
+
+// failingReader는 읽기에 실패하는 LOB reader입니다
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("ORA-22922") }
+
+func TestValue_Set(t *testing.T) {
+	tz := time.FixedZone("KST", 9*60*60)
+	ts := time.Date(2024, 1, 15, 10, 30, 0, 123456000, tz)
+
+	tests := []struct {
+		name     string
+		kind     Kind
+		input    interface{}
+		expected string
+	}{
+		{name: "NULL", kind: KindString, input: nil, expected: `null`},
+		{name: "빈 문자열은 NULL", kind: KindString, input: "", expected: `null`},
+		{name: "문자열 이스케이프", kind: KindString, input: "a\"b\\c\n<d>\u2028", expected: `"a\"b\\c\n<d>\u2028"`},
+		{name: "잘못된 UTF-8", kind: KindString, input: "a\xffb", expected: `"a\ufffdb"`},
+		{name: "제어 문자", kind: KindString, input: "\x01", expected: `"\u0001"`},
+		{name: "CLOB reader", kind: KindString, input: strings.NewReader("long text"), expected: `"long text"`},
+		{name: "NUMBER 정밀도 유지", kind: KindNumber, input: number("12345678901234567890.123456789"), expected: `12345678901234567890.123456789`},
+		{name: "NUMBER 소수점 정규화", kind: KindNumber, input: number("-.5"), expected: `-0.5`},
+		{name: "NUMBER 정수", kind: KindNumber, input: int64(42), expected: `42`},
+		{name: "NUMBER 숫자 형식이 아닌 값", kind: KindNumber, input: "~", expected: `"~"`},
+		{name: "BINARY_DOUBLE", kind: KindDouble, input: 1.5, expected: `1.5`},
+		{name: "BINARY_DOUBLE 지수 표기", kind: KindDouble, input: 1e21, expected: `1e+21`},
+		{name: "BINARY_DOUBLE NaN", kind: KindDouble, input: math.NaN(), expected: `"NaN"`},
+		{name: "DATE", kind: KindTime, input: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), expected: `"2024-01-15T00:00:00Z"`},
+		{name: "TIMESTAMP WITH TIME ZONE", kind: KindTimeTZ, input: ts, expected: `"2024-01-15T10:30:00.123456+09:00"`},
+		{name: "zero time은 NULL", kind: KindTime, input: time.Time{}, expected: `null`},
+		{name: "RAW base64", kind: KindBytes, input: []byte{0xde, 0xad, 0xbe, 0xef}, expected: `"3q2+7w=="`},
+		{name: "BLOB reader", kind: KindBytes, input: bytes.NewReader([]byte("hi")), expected: `"aGk="`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Value
+			require.NoError(t, v.Set(tt.kind, tt.input))
+			assert.Equal(t, tt.expected, string(v.AppendJSON(nil, tt.kind)))
+		})
+	}
+}
+
+func TestValue_SetError(t *testing.T) {
+	var v Value
+	assert.Error(t, v.Set(KindTime, "2024-01-15"))
+	assert.Error(t, v.Set(KindBytes, 42))
+	assert.Error(t, v.Set(KindNumber, struct{}{}))
+	assert.Error(t, v.Set(KindDouble, "abc"))
+	assert.ErrorContains(t, v.Set(KindString, failingReader{}), "ORA-22922")
+}
+
+func TestValue_SetLimit(t *testing.T) {
+	const limit = 1024
+	var v Value
+
+	// 상한 이하의 LOB은 그대로 읽음
+	require.NoError(t, v.SetLimit(KindString, strings.NewReader(strings.Repeat("a", limit)), limit))
+	assert.Len(t, v.Str, limit)
+	require.NoError(t, v.SetLimit(KindBytes, bytes.NewReader(make([]byte, limit)), limit))
+	assert.Len(t, v.Bytes, limit)
+
+	// 상한을 넘는 LOB은 limit+1 bytes만 읽고 실패
+	blob := bytes.NewReader(make([]byte, 10*limit))
+	err := v.SetLimit(KindBytes, blob, limit)
+	require.ErrorIs(t, err, ErrValueTooLarge)
+	assert.False(t, v.Valid)
+	assert.Equal(t, int64(9*limit-1), int64(blob.Len()), "상한을 넘는 LOB 전체를 읽었습니다")
+
+	clob := strings.NewReader(strings.Repeat("가", limit))
+	assert.ErrorIs(t, v.SetLimit(KindString, clob, limit), ErrValueTooLarge)
+
+	// 드라이버가 이미 읽어 온 값도 상한을 적용
+	assert.ErrorIs(t, v.SetLimit(KindString, strings.Repeat("a", limit+1), limit), ErrValueTooLarge)
+	assert.ErrorIs(t, v.SetLimit(KindBytes, make([]byte, limit+1), limit), ErrValueTooLarge)
+
+	// 0이면 제한 없음
+	require.NoError(t, v.SetLimit(KindBytes, bytes.NewReader(make([]byte, 10*limit)), 0))
+	assert.Len(t, v.Bytes, 10*limit)
+}
+
+func TestRow_AppendJSON(t *testing.T) {
+	schema := NewSchema([]Column{
+		{Name: "VBELN", Kind: KindString},
+		{Name: "NETWR", Kind: KindNumber},
+		{Name: "ERDAT", Kind: KindTime},
+	})
+	row, err := New(schema, "0090000001", number("100.50"), time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// 컬럼 순서를 유지하고 encoding/json으로 다시 읽을 수 있어야 함
+	data := row.AppendJSON(nil)
+	assert.Equal(t, `{"VBELN":"0090000001","NETWR":100.50,"ERDAT":"2024-01-15T10:30:00Z"}`, string(data))
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "0090000001", decoded["VBELN"])
+
+	marshaled, err := json.Marshal(row)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+}
+
+func TestRow_Access(t *testing.T) {
+	schema := NewSchema([]Column{
+		{Name: "ID", Kind: KindNumber},
+		{Name: "NAME", Kind: KindString},
+	})
+
+	row, err := FromMap(schema, map[string]interface{}{"ID": 7})
+	require.NoError(t, err)
+
+	id, ok := row.Get("ID")
+	assert.True(t, ok)
+	assert.Equal(t, json.Number("7"), id)
+
+	name, ok := row.Get("NAME")
+	assert.True(t, ok)
+	assert.Nil(t, name)
+
+	_, ok = row.Get("MISSING")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]interface{}{"ID": json.Number("7"), "NAME": nil}, row.Map())
+	assert.Equal(t, []string{"ID", "NAME"}, schema.Names())
+
+	_, err = New(schema, 1)
+	assert.Error(t, err)
+}
+
+func TestBuffer_Next(t *testing.T) {
+	schema := NewSchema([]Column{{Name: "A", Kind: KindString}, {Name: "B", Kind: KindString}})
+	buf := NewBuffer(schema, 2)
+
+	first := buf.Next()
+	second := buf.Next()
+	require.NoError(t, first.Values[0].Set(KindString, "1"))
+	require.NoError(t, second.Values[0].Set(KindString, "2"))
+
+	// 같은 슬랩을 공유하지만 row의 capacity가 제한되어 append가 다음 row를 덮어쓰지 않아야 함
+	assert.Equal(t, 2, cap(first.Values))
+	_ = append(first.Values, Value{Valid: true, Str: "x"})
+	assert.Equal(t, "1", first.Values[0].Str)
+	assert.Equal(t, "2", second.Values[0].Str)
+
+	// 슬랩이 가득 차면 이전 row를 덮어쓰지 않고 새 슬랩을 할당
+	third := buf.Next()
+	require.NoError(t, third.Values[0].Set(KindString, "3"))
+	assert.Equal(t, "1", first.Values[0].Str)
+	assert.Equal(t, "2", second.Values[0].Str)
+	assert.Len(t, third.Values, 2)
+}
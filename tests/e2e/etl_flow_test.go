@@ -228,7 +228,7 @@ func TestOracleToGCSFlow(t *testing.T) {
 		assert.NotEmpty(t, tables)
 
 		// 샘플 데이터 조회
-		sample, err := oracleRepo.GetSampleData(ctx, "SAPSR3", "VBRP", 100)
+		sample, err := oracleRepo.GetSampleData(ctx, "SAPSR3", "VBRP", 100, domain.ColumnFilter{})
 		require.NoError(t, err)
 		assert.NotEmpty(t, sample.Columns)
 		assert.NotEmpty(t, sample.Rows)
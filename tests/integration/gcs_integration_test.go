@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"oracle-etl/internal/adapter/gcs"
+	"oracle-etl/internal/domain"
 )
 
 // TestGCSConnection은 실제 GCS 연결을 테스트합니다.
@@ -77,12 +78,12 @@ func TestGCSMockClient(t *testing.T) {
 	})
 
 	t.Run("객체 경로 생성", func(t *testing.T) {
-		path := client.ObjectPath("TRP-001", "v001", "VBRP")
+		path := client.ObjectPath("TRP-001", "v001", "VBRP", domain.OutputFormatJSONL)
 		assert.Equal(t, "TRP-001/v001/VBRP.jsonl.gz", path)
 	})
 
 	t.Run("전체 GCS 경로 생성", func(t *testing.T) {
-		path := client.FullGCSPath("TRP-001", "v001", "VBRP")
+		path := client.FullGCSPath("TRP-001", "v001", "VBRP", domain.OutputFormatJSONL)
 		assert.Equal(t, "gs://test-bucket/TRP-001/v001/VBRP.jsonl.gz", path)
 	})
 
@@ -121,7 +122,7 @@ func TestGCSUploadFlow(t *testing.T) {
 		err := mockClient.Ping(ctx)
 		require.NoError(t, err)
 
-		objectPath := mockClient.ObjectPath("TRP-001", "v001", "VBRP")
+		objectPath := mockClient.ObjectPath("TRP-001", "v001", "VBRP", domain.OutputFormatJSONL)
 		assert.NotEmpty(t, objectPath)
 	})
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"oracle-etl/internal/adapter/oracle"
+	"oracle-etl/internal/domain"
 )
 
 // TestOracleConnection은 실제 Oracle 데이터베이스 연결을 테스트합니다.
@@ -129,7 +130,7 @@ func TestOracleDataExtraction(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("샘플 데이터 추출", func(t *testing.T) {
-		sample, err := mock.GetSampleData(ctx, tableOwner, tableName, 100)
+		sample, err := mock.GetSampleData(ctx, tableOwner, tableName, 100, domain.ColumnFilter{})
 		require.NoError(t, err)
 		assert.NotNil(t, sample)
 	})